@@ -73,6 +73,22 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.NewRedisTemplate(&addon.RedisProps{
+				StorageProps: &addon.StorageProps{
+					Name: "cache",
+				},
+			}),
+			outFileName: "redis.yml",
+		},
+		"sqs": {
+			addonMarshaler: addon.NewSQSTemplate(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "jobs",
+				},
+			}),
+			outFileName: "sqs.yml",
+		},
 	}
 
 	for name, tc := range testCases {
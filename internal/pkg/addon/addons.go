@@ -18,6 +18,10 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	// EnvAddonsDirName is the directory under "copilot/" that holds the addons shared by all workloads in an environment.
+	// Workloads can't be named after it.
+	EnvAddonsDirName = "environments"
 )
 
 var (
@@ -58,6 +62,12 @@ func New(wlName string) (*Addons, error) {
 	}, nil
 }
 
+// NewEnv creates an Addons object for the resources shared by all the workloads in an environment.
+// The templates are read from the "copilot/environments/addons/" directory.
+func NewEnv() (*Addons, error) {
+	return New(EnvAddonsDirName)
+}
+
 // Template merges CloudFormation templates under the "addons/" directory of a workload
 // into a single CloudFormation template and returns it.
 //
@@ -149,21 +159,32 @@ func (a *Addons) Parameters() (string, error) {
 }
 
 func (a *Addons) validateReservedParameters(params yaml.Node, fname string) error {
+	reserved, err := hasReservedParameters(params)
+	if err != nil {
+		return fmt.Errorf("decode content of parameters file %s under %s addons/", fname, a.wlName)
+	}
+	if reserved {
+		return fmt.Errorf("reserved parameters 'App', 'Env', and 'Name' cannot be declared in %s under %s addons/", fname, a.wlName)
+	}
+	return nil
+}
+
+// hasReservedParameters returns true if the mapping declares any of the parameters that Copilot passes to every addons stack.
+func hasReservedParameters(params yaml.Node) (bool, error) {
 	content := struct {
 		App  yaml.Node `yaml:"App"`
 		Env  yaml.Node `yaml:"Env"`
 		Name yaml.Node `yaml:"Name"`
 	}{}
 	if err := params.Decode(&content); err != nil {
-		return fmt.Errorf("decode content of parameters file %s under %s addons/", fname, a.wlName)
+		return false, err
 	}
-
 	for _, field := range []yaml.Node{content.App, content.Env, content.Name} {
 		if !field.IsZero() {
-			return fmt.Errorf("reserved parameters 'App', 'Env', and 'Name' cannot be declared in %s under %s addons/", fname, a.wlName)
+			return true, nil
 		}
 	}
-	return nil
+	return false, nil
 }
 
 func filterFiles(files []string, matchers ...func(string) bool) []string {
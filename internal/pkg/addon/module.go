@@ -0,0 +1,351 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	moduleManifestFileName = "module.yml"
+	moduleTemplateFileName = "template.yml"
+
+	gitModulePrefix = "git::"
+)
+
+// ModuleSource is the location of an addon module.
+type ModuleSource struct {
+	URL  string // Git repository URL. Empty if the module is in a local directory.
+	Path string // Directory of the module on disk, or within the git repository.
+	Ref  string // Git branch, tag or commit to check out. Only valid for git modules.
+}
+
+// ParseModuleSource parses the location of a module.
+// Local modules are directories such as "./modules/redis", and git modules have the form
+// "git::https://github.com/org/repo.git//modules/redis?ref=v1.0.0".
+func ParseModuleSource(src string) (ModuleSource, error) {
+	if src == "" {
+		return ModuleSource{}, errors.New("module source cannot be empty")
+	}
+	if !strings.HasPrefix(src, gitModulePrefix) {
+		return ModuleSource{Path: src}, nil
+	}
+	rest := strings.TrimPrefix(src, gitModulePrefix)
+	var ref string
+	if i := strings.Index(rest, "?"); i != -1 {
+		query, err := url.ParseQuery(rest[i+1:])
+		if err != nil {
+			return ModuleSource{}, fmt.Errorf("parse query of module source %s: %w", src, err)
+		}
+		for key := range query {
+			if key != "ref" {
+				return ModuleSource{}, fmt.Errorf(`module source %s: unsupported query parameter "%s"`, src, key)
+			}
+		}
+		ref = query.Get("ref")
+		rest = rest[:i]
+	}
+	// The module's directory inside the repository is separated by a double slash after the scheme.
+	start := 0
+	if i := strings.Index(rest, "://"); i != -1 {
+		start = i + len("://")
+	}
+	var path string
+	if i := strings.Index(rest[start:], "//"); i != -1 {
+		path = rest[start+i+len("//"):]
+		rest = rest[:start+i]
+	}
+	if rest == "" {
+		return ModuleSource{}, fmt.Errorf("module source %s must include a git repository URL", src)
+	}
+	return ModuleSource{
+		URL:  rest,
+		Path: path,
+		Ref:  ref,
+	}, nil
+}
+
+// IsGit returns true if the module is fetched from a git repository.
+func (s ModuleSource) IsGit() bool {
+	return s.URL != ""
+}
+
+// String returns the module source in the same format accepted by ParseModuleSource.
+func (s ModuleSource) String() string {
+	if !s.IsGit() {
+		return s.Path
+	}
+	src := gitModulePrefix + s.URL
+	if s.Path != "" {
+		src += "//" + s.Path
+	}
+	if s.Ref != "" {
+		src += "?ref=" + s.Ref
+	}
+	return src
+}
+
+// ModuleValue is a value that can be provided to render a module.
+type ModuleValue struct {
+	Description string  `yaml:"description"`
+	Default     *string `yaml:"default"` // Values without a default are required.
+}
+
+// Module is a parameterized addon template that can be shared across workspaces.
+type Module struct {
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description"`
+	Values      map[string]ModuleValue `yaml:"values"`
+
+	Source ModuleSource `yaml:"-"`
+	Commit string       `yaml:"-"` // The commit that was checked out for git modules.
+
+	template string
+}
+
+// ModuleTemplate is the addon template rendered from a module.
+type ModuleTemplate struct {
+	content string
+}
+
+// MarshalBinary returns the content of the rendered template.
+func (t *ModuleTemplate) MarshalBinary() ([]byte, error) {
+	return []byte(t.content), nil
+}
+
+// Render executes the module's template with the values provided by the user, falling back to the
+// defaults declared by the module. The rendered template must be a valid CloudFormation template.
+func (m *Module) Render(values map[string]string) (*ModuleTemplate, error) {
+	for name := range values {
+		if _, ok := m.Values[name]; !ok {
+			return nil, fmt.Errorf("value %s is not declared by module %s", name, m.Name)
+		}
+	}
+	resolved := make(map[string]string)
+	var missing []string
+	for name, value := range m.Values {
+		if v, ok := values[name]; ok {
+			resolved[name] = v
+			continue
+		}
+		if value.Default == nil {
+			missing = append(missing, name)
+			continue
+		}
+		resolved[name] = *value.Default
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("module %s requires values for %s", m.Name, strings.Join(missing, ", "))
+	}
+
+	tpl, err := template.New(m.Name).Option("missingkey=error").Parse(m.template)
+	if err != nil {
+		return nil, fmt.Errorf("parse template of module %s: %w", m.Name, err)
+	}
+	buf := new(bytes.Buffer)
+	if err := tpl.Execute(buf, struct {
+		Values map[string]string
+	}{
+		Values: resolved,
+	}); err != nil {
+		return nil, fmt.Errorf("execute template of module %s: %w", m.Name, err)
+	}
+	rendered := newCFNTemplate(m.Name)
+	if err := yaml.Unmarshal(buf.Bytes(), rendered); err != nil {
+		return nil, fmt.Errorf("unmarshal rendered template of module %s: %w", m.Name, err)
+	}
+	if missing := missingReservedParameters(rendered.Parameters); len(missing) > 0 {
+		return nil, fmt.Errorf("rendered template of module %s must declare the reserved %s %s",
+			m.Name, english.PluralWord(len(missing), "parameter", ""), english.WordSeries(missing, "and"))
+	}
+	return &ModuleTemplate{
+		content: buf.String(),
+	}, nil
+}
+
+// ParseModule parses a module from the content of its module.yml and template.yml files.
+func ParseModule(manifest, tpl []byte) (*Module, error) {
+	var m Module
+	if err := yaml.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", moduleManifestFileName, err)
+	}
+	if m.Name == "" {
+		return nil, fmt.Errorf(`"name" must be specified in %s`, moduleManifestFileName)
+	}
+	var raw struct {
+		Values yaml.Node `yaml:"values"`
+	}
+	if err := yaml.Unmarshal(manifest, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", moduleManifestFileName, err)
+	}
+	if !raw.Values.IsZero() {
+		reserved, err := hasReservedParameters(raw.Values)
+		if err != nil {
+			return nil, fmt.Errorf("decode values of module %s: %w", m.Name, err)
+		}
+		if reserved {
+			return nil, fmt.Errorf("reserved parameters 'App', 'Env', and 'Name' cannot be declared as values of module %s", m.Name)
+		}
+	}
+	m.template = string(tpl)
+	return &m, nil
+}
+
+// missingReservedParameters returns the names of the parameters that Copilot passes to every addons stack
+// but that are not declared in the Parameters section of a template.
+func missingReservedParameters(params yaml.Node) []string {
+	declared := make(map[string]bool)
+	for i := 0; i+1 < len(params.Content); i += 2 {
+		declared[params.Content[i].Value] = true
+	}
+	var missing []string
+	for _, name := range []string{"App", "Env", "Name"} {
+		if !declared[name] {
+			missing = append(missing, fmt.Sprintf("'%s'", name))
+		}
+	}
+	return missing
+}
+
+type runner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+// ModuleFetcher reads addon modules from local directories or git repositories.
+type ModuleFetcher struct {
+	fs     afero.Fs
+	runner runner
+}
+
+// NewModuleFetcher returns a ModuleFetcher that reads modules from the file system and clones git modules.
+func NewModuleFetcher() *ModuleFetcher {
+	return &ModuleFetcher{
+		fs:     afero.NewOsFs(),
+		runner: exec.NewCmd(),
+	}
+}
+
+// Fetch reads the module at the source.
+// Git modules are cloned at the pinned reference into a temporary directory that is removed afterwards.
+func (f *ModuleFetcher) Fetch(src ModuleSource) (*Module, error) {
+	dir := src.Path
+	var commit string
+	if src.IsGit() {
+		tmp, err := afero.TempDir(f.fs, "", "copilot-addon-module")
+		if err != nil {
+			return nil, fmt.Errorf("create temporary directory: %w", err)
+		}
+		defer f.fs.RemoveAll(tmp)
+		dir = filepath.Join(tmp, src.Path)
+		if rel, err := filepath.Rel(tmp, dir); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("module path %s resolves outside of repository %s", src.Path, src.URL)
+		}
+
+		// Fetch the ref instead of cloning it so that commit SHAs can be used as well as branches and tags.
+		ref := src.Ref
+		if ref == "" {
+			ref = "HEAD"
+		}
+		cmds := [][]string{
+			{"init", "--quiet", tmp},
+			{"-C", tmp, "remote", "add", "origin", src.URL},
+			{"-C", tmp, "fetch", "--quiet", "--depth", "1", "origin", ref},
+			{"-C", tmp, "checkout", "--quiet", "FETCH_HEAD"},
+		}
+		for _, args := range cmds {
+			if err := f.runner.Run("git", args); err != nil {
+				return nil, fmt.Errorf("fetch ref %s of module repository %s: %w", ref, src.URL, err)
+			}
+		}
+		var out bytes.Buffer
+		if err := f.runner.Run("git", []string{"-C", tmp, "rev-parse", "HEAD"}, exec.Stdout(&out)); err != nil {
+			return nil, fmt.Errorf("get commit of module repository %s: %w", src.URL, err)
+		}
+		commit = strings.TrimSpace(out.String())
+	}
+
+	manifest, err := afero.ReadFile(f.fs, filepath.Join(dir, moduleManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("read module manifest from %s: %w", src, err)
+	}
+	tpl, err := afero.ReadFile(f.fs, filepath.Join(dir, moduleTemplateFileName))
+	if err != nil {
+		return nil, fmt.Errorf("read module template from %s: %w", src, err)
+	}
+	m, err := ParseModule(manifest, tpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse module from %s: %w", src, err)
+	}
+	m.Source = src
+	m.Commit = commit
+	return m, nil
+}
+
+// LockedModule records how an addon was created from a module.
+type LockedModule struct {
+	Workload string            `yaml:"workload"`
+	Addon    string            `yaml:"addon"`
+	Source   string            `yaml:"source"`
+	Commit   string            `yaml:"commit,omitempty"`
+	Values   map[string]string `yaml:"values,omitempty"`
+}
+
+// ModulesLock is the lockfile of the addons created from modules in a workspace.
+type ModulesLock struct {
+	Modules []LockedModule `yaml:"modules"`
+}
+
+// ParseModulesLock unmarshals the content of a lockfile.
+func ParseModulesLock(data []byte) (*ModulesLock, error) {
+	var lock ModulesLock
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("unmarshal addon modules lockfile: %w", err)
+	}
+	return &lock, nil
+}
+
+// Get returns the module that the addon of a workload was created from.
+func (l *ModulesLock) Get(workload, addon string) (LockedModule, bool) {
+	for _, m := range l.Modules {
+		if m.Workload == workload && m.Addon == addon {
+			return m, true
+		}
+	}
+	return LockedModule{}, false
+}
+
+// Set records the module of an addon, replacing the existing entry if there is one.
+func (l *ModulesLock) Set(module LockedModule) {
+	for i, m := range l.Modules {
+		if m.Workload == module.Workload && m.Addon == module.Addon {
+			l.Modules[i] = module
+			return
+		}
+	}
+	l.Modules = append(l.Modules, module)
+}
+
+// MarshalBinary serializes the lockfile into YAML.
+func (l *ModulesLock) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(l); err != nil {
+		return nil, fmt.Errorf("marshal addon modules lockfile: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,352 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addon
+
+import (
+	"errors"
+	"fmt"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseModuleSource(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    ModuleSource
+		wantedErr error
+	}{
+		"error if empty": {
+			wantedErr: errors.New("module source cannot be empty"),
+		},
+		"local directory": {
+			in:     "../modules/redis",
+			wanted: ModuleSource{Path: "../modules/redis"},
+		},
+		"git repository with directory and ref": {
+			in: "git::https://github.com/acme/modules.git//storage/redis?ref=v1.2.0",
+			wanted: ModuleSource{
+				URL:  "https://github.com/acme/modules.git",
+				Path: "storage/redis",
+				Ref:  "v1.2.0",
+			},
+		},
+		"git repository without directory": {
+			in: "git::git@github.com:acme/redis.git?ref=main",
+			wanted: ModuleSource{
+				URL: "git@github.com:acme/redis.git",
+				Ref: "main",
+			},
+		},
+		"error on unsupported query parameter": {
+			in:        "git::https://github.com/acme/modules.git?version=1",
+			wantedErr: errors.New(`module source git::https://github.com/acme/modules.git?version=1: unsupported query parameter "version"`),
+		},
+		"error if git repository is missing": {
+			in:        "git::?ref=v1",
+			wantedErr: errors.New("module source git::?ref=v1 must include a git repository URL"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseModuleSource(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.in, got.String())
+		})
+	}
+}
+
+const (
+	testModuleManifest = `name: redis
+description: An ElastiCache Redis cluster.
+values:
+  NodeType:
+    description: The compute and memory capacity of the nodes.
+    default: cache.t3.micro
+  Engine:
+    description: The version of Redis.
+`
+	testModuleTemplate = `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Cluster:
+    Type: AWS::ElastiCache::CacheCluster
+    Properties:
+      CacheNodeType: {{.Values.NodeType}}
+      EngineVersion: "{{.Values.Engine}}"
+`
+)
+
+func TestModule_Render(t *testing.T) {
+	testCases := map[string]struct {
+		manifest string
+		template string
+		values   map[string]string
+
+		wanted    string
+		wantedErr error
+	}{
+		"error on value not declared by the module": {
+			manifest:  testModuleManifest,
+			template:  testModuleTemplate,
+			values:    map[string]string{"Engine": "6.x", "Shards": "2"},
+			wantedErr: errors.New("value Shards is not declared by module redis"),
+		},
+		"error on missing required value": {
+			manifest:  testModuleManifest,
+			template:  testModuleTemplate,
+			wantedErr: errors.New("module redis requires values for Engine"),
+		},
+		"error if template references an undeclared value": {
+			manifest:  "name: bucket",
+			template:  "Resources: {{.Values.Bucket}}",
+			wantedErr: errors.New(`execute template of module bucket: template: bucket:1:20: executing "bucket" at <.Values.Bucket>: map has no entry for key "Bucket"`),
+		},
+		"error if the rendered template is not yaml": {
+			manifest:  "name: bucket",
+			template:  "Resources: [",
+			wantedErr: errors.New("unmarshal rendered template of module bucket: yaml: line 1: did not find expected node content"),
+		},
+		"error if the rendered template does not declare the reserved parameters": {
+			manifest: "name: bucket",
+			template: `Parameters:
+  App:
+    Type: String
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+`,
+			wantedErr: errors.New("rendered template of module bucket must declare the reserved parameters 'Env' and 'Name'"),
+		},
+		"renders template with provided values and defaults": {
+			manifest: testModuleManifest,
+			template: testModuleTemplate,
+			values:   map[string]string{"Engine": "6.x"},
+			wanted: strings.NewReplacer(
+				"{{.Values.NodeType}}", "cache.t3.micro",
+				"{{.Values.Engine}}", "6.x").Replace(testModuleTemplate),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m, err := ParseModule([]byte(tc.manifest), []byte(tc.template))
+			require.NoError(t, err)
+
+			tpl, err := m.Render(tc.values)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			out, err := tpl.MarshalBinary()
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
+
+func TestModuleFetcher_Fetch(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		src         ModuleSource
+		files       map[string]string
+		setupRunner func(m *mocks.Mockrunner)
+
+		wantedErr error
+	}{
+		"reads module from a local directory": {
+			src: ModuleSource{Path: "modules/redis"},
+			files: map[string]string{
+				"modules/redis/module.yml":   testModuleManifest,
+				"modules/redis/template.yml": testModuleTemplate,
+			},
+			setupRunner: func(m *mocks.Mockrunner) {},
+		},
+		"error if the module manifest is missing": {
+			src: ModuleSource{Path: "modules/redis"},
+			files: map[string]string{
+				"modules/redis/template.yml": testModuleTemplate,
+			},
+			setupRunner: func(m *mocks.Mockrunner) {},
+			wantedErr:   fmt.Errorf("read module manifest from modules/redis: open %s: file does not exist", filepath.Join("modules", "redis", "module.yml")),
+		},
+		"error if the module declares reserved values": {
+			src: ModuleSource{Path: "modules/redis"},
+			files: map[string]string{
+				"modules/redis/module.yml":   "name: redis\nvalues:\n  Env:\n    default: test\n",
+				"modules/redis/template.yml": testModuleTemplate,
+			},
+			setupRunner: func(m *mocks.Mockrunner) {},
+			wantedErr:   errors.New("parse module from modules/redis: reserved parameters 'App', 'Env', and 'Name' cannot be declared as values of module redis"),
+		},
+		"error if the module path resolves outside of the repository": {
+			src:         ModuleSource{URL: "https://github.com/acme/modules.git", Path: "../../etc"},
+			setupRunner: func(m *mocks.Mockrunner) {},
+			wantedErr:   errors.New("module path ../../etc resolves outside of repository https://github.com/acme/modules.git"),
+		},
+		"wraps error if the ref cannot be fetched": {
+			src: ModuleSource{URL: "https://github.com/acme/modules.git", Ref: "3f2a9c1"},
+			setupRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", gomock.Any()).Return(nil).Times(2)
+				m.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+					require.Equal(t, []string{"fetch", "--quiet", "--depth", "1", "origin", "3f2a9c1"}, args[2:])
+					return testErr
+				})
+			},
+			wantedErr: errors.New("fetch ref 3f2a9c1 of module repository https://github.com/acme/modules.git: some error"),
+		},
+		"wraps error if the commit cannot be retrieved": {
+			src: ModuleSource{URL: "https://github.com/acme/modules.git"},
+			setupRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("git", gomock.Any()).Return(nil).Times(4)
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(testErr)
+			},
+			wantedErr: errors.New("get commit of module repository https://github.com/acme/modules.git: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fs := afero.NewMemMapFs()
+			for name, content := range tc.files {
+				require.NoError(t, afero.WriteFile(fs, name, []byte(content), 0644))
+			}
+			runner := mocks.NewMockrunner(ctrl)
+			tc.setupRunner(runner)
+			fetcher := &ModuleFetcher{
+				fs:     fs,
+				runner: runner,
+			}
+
+			m, err := fetcher.Fetch(tc.src)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "redis", m.Name)
+			require.Equal(t, tc.src, m.Source)
+		})
+	}
+}
+
+func TestModuleFetcher_FetchGit(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	fs := afero.NewMemMapFs()
+	runner := mocks.NewMockrunner(ctrl)
+	var clonedDir string
+	gomock.InOrder(
+		runner.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+			clonedDir = args[len(args)-1]
+			require.Equal(t, []string{"init", "--quiet", clonedDir}, args)
+			return nil
+		}),
+		runner.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+			require.Equal(t, []string{"-C", clonedDir, "remote", "add", "origin", "https://github.com/acme/modules.git"}, args)
+			return nil
+		}),
+		runner.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+			require.Equal(t, []string{"-C", clonedDir, "fetch", "--quiet", "--depth", "1", "origin", "HEAD"}, args)
+			return nil
+		}),
+		runner.EXPECT().Run("git", gomock.Any()).DoAndReturn(func(_ string, args []string, _ ...exec.CmdOption) error {
+			require.Equal(t, []string{"-C", clonedDir, "checkout", "--quiet", "FETCH_HEAD"}, args)
+			afero.WriteFile(fs, filepath.Join(clonedDir, "storage", "redis", "module.yml"), []byte(testModuleManifest), 0644)
+			afero.WriteFile(fs, filepath.Join(clonedDir, "storage", "redis", "template.yml"), []byte(testModuleTemplate), 0644)
+			return nil
+		}),
+	)
+	runner.EXPECT().Run("git", gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, args []string, opts ...exec.CmdOption) error {
+		require.Equal(t, []string{"-C", clonedDir, "rev-parse", "HEAD"}, args)
+		cmd := &osexec.Cmd{}
+		for _, opt := range opts {
+			opt(cmd)
+		}
+		_, err := cmd.Stdout.Write([]byte("abc123\n"))
+		return err
+	})
+	fetcher := &ModuleFetcher{
+		fs:     fs,
+		runner: runner,
+	}
+
+	// WHEN
+	m, err := fetcher.Fetch(ModuleSource{URL: "https://github.com/acme/modules.git", Path: "storage/redis"})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "redis", m.Name)
+	require.Equal(t, "abc123", m.Commit)
+	exists, err := afero.Exists(fs, clonedDir)
+	require.NoError(t, err)
+	require.False(t, exists, "the cloned repository should be removed")
+}
+
+func TestModulesLock(t *testing.T) {
+	// GIVEN
+	lock, err := ParseModulesLock([]byte(`modules:
+  - workload: api
+    addon: cache
+    source: git::https://github.com/acme/modules.git//storage/redis?ref=v1.0.0
+    commit: abc123
+    values:
+      Engine: 6.x
+`))
+	require.NoError(t, err)
+
+	// WHEN
+	m, ok := lock.Get("api", "cache")
+
+	// THEN
+	require.True(t, ok)
+	require.Equal(t, "abc123", m.Commit)
+	require.Equal(t, map[string]string{"Engine": "6.x"}, m.Values)
+	_, ok = lock.Get("frontend", "cache")
+	require.False(t, ok)
+
+	// WHEN
+	m.Source = "git::https://github.com/acme/modules.git//storage/redis?ref=v1.1.0"
+	m.Commit = "def456"
+	lock.Set(m)
+	lock.Set(LockedModule{Workload: "frontend", Addon: "keys", Source: "../modules/kms"})
+	out, err := lock.MarshalBinary()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `modules:
+  - workload: api
+    addon: cache
+    source: git::https://github.com/acme/modules.git//storage/redis?ref=v1.1.0
+    commit: def456
+    values:
+      Engine: 6.x
+  - workload: frontend
+    addon: keys
+    source: ../modules/kms
+`, string(out))
+}
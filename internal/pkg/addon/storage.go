@@ -17,6 +17,8 @@ import (
 const (
 	dynamoDbTemplatePath = "addons/ddb/cf.yml"
 	s3TemplatePath       = "addons/s3/cf.yml"
+	redisTemplatePath    = "addons/redis/cf.yml"
+	sqsTemplatePath      = "addons/sqs/cf.yml"
 	rdsTemplatePath      = "addons/aurora/cf.yml"
 	rdsRDWSTemplatePath  = "addons/aurora/rdws/cf.yml"
 	rdsRDWSParamsPath    = "addons/aurora/rdws/addons.parameters.yml"
@@ -71,6 +73,40 @@ func (s *S3Template) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisTemplate contains configuration options which fully describe an ElastiCache Redis replication group.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisTemplatePath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// SQSTemplate contains configuration options which fully describe an SQS queue with a dead-letter queue.
+// Implements the encoding.BinaryMarshaler interface.
+type SQSTemplate struct {
+	SQSProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (s *SQSTemplate) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(sqsTemplatePath, *s, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 // RDSTemplate contains configuration options which fully describe a RDS Aurora Serverless cluster.
 // Implements the encoding.BinaryMarshaler interface.
 type RDSTemplate struct {
@@ -125,6 +161,34 @@ func NewS3Template(input *S3Props) *S3Template {
 	}
 }
 
+// RedisProps contains ElastiCache Redis-specific properties for addon.NewRedisTemplate().
+type RedisProps struct {
+	*StorageProps
+}
+
+// NewRedisTemplate creates a new ElastiCache Redis marshaler which can be used to write CF via addonWriter.
+func NewRedisTemplate(input *RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: *input,
+
+		parser: template.New(),
+	}
+}
+
+// SQSProps contains SQS-specific properties for addon.NewSQSTemplate().
+type SQSProps struct {
+	*StorageProps
+}
+
+// NewSQSTemplate creates a new SQS marshaler which can be used to write CF via addonWriter.
+func NewSQSTemplate(input *SQSProps) *SQSTemplate {
+	return &SQSTemplate{
+		SQSProps: *input,
+
+		parser: template.New(),
+	}
+}
+
 // DynamoDBProps contains DynamoDB-specific properties for addon.NewDDBTemplate().
 type DynamoDBProps struct {
 	*StorageProps
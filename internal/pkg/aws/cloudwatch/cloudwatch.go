@@ -6,6 +6,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,6 +23,10 @@ const (
 	cloudwatchResourceType = "cloudwatch:alarm"
 	compositeAlarmType     = "Composite"
 	metricAlarmType        = "Metric"
+
+	// Period and look-back window used to retrieve the latest datapoint of a metric.
+	latestMetricPeriod   = 60 * time.Second
+	latestMetricLookBack = 5 * time.Minute
 )
 
 // humanizeDuration is overridden in tests so that its output is constant as time passes.
@@ -29,6 +34,7 @@ var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error)
 }
 
 type resourceGetter interface {
@@ -39,6 +45,7 @@ type resourceGetter interface {
 type CloudWatch struct {
 	client   api
 	rgClient resourceGetter
+	now      func() time.Time
 }
 
 // AlarmStatus contains CloudWatch alarm status.
@@ -56,6 +63,7 @@ func New(s *session.Session) *CloudWatch {
 	return &CloudWatch{
 		client:   cloudwatch.New(s),
 		rgClient: rg.New(s),
+		now:      time.Now,
 	}
 }
 
@@ -138,6 +146,47 @@ func (cw *CloudWatch) metricAlarmsStatus(alarms []*cloudwatch.MetricAlarm) []Ala
 	return alarmStatusList
 }
 
+// LatestMaximum returns the maximum value of the most recent datapoint of a metric published in the last few minutes.
+// If there are no datapoints, it returns nil.
+func (cw *CloudWatch) LatestMaximum(namespace, metricName string, dimensions map[string]string) (*float64, error) {
+	var dims []*cloudwatch.Dimension
+	for name, value := range dimensions {
+		dims = append(dims, &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+	sort.SliceStable(dims, func(i, j int) bool {
+		return aws.StringValue(dims[i].Name) < aws.StringValue(dims[j].Name)
+	})
+	end := cw.now()
+	out, err := cw.client.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
+		Dimensions: dims,
+		StartTime:  aws.Time(end.Add(-latestMetricLookBack)),
+		EndTime:    aws.Time(end),
+		Period:     aws.Int64(int64(latestMetricPeriod.Seconds())),
+		Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get metric statistics for %s: %w", metricName, err)
+	}
+	var latest *cloudwatch.Datapoint
+	for _, dp := range out.Datapoints {
+		if dp == nil || dp.Timestamp == nil {
+			continue
+		}
+		if latest == nil || dp.Timestamp.After(*latest.Timestamp) {
+			latest = dp
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return latest.Maximum, nil
+}
+
 // getAlarmName gets the alarm name given a specific alarm ARN.
 // For example: arn:aws:cloudwatch:us-west-2:1234567890:alarm:SDc-ReadCapacityUnitsLimit-BasicAlarm
 // returns SDc-ReadCapacityUnitsLimit-BasicAlarm
@@ -353,3 +353,92 @@ func TestCloudWatch_AlarmsWithTags(t *testing.T) {
 
 	}
 }
+
+func TestCloudWatch_LatestMaximum(t *testing.T) {
+	mockNow := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	wantedInput := &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/SQS"),
+		MetricName: aws.String("ApproximateAgeOfOldestMessage"),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("QueueName"),
+				Value: aws.String("mockQueue"),
+			},
+		},
+		StartTime:  aws.Time(mockNow.Add(-5 * time.Minute)),
+		EndTime:    aws.Time(mockNow),
+		Period:     aws.Int64(60),
+		Statistics: aws.StringSlice([]string{"Maximum"}),
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantValue *float64
+	}{
+		"errors if failed to get metric statistics": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get metric statistics for ApproximateAgeOfOldestMessage: some error"),
+		},
+		"returns nil if there are no datapoints": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(wantedInput).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+		},
+		"returns the most recent datapoint": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetMetricStatistics(wantedInput).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{
+							Timestamp: aws.Time(mockNow.Add(-3 * time.Minute)),
+							Maximum:   aws.Float64(30),
+						},
+						{
+							Timestamp: aws.Time(mockNow.Add(-1 * time.Minute)),
+							Maximum:   aws.Float64(90),
+						},
+						{
+							Timestamp: aws.Time(mockNow.Add(-2 * time.Minute)),
+							Maximum:   aws.Float64(60),
+						},
+					},
+				}, nil)
+			},
+			wantValue: aws.Float64(90),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockcwClient)
+
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+				now: func() time.Time {
+					return mockNow
+				},
+			}
+
+			// WHEN
+			got, err := cwSvc.LatestMaximum("AWS/SQS", "ApproximateAgeOfOldestMessage", map[string]string{
+				"QueueName": "mockQueue",
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantValue, got)
+		})
+	}
+}
@@ -319,6 +319,22 @@ func (c *CodePipeline) getStage(s *cp.StageDeclaration) (*Stage, error) {
 	return stage, nil
 }
 
+// LatestExecutionStatus returns the status of the most recent execution of a pipeline, such as "InProgress" or "Succeeded".
+// If the pipeline was never executed, it returns an empty string.
+func (c *CodePipeline) LatestExecutionStatus(pipelineName string) (string, error) {
+	output, err := c.client.ListPipelineExecutions(&cp.ListPipelineExecutionsInput{
+		MaxResults:   aws.Int64(1),
+		PipelineName: aws.String(pipelineName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("list pipeline execution for %s: %w", pipelineName, err)
+	}
+	if len(output.PipelineExecutionSummaries) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(output.PipelineExecutionSummaries[0].Status), nil
+}
+
 // pipelineExecutionID returns the ExecutionID of the most recent execution of a pipeline.
 func (c *CodePipeline) pipelineExecutionID(pipelineName string) (string, error) {
 	input := &cp.ListPipelineExecutionsInput{
@@ -657,3 +657,70 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestCodePipeline_LatestExecutionStatus(t *testing.T) {
+	mockPipelineName := "pipeline-dinder-badgoose-repo"
+	mockInput := &codepipeline.ListPipelineExecutionsInput{
+		MaxResults:   aws.Int64(1),
+		PipelineName: aws.String(mockPipelineName),
+	}
+
+	tests := map[string]struct {
+		callMocks func(m codepipelineMocks)
+
+		wantedStatus string
+		wantedError  error
+	}{
+		"returns wrapped error if ListPipelineExecutions fails": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list pipeline execution for pipeline-dinder-badgoose-repo: some error"),
+		},
+		"returns an empty status if the pipeline was never executed": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(&codepipeline.ListPipelineExecutionsOutput{}, nil)
+			},
+		},
+		"returns the status of the latest execution": {
+			callMocks: func(m codepipelineMocks) {
+				m.cp.EXPECT().ListPipelineExecutions(mockInput).Return(&codepipeline.ListPipelineExecutionsOutput{
+					PipelineExecutionSummaries: []*codepipeline.PipelineExecutionSummary{
+						{
+							PipelineExecutionId: aws.String("12345678-fake-exec-utio-nid987654321"),
+							Status:              aws.String("InProgress"),
+						},
+					},
+				}, nil)
+			},
+			wantedStatus: "InProgress",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.callMocks(codepipelineMocks{
+				cp: mockClient,
+			})
+			cp := CodePipeline{
+				client: mockClient,
+			}
+
+			// WHEN
+			status, err := cp.LatestExecutionStatus(mockPipelineName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStatus, status)
+			}
+		})
+	}
+}
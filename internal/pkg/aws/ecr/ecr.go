@@ -26,11 +26,36 @@ const (
 	batchDeleteLimit  = 100
 )
 
+// FindingSeverities are the severities of image scan findings from the most to the least severe.
+var FindingSeverities = []string{
+	ecr.FindingSeverityCritical,
+	ecr.FindingSeverityHigh,
+	ecr.FindingSeverityMedium,
+	ecr.FindingSeverityLow,
+	ecr.FindingSeverityInformational,
+	ecr.FindingSeverityUndefined,
+}
+
+// Media types of the image manifests that can be retagged.
+var acceptedManifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+}
+
 type api interface {
 	DescribeImages(*ecr.DescribeImagesInput) (*ecr.DescribeImagesOutput, error)
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
+	PutImage(*ecr.PutImageInput) (*ecr.PutImageOutput, error)
+	PutImageScanningConfiguration(*ecr.PutImageScanningConfigurationInput) (*ecr.PutImageScanningConfigurationOutput, error)
+	StartImageScan(*ecr.StartImageScanInput) (*ecr.StartImageScanOutput, error)
+	DescribeImageScanFindings(*ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error)
+	WaitUntilImageScanComplete(*ecr.DescribeImageScanFindingsInput) error
+	DescribePullThroughCacheRules(*ecr.DescribePullThroughCacheRulesInput) (*ecr.DescribePullThroughCacheRulesOutput, error)
 }
 
 // ECR wraps an AWS ECR client.
@@ -127,6 +152,194 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 	return images, nil
 }
 
+// ImageDigest returns the digest of the image tagged with tag in the input ECR repository name.
+// If no image in the repository has the tag, it returns an empty string.
+func (c ECR) ImageDigest(repoName, tag string) (string, error) {
+	resp, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	})
+	if err != nil {
+		if isImageNotFoundErr(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("ecr repo %s describe image with tag %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", nil
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
+// TagImage adds the tags to the image with the digest in the input ECR repository name.
+func (c ECR) TagImage(repoName, digest string, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	resp, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RepositoryName:     aws.String(repoName),
+		AcceptedMediaTypes: aws.StringSlice(acceptedManifestMediaTypes),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s batch get image %s: %w", repoName, digest, err)
+	}
+	if len(resp.Images) == 0 {
+		return fmt.Errorf("image %s not found in ecr repo %s", digest, repoName)
+	}
+	image := resp.Images[0]
+	for _, tag := range tags {
+		_, err := c.client.PutImage(&ecr.PutImageInput{
+			RepositoryName:         aws.String(repoName),
+			ImageDigest:            aws.String(digest),
+			ImageManifest:          image.ImageManifest,
+			ImageManifestMediaType: image.ImageManifestMediaType,
+			ImageTag:               aws.String(tag),
+		})
+		if err != nil && !isImageAlreadyExistsErr(err) {
+			return fmt.Errorf("ecr repo %s tag image %s with %s: %w", repoName, digest, tag, err)
+		}
+	}
+	return nil
+}
+
+// EnableScanOnPush turns on the vulnerability scan of the images pushed to the input ECR repository name.
+func (c ECR) EnableScanOnPush(repoName string) error {
+	_, err := c.client.PutImageScanningConfiguration(&ecr.PutImageScanningConfigurationInput{
+		RepositoryName: aws.String(repoName),
+		ImageScanningConfiguration: &ecr.ImageScanningConfiguration{
+			ScanOnPush: aws.Bool(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s enable scan on push: %w", repoName, err)
+	}
+	return nil
+}
+
+// PullThroughCacheRules returns the upstream registry URLs of the pull through cache rules in the account and region
+// keyed by their ECR repository prefix.
+func (c ECR) PullThroughCacheRules() (map[string]string, error) {
+	rules := make(map[string]string)
+	in := &ecr.DescribePullThroughCacheRulesInput{}
+	for {
+		resp, err := c.client.DescribePullThroughCacheRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe pull through cache rules: %w", err)
+		}
+		for _, rule := range resp.PullThroughCacheRules {
+			rules[aws.StringValue(rule.EcrRepositoryPrefix)] = aws.StringValue(rule.UpstreamRegistryUrl)
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return rules, nil
+}
+
+// ImageScanFinding is a vulnerability found by the scan of an image.
+type ImageScanFinding struct {
+	Name     string
+	Severity string
+	Package  string // Name and version of the vulnerable package if the scan reports it.
+	URI      string
+}
+
+// ImageScanFindings holds the results of the vulnerability scan of an image.
+type ImageScanFindings struct {
+	SeverityCounts map[string]int64 // Number of findings keyed by severity.
+	Findings       []ImageScanFinding
+}
+
+// CountAtOrAbove returns the number of findings that are at least as severe as the input severity.
+func (f *ImageScanFindings) CountAtOrAbove(severity string) int64 {
+	var count int64
+	for _, s := range FindingSeverities {
+		count += f.SeverityCounts[s]
+		if s == severity {
+			break
+		}
+	}
+	return count
+}
+
+// ImageScanFindings waits for the scan of the image with the digest in the input ECR repository name to complete
+// and returns its findings. If the image wasn't scanned on push, it starts a scan first.
+func (c ECR) ImageScanFindings(repoName, digest string) (*ImageScanFindings, error) {
+	in := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+		},
+	}
+	if _, err := c.client.DescribeImageScanFindings(in); err != nil {
+		if !isScanNotFoundErr(err) {
+			return nil, fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", repoName, digest, err)
+		}
+		if _, err := c.client.StartImageScan(&ecr.StartImageScanInput{
+			RepositoryName: aws.String(repoName),
+			ImageId:        in.ImageId,
+		}); err != nil {
+			return nil, fmt.Errorf("ecr repo %s start scan of image %s: %w", repoName, digest, err)
+		}
+	}
+	if err := c.client.WaitUntilImageScanComplete(in); err != nil {
+		return nil, fmt.Errorf("ecr repo %s wait for scan of image %s to complete: %w", repoName, digest, err)
+	}
+
+	findings := &ImageScanFindings{
+		SeverityCounts: make(map[string]int64),
+	}
+	for {
+		resp, err := c.client.DescribeImageScanFindings(in)
+		if err != nil {
+			return nil, fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", repoName, digest, err)
+		}
+		if resp.ImageScanFindings == nil {
+			break
+		}
+		for severity, count := range resp.ImageScanFindings.FindingSeverityCounts {
+			findings.SeverityCounts[severity] = aws.Int64Value(count)
+		}
+		for _, f := range resp.ImageScanFindings.Findings {
+			findings.Findings = append(findings.Findings, ImageScanFinding{
+				Name:     aws.StringValue(f.Name),
+				Severity: aws.StringValue(f.Severity),
+				Package:  findingPackage(f.Attributes),
+				URI:      aws.StringValue(f.Uri),
+			})
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return findings, nil
+}
+
+// findingPackage returns the name and version of the package of a basic scan finding.
+func findingPackage(attributes []*ecr.Attribute) string {
+	var name, version string
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "package_name":
+			name = aws.StringValue(attr.Value)
+		case "package_version":
+			version = aws.StringValue(attr.Value)
+		}
+	}
+	return strings.TrimSpace(name + " " + version)
+}
+
 // DeleteImages calls the ECR BatchDeleteImage API with the input image list and repository name.
 func (c ECR) DeleteImages(images []Image, repoName string) error {
 	if len(images) == 0 {
@@ -204,3 +417,19 @@ func isRepoNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+func isImageNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeImageNotFoundException
+}
+
+func isScanNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeScanNotFoundException
+}
+
+// isImageAlreadyExistsErr returns true if the image is already tagged with the tag.
+func isImageAlreadyExistsErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeImageAlreadyExistsException
+}
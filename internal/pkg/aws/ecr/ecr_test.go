@@ -264,6 +264,459 @@ func TestListImages(t *testing.T) {
 	}
 }
 
+func TestImageDigest(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockTag := "mockTag"
+	mockDigest := "sha256:mockDigest"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantError  error
+	}{
+		"should wrap error returned by DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName describe image with tag mockTag: %w", mockError),
+		},
+		"should return empty digest if no image has the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeImageNotFoundException, "not found", nil))
+			},
+		},
+		"should return the digest of the image with the tag": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(&ecr.DescribeImagesInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageTag: aws.String(mockTag),
+						},
+					},
+				}).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String(mockDigest),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: mockDigest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotDigest, gotError := client.ImageDigest(mockRepoName, mockTag)
+
+			require.Equal(t, tc.wantDigest, gotDigest)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
+func TestEnableScanOnPush(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should wrap error returned by PutImageScanningConfiguration": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutImageScanningConfiguration(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName enable scan on push: %w", mockError),
+		},
+		"should enable scan on push": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().PutImageScanningConfiguration(&ecr.PutImageScanningConfigurationInput{
+					RepositoryName: aws.String("mockRepoName"),
+					ImageScanningConfiguration: &ecr.ImageScanningConfiguration{
+						ScanOnPush: aws.Bool(true),
+					},
+				}).Return(&ecr.PutImageScanningConfigurationOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotError := client.EnableScanOnPush("mockRepoName")
+
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
+func TestPullThroughCacheRules(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantRules map[string]string
+		wantError error
+	}{
+		"should wrap error returned by DescribePullThroughCacheRules": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribePullThroughCacheRules(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("describe pull through cache rules: %w", mockError),
+		},
+		"should return the rules of all pages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribePullThroughCacheRules(&ecr.DescribePullThroughCacheRulesInput{}).Return(&ecr.DescribePullThroughCacheRulesOutput{
+					PullThroughCacheRules: []*ecr.PullThroughCacheRule{
+						{
+							EcrRepositoryPrefix: aws.String("ecr-public"),
+							UpstreamRegistryUrl: aws.String("public.ecr.aws"),
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribePullThroughCacheRules(&ecr.DescribePullThroughCacheRulesInput{
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ecr.DescribePullThroughCacheRulesOutput{
+					PullThroughCacheRules: []*ecr.PullThroughCacheRule{
+						{
+							EcrRepositoryPrefix: aws.String("quay"),
+							UpstreamRegistryUrl: aws.String("quay.io"),
+						},
+					},
+				}, nil)
+			},
+			wantRules: map[string]string{
+				"ecr-public": "public.ecr.aws",
+				"quay":       "quay.io",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotRules, gotError := client.PullThroughCacheRules()
+
+			require.Equal(t, tc.wantError, gotError)
+			require.Equal(t, tc.wantRules, gotRules)
+		})
+	}
+}
+
+func TestImageScanFindings(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockDigest := "sha256:mockDigest"
+	mockError := errors.New("mockError")
+	mockOutput := &ecr.DescribeImageScanFindingsOutput{
+		ImageScanFindings: &ecr.ImageScanFindings{
+			FindingSeverityCounts: map[string]*int64{
+				ecr.FindingSeverityHigh: aws.Int64(1),
+				ecr.FindingSeverityLow:  aws.Int64(2),
+			},
+			Findings: []*ecr.ImageScanFinding{
+				{
+					Name:     aws.String("CVE-2021-3711"),
+					Severity: aws.String(ecr.FindingSeverityHigh),
+					Uri:      aws.String("https://security-tracker.debian.org/tracker/CVE-2021-3711"),
+					Attributes: []*ecr.Attribute{
+						{
+							Key:   aws.String("package_version"),
+							Value: aws.String("1.1.1k-1"),
+						},
+						{
+							Key:   aws.String("package_name"),
+							Value: aws.String("openssl"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantFindings *ImageScanFindings
+		wantError    error
+	}{
+		"should wrap error returned by DescribeImageScanFindings": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName describe scan findings of image sha256:mockDigest: %w", mockError),
+		},
+		"should wrap error returned by StartImageScan": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil))
+				m.EXPECT().StartImageScan(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName start scan of image sha256:mockDigest: %w", mockError),
+		},
+		"should wrap error returned while waiting for the scan": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName wait for scan of image sha256:mockDigest to complete: %w", mockError),
+		},
+		"should start a scan if the image wasn't scanned and return its findings": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil))
+				m.EXPECT().StartImageScan(&ecr.StartImageScanInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockDigest),
+					},
+				}).Return(&ecr.StartImageScanOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(mockOutput, nil)
+			},
+			wantFindings: &ImageScanFindings{
+				SeverityCounts: map[string]int64{
+					ecr.FindingSeverityHigh: 1,
+					ecr.FindingSeverityLow:  2,
+				},
+				Findings: []ImageScanFinding{
+					{
+						Name:     "CVE-2021-3711",
+						Severity: ecr.FindingSeverityHigh,
+						Package:  "openssl 1.1.1k-1",
+						URI:      "https://security-tracker.debian.org/tracker/CVE-2021-3711",
+					},
+				},
+			},
+		},
+		"should return the findings of all pages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{}, nil)
+				m.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanFindings: &ecr.ImageScanFindings{
+						FindingSeverityCounts: map[string]*int64{
+							ecr.FindingSeverityCritical: aws.Int64(2),
+						},
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-1"),
+								Severity: aws.String(ecr.FindingSeverityCritical),
+							},
+						},
+					},
+					NextToken: aws.String("mockNextToken"),
+				}, nil)
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId: &ecr.ImageIdentifier{
+						ImageDigest: aws.String(mockDigest),
+					},
+					NextToken: aws.String("mockNextToken"),
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanFindings: &ecr.ImageScanFindings{
+						FindingSeverityCounts: map[string]*int64{
+							ecr.FindingSeverityCritical: aws.Int64(2),
+						},
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-2"),
+								Severity: aws.String(ecr.FindingSeverityCritical),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantFindings: &ImageScanFindings{
+				SeverityCounts: map[string]int64{
+					ecr.FindingSeverityCritical: 2,
+				},
+				Findings: []ImageScanFinding{
+					{
+						Name:     "CVE-1",
+						Severity: ecr.FindingSeverityCritical,
+					},
+					{
+						Name:     "CVE-2",
+						Severity: ecr.FindingSeverityCritical,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotFindings, gotError := client.ImageScanFindings(mockRepoName, mockDigest)
+
+			require.Equal(t, tc.wantFindings, gotFindings)
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
+func TestImageScanFindings_CountAtOrAbove(t *testing.T) {
+	findings := &ImageScanFindings{
+		SeverityCounts: map[string]int64{
+			ecr.FindingSeverityCritical: 1,
+			ecr.FindingSeverityHigh:     2,
+			ecr.FindingSeverityLow:      4,
+		},
+	}
+
+	require.Equal(t, int64(1), findings.CountAtOrAbove(ecr.FindingSeverityCritical))
+	require.Equal(t, int64(3), findings.CountAtOrAbove(ecr.FindingSeverityHigh))
+	require.Equal(t, int64(3), findings.CountAtOrAbove(ecr.FindingSeverityMedium))
+	require.Equal(t, int64(7), findings.CountAtOrAbove(ecr.FindingSeverityLow))
+}
+
+func TestTagImage(t *testing.T) {
+	mockRepoName := "mockRepoName"
+	mockDigest := "sha256:mockDigest"
+	mockManifest := "mockManifest"
+	mockMediaType := "application/vnd.docker.distribution.manifest.v2+json"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		tags          []string
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantError error
+	}{
+		"should do nothing without tags": {
+			mockECRClient: func(m *mocks.Mockapi) {},
+		},
+		"should wrap error returned by BatchGetImage": {
+			tags: []string{"latest"},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName batch get image sha256:mockDigest: %w", mockError),
+		},
+		"should return error if the image does not exist": {
+			tags: []string{"latest"},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{}, nil)
+			},
+			wantError: errors.New("image sha256:mockDigest not found in ecr repo mockRepoName"),
+		},
+		"should wrap error returned by PutImage": {
+			tags: []string{"latest"},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(gomock.Any()).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifest:          aws.String(mockManifest),
+							ImageManifestMediaType: aws.String(mockMediaType),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutImage(gomock.Any()).Return(nil, mockError)
+			},
+			wantError: fmt.Errorf("ecr repo mockRepoName tag image sha256:mockDigest with latest: %w", mockError),
+		},
+		"should add every tag to the image and ignore tags that already exist": {
+			tags: []string{"latest", "v1.0.0"},
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(&ecr.BatchGetImageInput{
+					RepositoryName:     aws.String(mockRepoName),
+					AcceptedMediaTypes: aws.StringSlice(acceptedManifestMediaTypes),
+					ImageIds: []*ecr.ImageIdentifier{
+						{
+							ImageDigest: aws.String(mockDigest),
+						},
+					},
+				}).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{
+						{
+							ImageManifest:          aws.String(mockManifest),
+							ImageManifestMediaType: aws.String(mockMediaType),
+						},
+					},
+				}, nil)
+				m.EXPECT().PutImage(&ecr.PutImageInput{
+					RepositoryName:         aws.String(mockRepoName),
+					ImageDigest:            aws.String(mockDigest),
+					ImageManifest:          aws.String(mockManifest),
+					ImageManifestMediaType: aws.String(mockMediaType),
+					ImageTag:               aws.String("latest"),
+				}).Return(nil, awserr.New(ecr.ErrCodeImageAlreadyExistsException, "already exists", nil))
+				m.EXPECT().PutImage(&ecr.PutImageInput{
+					RepositoryName:         aws.String(mockRepoName),
+					ImageDigest:            aws.String(mockDigest),
+					ImageManifest:          aws.String(mockManifest),
+					ImageManifestMediaType: aws.String(mockMediaType),
+					ImageTag:               aws.String("v1.0.0"),
+				}).Return(&ecr.PutImageOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotError := client.TagImage(mockRepoName, mockDigest, tc.tags...)
+
+			require.Equal(t, tc.wantError, gotError)
+		})
+	}
+}
+
 func TestDeleteImages(t *testing.T) {
 	mockRepoName := "mockRepoName"
 	mockError := errors.New("mockError")
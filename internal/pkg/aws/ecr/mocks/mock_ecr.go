@@ -49,6 +49,36 @@ func (mr *MockapiMockRecorder) BatchDeleteImage(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeleteImage", reflect.TypeOf((*Mockapi)(nil).BatchDeleteImage), arg0)
 }
 
+// BatchGetImage mocks base method.
+func (m *Mockapi) BatchGetImage(arg0 *ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetImage", arg0)
+	ret0, _ := ret[0].(*ecr.BatchGetImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetImage indicates an expected call of BatchGetImage.
+func (mr *MockapiMockRecorder) BatchGetImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetImage", reflect.TypeOf((*Mockapi)(nil).BatchGetImage), arg0)
+}
+
+// DescribeImageScanFindings mocks base method.
+func (m *Mockapi) DescribeImageScanFindings(arg0 *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeImageScanFindings", arg0)
+	ret0, _ := ret[0].(*ecr.DescribeImageScanFindingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeImageScanFindings indicates an expected call of DescribeImageScanFindings.
+func (mr *MockapiMockRecorder) DescribeImageScanFindings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImageScanFindings", reflect.TypeOf((*Mockapi)(nil).DescribeImageScanFindings), arg0)
+}
+
 // DescribeImages mocks base method.
 func (m *Mockapi) DescribeImages(arg0 *ecr.DescribeImagesInput) (*ecr.DescribeImagesOutput, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +123,77 @@ func (mr *MockapiMockRecorder) GetAuthorizationToken(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationToken", reflect.TypeOf((*Mockapi)(nil).GetAuthorizationToken), arg0)
 }
+
+// PutImage mocks base method.
+func (m *Mockapi) PutImage(arg0 *ecr.PutImageInput) (*ecr.PutImageOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutImage", arg0)
+	ret0, _ := ret[0].(*ecr.PutImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImage indicates an expected call of PutImage.
+func (mr *MockapiMockRecorder) PutImage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImage", reflect.TypeOf((*Mockapi)(nil).PutImage), arg0)
+}
+
+// DescribePullThroughCacheRules mocks base method.
+func (m *Mockapi) DescribePullThroughCacheRules(arg0 *ecr.DescribePullThroughCacheRulesInput) (*ecr.DescribePullThroughCacheRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePullThroughCacheRules", arg0)
+	ret0, _ := ret[0].(*ecr.DescribePullThroughCacheRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePullThroughCacheRules indicates an expected call of DescribePullThroughCacheRules.
+func (mr *MockapiMockRecorder) DescribePullThroughCacheRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePullThroughCacheRules", reflect.TypeOf((*Mockapi)(nil).DescribePullThroughCacheRules), arg0)
+}
+
+// PutImageScanningConfiguration mocks base method.
+func (m *Mockapi) PutImageScanningConfiguration(arg0 *ecr.PutImageScanningConfigurationInput) (*ecr.PutImageScanningConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutImageScanningConfiguration", arg0)
+	ret0, _ := ret[0].(*ecr.PutImageScanningConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutImageScanningConfiguration indicates an expected call of PutImageScanningConfiguration.
+func (mr *MockapiMockRecorder) PutImageScanningConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutImageScanningConfiguration", reflect.TypeOf((*Mockapi)(nil).PutImageScanningConfiguration), arg0)
+}
+
+// StartImageScan mocks base method.
+func (m *Mockapi) StartImageScan(arg0 *ecr.StartImageScanInput) (*ecr.StartImageScanOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImageScan", arg0)
+	ret0, _ := ret[0].(*ecr.StartImageScanOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImageScan indicates an expected call of StartImageScan.
+func (mr *MockapiMockRecorder) StartImageScan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImageScan", reflect.TypeOf((*Mockapi)(nil).StartImageScan), arg0)
+}
+
+// WaitUntilImageScanComplete mocks base method.
+func (m *Mockapi) WaitUntilImageScanComplete(arg0 *ecr.DescribeImageScanFindingsInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilImageScanComplete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilImageScanComplete indicates an expected call of WaitUntilImageScanComplete.
+func (mr *MockapiMockRecorder) WaitUntilImageScanComplete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilImageScanComplete", reflect.TypeOf((*Mockapi)(nil).WaitUntilImageScanComplete), arg0)
+}
@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,6 +24,7 @@ const (
 	waitServiceStablePollingInterval = 15 * time.Second
 	waitServiceStableMaxTry          = 80
 	stableServiceDeploymentNum       = 1
+	describeTasksBatchSize           = 100 // Maximum number of tasks that DescribeTasks accepts.
 )
 
 type api interface {
@@ -40,6 +42,7 @@ type api interface {
 
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartSessionWithIO(ssmSession *ecs.Session, stdin io.Reader, stdout io.Writer) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -61,6 +64,15 @@ type RunTaskInput struct {
 	StartedBy       string
 	PlatformVersion string
 	EnableExec      bool
+
+	AssignPublicIP     string               // Optional. Defaults to "ENABLED".
+	ContainerOverrides []*ContainerOverride // Optional. Overrides the command of containers in the task definition.
+}
+
+// ContainerOverride holds the command to run in place of the one in the container definition.
+type ContainerOverride struct {
+	Name    string
+	Command []string
 }
 
 // ExecuteCommandInput holds the fields needed to execute commands in a running container.
@@ -69,6 +81,11 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+
+	// Optional. If either is set, the session reads its input from Stdin and writes its output to Stdout
+	// instead of the terminal.
+	Stdin  io.Reader
+	Stdout io.Writer
 }
 
 // New returns a Service configured against the input session.
@@ -319,6 +336,20 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
+	assignPublicIP := ecs.AssignPublicIpEnabled
+	if input.AssignPublicIP != "" {
+		assignPublicIP = input.AssignPublicIP
+	}
+	var overrides *ecs.TaskOverride
+	if len(input.ContainerOverrides) > 0 {
+		overrides = &ecs.TaskOverride{}
+		for _, override := range input.ContainerOverrides {
+			overrides.ContainerOverrides = append(overrides.ContainerOverrides, &ecs.ContainerOverride{
+				Name:    aws.String(override.Name),
+				Command: aws.StringSlice(override.Command),
+			})
+		}
+	}
 	resp, err := e.client.RunTask(&ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
@@ -327,11 +358,12 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 		TaskDefinition: aws.String(input.TaskFamilyName),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
-				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+				AssignPublicIp: aws.String(assignPublicIP),
 				Subnets:        aws.StringSlice(input.Subnets),
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
+		Overrides:            overrides,
 		EnableExecuteCommand: aws.Bool(input.EnableExec),
 		PlatformVersion:      aws.String(input.PlatformVersion),
 		PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
@@ -368,20 +400,26 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 }
 
 // DescribeTasks returns the tasks with the taskARNs in the cluster.
+// The tasks are described in batches of at most 100 tasks, the limit of the API.
 func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error) {
-	resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
-		Cluster: aws.String(cluster),
-		Tasks:   aws.StringSlice(taskARNs),
-		Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("describe tasks: %w", err)
-	}
-
-	tasks := make([]*Task, len(resp.Tasks))
-	for idx, task := range resp.Tasks {
-		t := Task(*task)
-		tasks[idx] = &t
+	tasks := make([]*Task, 0, len(taskARNs))
+	for start := 0; start < len(taskARNs); start += describeTasksBatchSize {
+		end := start + describeTasksBatchSize
+		if end > len(taskARNs) {
+			end = len(taskARNs)
+		}
+		resp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   aws.StringSlice(taskARNs[start:end]),
+			Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tasks: %w", err)
+		}
+		for _, task := range resp.Tasks {
+			t := Task(*task)
+			tasks = append(tasks, &t)
+		}
 	}
 	return tasks, nil
 }
@@ -399,7 +437,12 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		return &ErrExecuteCommand{err: err}
 	}
 	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
+	if in.Stdin != nil || in.Stdout != nil {
+		err = e.newSessStarter().StartSessionWithIO(execCmdresp.Session, in.Stdin, in.Stdout)
+	} else {
+		err = e.newSessStarter().StartSession(execCmdresp.Session)
+	}
+	if err != nil {
 		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
 	}
 	return err
@@ -4,8 +4,11 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -865,6 +868,9 @@ func TestECS_RunTask(t *testing.T) {
 		startedBy       string
 		platformVersion string
 		enableExec      bool
+
+		assignPublicIP     string
+		containerOverrides []*ContainerOverride
 	}
 
 	runTaskInput := input{
@@ -965,6 +971,62 @@ func TestECS_RunTask(t *testing.T) {
 			},
 			wantedError: errors.New("run task(s) my-task: error"),
 		},
+		"run task with container overrides and private networking": {
+			input: input{
+				cluster:            "my-cluster",
+				count:              1,
+				subnets:            []string{"subnet-1"},
+				securityGroups:     []string{"sg-1"},
+				taskFamilyName:     "arn:aws:ecs:us-west-2:123456789:task-definition/my-app-test-api:3",
+				startedBy:          "task",
+				platformVersion:    "LATEST",
+				assignPublicIP:     ecs.AssignPublicIpDisabled,
+				containerOverrides: []*ContainerOverride{{Name: "api", Command: []string{"rake", "db:migrate"}}},
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster:        aws.String("my-cluster"),
+					Count:          aws.Int64(1),
+					LaunchType:     aws.String(ecs.LaunchTypeFargate),
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789:task-definition/my-app-test-api:3"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+						},
+					},
+					Overrides: &ecs.TaskOverride{
+						ContainerOverrides: []*ecs.ContainerOverride{
+							{
+								Name:    aws.String("api"),
+								Command: aws.StringSlice([]string{"rake", "db:migrate"}),
+							},
+						},
+					},
+					EnableExecuteCommand: aws.Bool(false),
+					PlatformVersion:      aws.String("LATEST"),
+					PropagateTags:        aws.String(ecs.PropagateTagsTaskDefinition),
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+				in := ecs.DescribeTasksInput{
+					Cluster: aws.String("my-cluster"),
+					Tasks:   aws.StringSlice([]string{"task-1"}),
+					Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+				}
+				m.EXPECT().WaitUntilTasksRunning(&in).Times(1)
+				m.EXPECT().DescribeTasks(&in).Return(&ecs.DescribeTasksOutput{
+					Tasks: ecsTasks[:1],
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+			},
+		},
 		"failed to call WaitUntilTasksRunning": {
 			input: runTaskInput,
 
@@ -1065,6 +1127,9 @@ func TestECS_RunTask(t *testing.T) {
 				StartedBy:       tc.startedBy,
 				PlatformVersion: tc.platformVersion,
 				EnableExec:      tc.enableExec,
+
+				AssignPublicIP:     tc.assignPublicIP,
+				ContainerOverrides: tc.containerOverrides,
 			})
 
 			if tc.wantedError != nil {
@@ -1151,6 +1216,46 @@ func TestECS_DescribeTasks(t *testing.T) {
 	}
 }
 
+func TestECS_DescribeTasks_Batches(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	var taskARNs []string
+	for i := 0; i < 101; i++ {
+		taskARNs = append(taskARNs, fmt.Sprintf("task-%d", i))
+	}
+	mockAPI := mocks.NewMockapi(ctrl)
+	gomock.InOrder(
+		mockAPI.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String("my-cluster"),
+			Tasks:   aws.StringSlice(taskARNs[:100]),
+			Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+		}).Return(&ecs.DescribeTasksOutput{
+			Tasks: []*ecs.Task{{TaskArn: aws.String("task-0")}},
+		}, nil),
+		mockAPI.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+			Cluster: aws.String("my-cluster"),
+			Tasks:   aws.StringSlice(taskARNs[100:]),
+			Include: aws.StringSlice([]string{ecs.TaskFieldTags}),
+		}).Return(&ecs.DescribeTasksOutput{
+			Tasks: []*ecs.Task{{TaskArn: aws.String("task-100")}},
+		}, nil),
+	)
+	ecs := ECS{
+		client: mockAPI,
+	}
+
+	// WHEN
+	tasks, err := ecs.DescribeTasks("my-cluster", taskARNs)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*Task{
+		{TaskArn: aws.String("task-0")},
+		{TaskArn: aws.String("task-100")},
+	}, tasks)
+}
+
 func TestECS_ExecuteCommand(t *testing.T) {
 	mockExecCmdIn := &ecs.ExecuteCommandInput{
 		Cluster:     aws.String("mockCluster"),
@@ -1163,7 +1268,10 @@ func TestECS_ExecuteCommand(t *testing.T) {
 		SessionId: aws.String("mockSessID"),
 	}
 	mockErr := errors.New("some error")
+	mockStdin, mockStdout := strings.NewReader("mockInput"), &bytes.Buffer{}
 	testCases := map[string]struct {
+		inStdin         io.Reader
+		inStdout        io.Writer
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
 		wantedError     error
@@ -1202,6 +1310,18 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				m.EXPECT().StartSession(mockSess).Return(nil)
 			},
 		},
+		"success with custom input and output": {
+			inStdin:  mockStdin,
+			inStdout: mockStdout,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithIO(mockSess, mockStdin, mockStdout).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1226,6 +1346,8 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Stdin:     tc.inStdin,
+				Stdout:    tc.inStdout,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
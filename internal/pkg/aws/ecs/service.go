@@ -19,6 +19,9 @@ const (
 	ServiceDeploymentStatusPrimary = "PRIMARY"
 	// ServiceDeploymentStatusActive is the status ACTIVE of an ECS service deployment.
 	ServiceDeploymentStatusActive = "ACTIVE"
+
+	// ServiceDeploymentRolloutStateFailed is the rollout state FAILED of an ECS service deployment.
+	ServiceDeploymentRolloutStateFailed = "FAILED"
 )
 
 // Service wraps up ECS Service struct.
@@ -33,6 +36,7 @@ type Deployment struct {
 	LaunchType     string    `json:"launchType"`
 	TaskDefinition string    `json:"taskDefinition"`
 	Status         string    `json:"status"`
+	RolloutState   string    `json:"rolloutState,omitempty"`
 }
 
 // ServiceStatus contains the status info of a service.
@@ -57,6 +61,7 @@ func (s *Service) ServiceStatus() ServiceStatus {
 			LaunchType:     aws.StringValue(dp.LaunchType),
 			TaskDefinition: aws.StringValue(dp.TaskDefinition),
 			Status:         aws.StringValue(dp.Status),
+			RolloutState:   aws.StringValue(dp.RolloutState),
 		})
 	}
 
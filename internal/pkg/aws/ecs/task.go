@@ -134,6 +134,31 @@ func (t *Task) PrivateIP() (string, error) {
 	}
 }
 
+// SSMTarget returns the Session Manager target of the managed agent running alongside the named container.
+// For example, a container with runtime ID 4082490ee6c245e09d2145010aa1ba8d-2 in task 4082490ee6c245e09d2145010aa1ba8d
+// of cluster "my-cluster" becomes "ecs:my-cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2".
+func (t *Task) SSMTarget(container string) (string, error) {
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	cluster := aws.StringValue(t.ClusterArn)
+	if parsed, err := arn.Parse(cluster); err == nil {
+		cluster = strings.TrimPrefix(parsed.Resource, "cluster/")
+	}
+	for _, c := range t.Containers {
+		if aws.StringValue(c.Name) != container {
+			continue
+		}
+		runtimeID := aws.StringValue(c.RuntimeId)
+		if runtimeID == "" {
+			return "", fmt.Errorf("container %s in task %s does not have a runtime ID yet", container, taskID)
+		}
+		return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, runtimeID), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", container, taskID)
+}
+
 func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 	// Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 	// So an error is warranted if there is no ENI found.
@@ -306,6 +306,66 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		containers   []*ecs.Container
+		inContainer  string
+		wantedTarget string
+		wantedErr    error
+	}{
+		"container not found": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("sidecar"),
+				},
+			},
+			inContainer: "frontend",
+			wantedErr:   errors.New("container frontend not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"container without runtime ID": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("frontend"),
+				},
+			},
+			inContainer: "frontend",
+			wantedErr:   errors.New("container frontend in task 4082490ee6c245e09d2145010aa1ba8d does not have a runtime ID yet"),
+		},
+		"success": {
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("sidecar"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1"),
+				},
+				{
+					Name:      aws.String("frontend"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2"),
+				},
+			},
+			inContainer:  "frontend",
+			wantedTarget: "ecs:my-cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/my-cluster"),
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"),
+				Containers: tc.containers,
+			}
+
+			out, err := task.SSMTarget(tc.inContainer)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTarget, out)
+			}
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
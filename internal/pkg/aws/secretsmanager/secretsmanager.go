@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +18,7 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +42,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// FromSession returns a SecretsManager configured against the input session.
+func FromSession(sess *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(sess),
+		sessionRegion:  aws.StringValue(sess.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -88,6 +98,17 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+// GetSecretValue returns the string value of the secret.
+func (s *SecretsManager) GetSecretValue(secretName string) (string, error) {
+	resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get value of secret %s: %w", secretName, err)
+	}
+	return aws.StringValue(resp.SecretString), nil
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -105,3 +105,55 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretValue(t *testing.T) {
+	mockSecretName := "registry-credentials"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("get value of secret %s: %w", mockSecretName, mockError),
+		},
+		"should return the secret string if successful": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretName),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String(`{"username":"goose","password":"H0NK"}`),
+				}, nil)
+			},
+			wantedValue: `{"username":"goose","password":"H0NK"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.GetSecretValue(mockSecretName)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedValue, got)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+const (
+	// maxBatchSize is the maximum number of messages that can be received, sent, or deleted in a single request.
+	maxBatchSize = 10
+	// receiveWaitTimeSeconds enables long polling so that requests are less likely to return empty responses.
+	receiveWaitTimeSeconds = 1
+
+	attrMessageGroupID          = "MessageGroupId"
+	attrApproximateReceiveCount = "ApproximateReceiveCount"
+)
+
+type api interface {
+	GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+	ReceiveMessage(input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+	ChangeMessageVisibilityBatch(input *sqs.ChangeMessageVisibilityBatchInput) (*sqs.ChangeMessageVisibilityBatchOutput, error)
+	SendMessageBatch(input *sqs.SendMessageBatchInput) (*sqs.SendMessageBatchOutput, error)
+	DeleteMessageBatch(input *sqs.DeleteMessageBatchInput) (*sqs.DeleteMessageBatchOutput, error)
+}
+
+// SQS wraps an Amazon Simple Queue Service client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS struct configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// QueueAttributes holds the approximate counts of messages in a queue.
+type QueueAttributes struct {
+	ARN      string
+	Visible  int64 // Messages available for retrieval.
+	InFlight int64 // Messages received by a consumer but not yet deleted.
+	Delayed  int64 // Messages not yet available because of a delay.
+}
+
+// Message is a message received from a queue.
+type Message struct {
+	ID                string            `json:"id"`
+	Body              string            `json:"body"`
+	Attributes        map[string]string `json:"attributes"`
+	MessageAttributes map[string]string `json:"messageAttributes"`
+}
+
+// QueueAttributes returns the message counts of the queue.
+func (s *SQS) QueueAttributes(url string) (*QueueAttributes, error) {
+	out, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(url),
+		AttributeNames: aws.StringSlice([]string{
+			sqs.QueueAttributeNameQueueArn,
+			sqs.QueueAttributeNameApproximateNumberOfMessages,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attributes of queue %s: %w", url, err)
+	}
+	attrs := &QueueAttributes{
+		ARN: aws.StringValue(out.Attributes[sqs.QueueAttributeNameQueueArn]),
+	}
+	counts := []struct {
+		name string
+		dst  *int64
+	}{
+		{sqs.QueueAttributeNameApproximateNumberOfMessages, &attrs.Visible},
+		{sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible, &attrs.InFlight},
+		{sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed, &attrs.Delayed},
+	}
+	for _, count := range counts {
+		val, ok := out.Attributes[count.name]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(aws.StringValue(val), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse attribute %s of queue %s: %w", count.name, url, err)
+		}
+		*count.dst = n
+	}
+	return attrs, nil
+}
+
+// Peek returns up to max messages from the queue without deleting them.
+// The messages are made visible again to other consumers once they're all retrieved.
+func (s *SQS) Peek(url string, max int) ([]*Message, error) {
+	var received []*sqs.Message
+	seen := make(map[string]bool)
+	for len(received) < max {
+		out, err := s.receive(url, max-len(received))
+		if err != nil {
+			_ = s.releaseAll(url, received)
+			return nil, err
+		}
+		var added int
+		for _, msg := range out {
+			if seen[aws.StringValue(msg.MessageId)] {
+				continue
+			}
+			seen[aws.StringValue(msg.MessageId)] = true
+			received = append(received, msg)
+			added++
+		}
+		if added == 0 {
+			break
+		}
+	}
+	if err := s.releaseAll(url, received); err != nil {
+		return nil, err
+	}
+	msgs := make([]*Message, len(received))
+	for i, msg := range received {
+		msgs[i] = convertMessage(msg)
+	}
+	return msgs, nil
+}
+
+// MoveMessages moves up to max messages, in at most one batch, from the source queue to the destination queue.
+// Messages are deleted from the source queue only once they're successfully sent to the destination.
+// It returns the number of messages moved, which is 0 if the source queue is empty.
+func (s *SQS) MoveMessages(srcURL, dstURL string, max int) (int, error) {
+	if max > maxBatchSize {
+		max = maxBatchSize
+	}
+	msgs, err := s.receive(srcURL, max)
+	if err != nil {
+		return 0, err
+	}
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+	entries := make([]*sqs.SendMessageBatchRequestEntry, len(msgs))
+	for i, msg := range msgs {
+		entry := &sqs.SendMessageBatchRequestEntry{
+			Id:                aws.String(strconv.Itoa(i)),
+			MessageBody:       msg.Body,
+			MessageAttributes: msg.MessageAttributes,
+		}
+		if groupID := msg.Attributes[attrMessageGroupID]; groupID != nil {
+			// Reusing the original deduplication ID would make a FIFO queue drop the message if it was
+			// sent within the deduplication interval, after which it's deleted from the source queue.
+			entry.MessageGroupId = groupID
+			entry.MessageDeduplicationId = aws.String(fmt.Sprintf("%s-%s",
+				aws.StringValue(msg.MessageId), aws.StringValue(msg.Attributes[attrApproximateReceiveCount])))
+		}
+		entries[i] = entry
+	}
+	out, err := s.client.SendMessageBatch(&sqs.SendMessageBatchInput{
+		QueueUrl: aws.String(dstURL),
+		Entries:  entries,
+	})
+	if err != nil {
+		// Make the messages available again right away instead of waiting for the visibility timeout.
+		_ = s.release(srcURL, msgs)
+		return 0, fmt.Errorf("send messages to queue %s: %w", dstURL, err)
+	}
+	var sent, failed []*sqs.Message
+	for _, entry := range out.Successful {
+		i, _ := strconv.Atoi(aws.StringValue(entry.Id))
+		sent = append(sent, msgs[i])
+	}
+	for _, entry := range out.Failed {
+		i, _ := strconv.Atoi(aws.StringValue(entry.Id))
+		failed = append(failed, msgs[i])
+	}
+	if len(sent) > 0 {
+		if err := s.delete(srcURL, sent); err != nil {
+			return 0, err
+		}
+	}
+	if len(failed) > 0 {
+		_ = s.release(srcURL, failed)
+		return len(sent), fmt.Errorf("send %d messages to queue %s: %s", len(failed), dstURL, aws.StringValue(out.Failed[0].Message))
+	}
+	return len(sent), nil
+}
+
+func (s *SQS) receive(url string, max int) ([]*sqs.Message, error) {
+	if max > maxBatchSize {
+		max = maxBatchSize
+	}
+	out, err := s.client.ReceiveMessage(&sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(url),
+		MaxNumberOfMessages:   aws.Int64(int64(max)),
+		WaitTimeSeconds:       aws.Int64(receiveWaitTimeSeconds),
+		AttributeNames:        aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		MessageAttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("receive messages from queue %s: %w", url, err)
+	}
+	return out.Messages, nil
+}
+
+// release makes the messages visible again to other consumers.
+func (s *SQS) release(url string, msgs []*sqs.Message) error {
+	entries := make([]*sqs.ChangeMessageVisibilityBatchRequestEntry, len(msgs))
+	for i, msg := range msgs {
+		entries[i] = &sqs.ChangeMessageVisibilityBatchRequestEntry{
+			Id:                aws.String(strconv.Itoa(i)),
+			ReceiptHandle:     msg.ReceiptHandle,
+			VisibilityTimeout: aws.Int64(0),
+		}
+	}
+	if _, err := s.client.ChangeMessageVisibilityBatch(&sqs.ChangeMessageVisibilityBatchInput{
+		QueueUrl: aws.String(url),
+		Entries:  entries,
+	}); err != nil {
+		return fmt.Errorf("release messages back to queue %s: %w", url, err)
+	}
+	return nil
+}
+
+func (s *SQS) releaseAll(url string, msgs []*sqs.Message) error {
+	for start := 0; start < len(msgs); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		if err := s.release(url, msgs[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQS) delete(url string, msgs []*sqs.Message) error {
+	entries := make([]*sqs.DeleteMessageBatchRequestEntry, len(msgs))
+	for i, msg := range msgs {
+		entries[i] = &sqs.DeleteMessageBatchRequestEntry{
+			Id:            aws.String(strconv.Itoa(i)),
+			ReceiptHandle: msg.ReceiptHandle,
+		}
+	}
+	out, err := s.client.DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+		QueueUrl: aws.String(url),
+		Entries:  entries,
+	})
+	if err != nil {
+		return fmt.Errorf("delete messages from queue %s: %w", url, err)
+	}
+	if len(out.Failed) > 0 {
+		return fmt.Errorf("delete %d messages from queue %s: %s", len(out.Failed), url, aws.StringValue(out.Failed[0].Message))
+	}
+	return nil
+}
+
+func convertMessage(msg *sqs.Message) *Message {
+	out := &Message{
+		ID:                aws.StringValue(msg.MessageId),
+		Body:              aws.StringValue(msg.Body),
+		Attributes:        aws.StringValueMap(msg.Attributes),
+		MessageAttributes: make(map[string]string),
+	}
+	for name, attr := range msg.MessageAttributes {
+		if attr == nil {
+			continue
+		}
+		if attr.StringValue != nil {
+			out.MessageAttributes[name] = aws.StringValue(attr.StringValue)
+			continue
+		}
+		out.MessageAttributes[name] = string(attr.BinaryValue)
+	}
+	return out
+}
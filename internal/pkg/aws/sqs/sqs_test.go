@@ -0,0 +1,390 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-EventsQueue"
+	mockDLQURL   = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-DeadLetterQueue"
+)
+
+func TestSQS_QueueAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedAttrs *QueueAttributes
+		wantedErr   error
+	}{
+		"errors if failed to get queue attributes": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get attributes of queue " + mockQueueURL + ": some error"),
+		},
+		"errors if a count is not a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"ApproximateNumberOfMessages": aws.String("many"),
+					},
+				}, nil)
+			},
+			wantedErr: errors.New(`parse attribute ApproximateNumberOfMessages of queue ` + mockQueueURL + `: strconv.ParseInt: parsing "many": invalid syntax`),
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+					QueueUrl: aws.String(mockQueueURL),
+					AttributeNames: aws.StringSlice([]string{
+						"QueueArn",
+						"ApproximateNumberOfMessages",
+						"ApproximateNumberOfMessagesNotVisible",
+						"ApproximateNumberOfMessagesDelayed",
+					}),
+				}).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"QueueArn":                              aws.String("arn:aws:sqs:us-west-2:123456789012:app-test-worker-EventsQueue"),
+						"ApproximateNumberOfMessages":           aws.String("12"),
+						"ApproximateNumberOfMessagesNotVisible": aws.String("3"),
+						"ApproximateNumberOfMessagesDelayed":    aws.String("0"),
+					},
+				}, nil)
+			},
+			wantedAttrs: &QueueAttributes{
+				ARN:      "arn:aws:sqs:us-west-2:123456789012:app-test-worker-EventsQueue",
+				Visible:  12,
+				InFlight: 3,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := SQS{client: m}
+
+			// WHEN
+			got, err := client.QueueAttributes(mockQueueURL)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAttrs, got)
+		})
+	}
+}
+
+func TestSQS_Peek(t *testing.T) {
+	msg := func(id string) *sqs.Message {
+		return &sqs.Message{
+			MessageId:     aws.String(id),
+			Body:          aws.String("body-" + id),
+			ReceiptHandle: aws.String("handle-" + id),
+			Attributes: map[string]*string{
+				"ApproximateReceiveCount": aws.String("3"),
+			},
+			MessageAttributes: map[string]*sqs.MessageAttributeValue{
+				"type": {
+					DataType:    aws.String("String"),
+					StringValue: aws.String("order"),
+				},
+			},
+		}
+	}
+	wantedMsg := func(id string) *Message {
+		return &Message{
+			ID:   id,
+			Body: "body-" + id,
+			Attributes: map[string]string{
+				"ApproximateReceiveCount": "3",
+			},
+			MessageAttributes: map[string]string{
+				"type": "order",
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		max        int
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedMsgs []*Message
+		wantedErr  error
+	}{
+		"errors if failed to receive messages": {
+			max: 5,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("receive messages from queue " + mockDLQURL + ": some error"),
+		},
+		"errors if failed to release messages": {
+			max: 1,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{msg("1")},
+				}, nil)
+				m.EXPECT().ChangeMessageVisibilityBatch(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("release messages back to queue " + mockDLQURL + ": some error"),
+		},
+		"returns no messages if the queue is empty": {
+			max: 5,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{}, nil)
+			},
+			wantedMsgs: []*Message{},
+		},
+		"receives until max and releases every message": {
+			max: 3,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{
+						QueueUrl:              aws.String(mockDLQURL),
+						MaxNumberOfMessages:   aws.Int64(3),
+						WaitTimeSeconds:       aws.Int64(1),
+						AttributeNames:        aws.StringSlice([]string{"All"}),
+						MessageAttributeNames: aws.StringSlice([]string{"All"}),
+					}).Return(&sqs.ReceiveMessageOutput{
+						Messages: []*sqs.Message{msg("1"), msg("2")},
+					}, nil),
+					m.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{
+						QueueUrl:              aws.String(mockDLQURL),
+						MaxNumberOfMessages:   aws.Int64(1),
+						WaitTimeSeconds:       aws.Int64(1),
+						AttributeNames:        aws.StringSlice([]string{"All"}),
+						MessageAttributeNames: aws.StringSlice([]string{"All"}),
+					}).Return(&sqs.ReceiveMessageOutput{
+						Messages: []*sqs.Message{msg("3")},
+					}, nil),
+					m.EXPECT().ChangeMessageVisibilityBatch(&sqs.ChangeMessageVisibilityBatchInput{
+						QueueUrl: aws.String(mockDLQURL),
+						Entries: []*sqs.ChangeMessageVisibilityBatchRequestEntry{
+							{Id: aws.String("0"), ReceiptHandle: aws.String("handle-1"), VisibilityTimeout: aws.Int64(0)},
+							{Id: aws.String("1"), ReceiptHandle: aws.String("handle-2"), VisibilityTimeout: aws.Int64(0)},
+							{Id: aws.String("2"), ReceiptHandle: aws.String("handle-3"), VisibilityTimeout: aws.Int64(0)},
+						},
+					}).Return(&sqs.ChangeMessageVisibilityBatchOutput{}, nil),
+				)
+			},
+			wantedMsgs: []*Message{wantedMsg("1"), wantedMsg("2"), wantedMsg("3")},
+		},
+		"stops when only already seen messages are received": {
+			max: 3,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{msg("1")},
+				}, nil).Times(2)
+				m.EXPECT().ChangeMessageVisibilityBatch(gomock.Any()).Return(&sqs.ChangeMessageVisibilityBatchOutput{}, nil)
+			},
+			wantedMsgs: []*Message{wantedMsg("1")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := SQS{client: m}
+
+			// WHEN
+			got, err := client.Peek(mockDLQURL, tc.max)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedMsgs, got)
+		})
+	}
+}
+
+func TestSQS_MoveMessages(t *testing.T) {
+	fifoMsg := func(id string) *sqs.Message {
+		return &sqs.Message{
+			MessageId:     aws.String(id),
+			Body:          aws.String("body-" + id),
+			ReceiptHandle: aws.String("handle-" + id),
+			Attributes: map[string]*string{
+				"MessageGroupId":          aws.String("group"),
+				"MessageDeduplicationId":  aws.String("dedup-" + id),
+				"ApproximateReceiveCount": aws.String("3"),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		max        int
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedMoved int
+		wantedErr   error
+	}{
+		"returns 0 if the source queue is empty": {
+			max: 100,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{}, nil)
+			},
+		},
+		"releases the messages if failed to send them": {
+			max: 1,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{fifoMsg("1")},
+				}, nil)
+				m.EXPECT().SendMessageBatch(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().ChangeMessageVisibilityBatch(gomock.Any()).Return(&sqs.ChangeMessageVisibilityBatchOutput{}, nil)
+			},
+			wantedErr: errors.New("send messages to queue " + mockQueueURL + ": some error"),
+		},
+		"errors if failed to delete the sent messages": {
+			max: 1,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{fifoMsg("1")},
+				}, nil)
+				m.EXPECT().SendMessageBatch(gomock.Any()).Return(&sqs.SendMessageBatchOutput{
+					Successful: []*sqs.SendMessageBatchResultEntry{{Id: aws.String("0")}},
+				}, nil)
+				m.EXPECT().DeleteMessageBatch(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("delete messages from queue " + mockDLQURL + ": some error"),
+		},
+		"moves the sent messages with fresh deduplication IDs and releases the failed ones": {
+			max: 100,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{
+					QueueUrl:              aws.String(mockDLQURL),
+					MaxNumberOfMessages:   aws.Int64(10),
+					WaitTimeSeconds:       aws.Int64(1),
+					AttributeNames:        aws.StringSlice([]string{"All"}),
+					MessageAttributeNames: aws.StringSlice([]string{"All"}),
+				}).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{fifoMsg("1"), fifoMsg("2")},
+				}, nil)
+				m.EXPECT().SendMessageBatch(&sqs.SendMessageBatchInput{
+					QueueUrl: aws.String(mockQueueURL),
+					Entries: []*sqs.SendMessageBatchRequestEntry{
+						{
+							Id:                     aws.String("0"),
+							MessageBody:            aws.String("body-1"),
+							MessageGroupId:         aws.String("group"),
+							MessageDeduplicationId: aws.String("1-3"),
+						},
+						{
+							Id:                     aws.String("1"),
+							MessageBody:            aws.String("body-2"),
+							MessageGroupId:         aws.String("group"),
+							MessageDeduplicationId: aws.String("2-3"),
+						},
+					},
+				}).Return(&sqs.SendMessageBatchOutput{
+					Successful: []*sqs.SendMessageBatchResultEntry{{Id: aws.String("0")}},
+					Failed:     []*sqs.BatchResultErrorEntry{{Id: aws.String("1"), Message: aws.String("throttled")}},
+				}, nil)
+				m.EXPECT().DeleteMessageBatch(&sqs.DeleteMessageBatchInput{
+					QueueUrl: aws.String(mockDLQURL),
+					Entries: []*sqs.DeleteMessageBatchRequestEntry{
+						{Id: aws.String("0"), ReceiptHandle: aws.String("handle-1")},
+					},
+				}).Return(&sqs.DeleteMessageBatchOutput{}, nil)
+				m.EXPECT().ChangeMessageVisibilityBatch(&sqs.ChangeMessageVisibilityBatchInput{
+					QueueUrl: aws.String(mockDLQURL),
+					Entries: []*sqs.ChangeMessageVisibilityBatchRequestEntry{
+						{Id: aws.String("0"), ReceiptHandle: aws.String("handle-2"), VisibilityTimeout: aws.Int64(0)},
+					},
+				}).Return(&sqs.ChangeMessageVisibilityBatchOutput{}, nil)
+			},
+			wantedMoved: 1,
+			wantedErr:   errors.New("send 1 messages to queue " + mockQueueURL + ": throttled"),
+		},
+		"does not set FIFO attributes for messages of a standard queue": {
+			max: 1,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{
+						{
+							MessageId:     aws.String("1"),
+							Body:          aws.String("body-1"),
+							ReceiptHandle: aws.String("handle-1"),
+							Attributes: map[string]*string{
+								"ApproximateReceiveCount": aws.String("3"),
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().SendMessageBatch(&sqs.SendMessageBatchInput{
+					QueueUrl: aws.String(mockQueueURL),
+					Entries: []*sqs.SendMessageBatchRequestEntry{
+						{
+							Id:          aws.String("0"),
+							MessageBody: aws.String("body-1"),
+						},
+					},
+				}).Return(&sqs.SendMessageBatchOutput{
+					Successful: []*sqs.SendMessageBatchResultEntry{{Id: aws.String("0")}},
+				}, nil)
+				m.EXPECT().DeleteMessageBatch(gomock.Any()).Return(&sqs.DeleteMessageBatchOutput{}, nil)
+			},
+			wantedMoved: 1,
+		},
+		"moves all received messages": {
+			max: 2,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{fifoMsg("1"), fifoMsg("2")},
+				}, nil)
+				m.EXPECT().SendMessageBatch(gomock.Any()).Return(&sqs.SendMessageBatchOutput{
+					Successful: []*sqs.SendMessageBatchResultEntry{{Id: aws.String("0")}, {Id: aws.String("1")}},
+				}, nil)
+				m.EXPECT().DeleteMessageBatch(gomock.Any()).Return(&sqs.DeleteMessageBatchOutput{}, nil)
+			},
+			wantedMoved: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := SQS{client: m}
+
+			// WHEN
+			moved, err := client.MoveMessages(mockDLQURL, mockQueueURL, tc.max)
+
+			// THEN
+			require.Equal(t, tc.wantedMoved, moved)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
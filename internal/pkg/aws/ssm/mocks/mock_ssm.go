@@ -63,3 +63,70 @@ func (mr *MockapiMockRecorder) PutParameter(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutParameter", reflect.TypeOf((*Mockapi)(nil).PutParameter), input)
 }
+
+// StartSession mocks base method.
+func (m *Mockapi) StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", input)
+	ret0, _ := ret[0].(*ssm.StartSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockapiMockRecorder) StartSession(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*Mockapi)(nil).StartSession), input)
+}
+
+// TerminateSession mocks base method.
+func (m *Mockapi) TerminateSession(input *ssm.TerminateSessionInput) (*ssm.TerminateSessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateSession", input)
+	ret0, _ := ret[0].(*ssm.TerminateSessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateSession indicates an expected call of TerminateSession.
+func (mr *MockapiMockRecorder) TerminateSession(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateSession", reflect.TypeOf((*Mockapi)(nil).TerminateSession), input)
+}
+
+// MockportForwardingSessionStarter is a mock of portForwardingSessionStarter interface.
+type MockportForwardingSessionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockportForwardingSessionStarterMockRecorder
+}
+
+// MockportForwardingSessionStarterMockRecorder is the mock recorder for MockportForwardingSessionStarter.
+type MockportForwardingSessionStarterMockRecorder struct {
+	mock *MockportForwardingSessionStarter
+}
+
+// NewMockportForwardingSessionStarter creates a new mock instance.
+func NewMockportForwardingSessionStarter(ctrl *gomock.Controller) *MockportForwardingSessionStarter {
+	mock := &MockportForwardingSessionStarter{ctrl: ctrl}
+	mock.recorder = &MockportForwardingSessionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockportForwardingSessionStarter) EXPECT() *MockportForwardingSessionStarterMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockportForwardingSessionStarter) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", ssmSess, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockportForwardingSessionStarterMockRecorder) StartPortForwardingSession(ssmSess, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockportForwardingSessionStarter)(nil).StartPortForwardingSession), ssmSess, in)
+}
@@ -14,23 +14,81 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocument           = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteDocument   = "AWS-StartPortForwardingSessionToRemoteHost"
+	portForwardingPortParamKey       = "portNumber"
+	portForwardingLocalPortParamKey  = "localPortNumber"
+	portForwardingRemoteHostParamKey = "host"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+	TerminateSession(input *ssm.TerminateSessionInput) (*ssm.TerminateSessionOutput, error)
+}
+
+type portForwardingSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() portForwardingSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() portForwardingSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
+	}
+}
+
+// ForwardPortInput holds the fields needed to forward a local port through the managed agent of a running container.
+type ForwardPortInput struct {
+	Target     string // Session Manager target of the managed agent, for example "ecs:cluster_taskID_runtimeID".
+	LocalPort  string
+	RemotePort string
+	RemoteHost string // Optional. If set, traffic is forwarded to this host instead of the container itself.
+}
+
+// ForwardPort starts a port forwarding session to the target and blocks until the session is closed.
+func (s *SSM) ForwardPort(in ForwardPortInput) error {
+	document, params := portForwardingDocument, map[string][]*string{
+		portForwardingPortParamKey:      aws.StringSlice([]string{in.RemotePort}),
+		portForwardingLocalPortParamKey: aws.StringSlice([]string{in.LocalPort}),
+	}
+	if in.RemoteHost != "" {
+		document = portForwardingToRemoteDocument
+		params[portForwardingRemoteHostParamKey] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	input := &ssm.StartSessionInput{
+		DocumentName: aws.String(document),
+		Parameters:   params,
+		Target:       aws.String(in.Target),
+	}
+	out, err := s.client.StartSession(input)
+	if err != nil {
+		return fmt.Errorf("start port forwarding session to %s: %w", in.Target, err)
+	}
+	sessID := aws.StringValue(out.SessionId)
+	if err := s.newSessStarter().StartPortForwardingSession(out, input); err != nil {
+		// Best-effort cleanup so that the session does not linger until it times out.
+		_, _ = s.client.TerminateSession(&ssm.TerminateSessionInput{SessionId: out.SessionId})
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	if _, err := s.client.TerminateSession(&ssm.TerminateSessionInput{SessionId: out.SessionId}); err != nil {
+		return fmt.Errorf("terminate session %s: %w", sessID, err)
 	}
+	return nil
 }
 
 // PutSecretInput contains fields needed to create or update a secret.
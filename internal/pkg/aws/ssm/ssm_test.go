@@ -361,3 +361,115 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ForwardPort(t *testing.T) {
+	mockSess := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessID"),
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		in              ForwardPortInput
+		mockAPI         func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockportForwardingSessionStarter)
+		wantedError     error
+	}{
+		"return error if fail to start the session": {
+			in: ForwardPortInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, mockErr)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {},
+			wantedError:     errors.New("start port forwarding session to ecs:cluster_task_runtime: some error"),
+		},
+		"terminate the session if the plugin fails": {
+			in: ForwardPortInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockSess, nil)
+				m.EXPECT().TerminateSession(&ssm.TerminateSessionInput{SessionId: aws.String("mockSessID")}).Return(nil, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, gomock.Any()).Return(mockErr)
+			},
+			wantedError: errors.New("start session mockSessID using ssm plugin: some error"),
+		},
+		"forward to the container port": {
+			in: ForwardPortInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(mockSess, nil)
+				m.EXPECT().TerminateSession(&ssm.TerminateSessionInput{SessionId: aws.String("mockSessID")}).Return(nil, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, gomock.Any()).Return(nil)
+			},
+		},
+		"forward to a remote host": {
+			in: ForwardPortInput{
+				Target:     "ecs:cluster_task_runtime",
+				LocalPort:  "5432",
+				RemotePort: "5432",
+				RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"5432"}),
+						"localPortNumber": aws.StringSlice([]string{"5432"}),
+						"host":            aws.StringSlice([]string{"mydb.cluster-abc.us-west-2.rds.amazonaws.com"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(mockSess, nil)
+				m.EXPECT().TerminateSession(&ssm.TerminateSessionInput{SessionId: aws.String("mockSessID")}).Return(nil, nil)
+			},
+			mockSessStarter: func(m *mocks.MockportForwardingSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockSess, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockportForwardingSessionStarter(ctrl)
+			tc.mockAPI(mockAPI)
+			tc.mockSessStarter(mockSessStarter)
+
+			client := SSM{
+				client: mockAPI,
+				newSessStarter: func() portForwardingSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			err := client.ForwardPort(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildAddonCmd is the top level command for addons.
+func BuildAddonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addon",
+		Short: "Commands for working with addon modules.",
+		Long: `Commands for working with addon modules.
+Add CloudFormation templates shared by your teams from local directories or git repositories.`,
+	}
+
+	cmd.AddCommand(buildAddonAddCmd())
+	cmd.AddCommand(buildAddonUpgradeCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addonAddWorkloadPrompt = "Which " + color.Emphasize("workload") + " would you like to add the addon to?"
+)
+
+type addonAddVars struct {
+	workloadName string
+	addonName    string
+	from         string
+	values       map[string]string
+}
+
+type addonAddOpts struct {
+	addonAddVars
+	appName string
+
+	ws      wsAddonModuleManager
+	fetcher addonModuleFetcher
+	sel     wsSelector
+
+	// Cached data.
+	source addon.ModuleSource
+}
+
+func newAddonAddOpts(vars addonAddVars) (*addonAddOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	return &addonAddOpts{
+		addonAddVars: vars,
+		appName:      tryReadingAppName(),
+
+		ws:      ws,
+		fetcher: addon.NewModuleFetcher(),
+		sel:     selector.NewWorkspaceSelect(prompt.New(), store, ws),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *addonAddOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.from == "" {
+		return fmt.Errorf("--%s is required", fromFlag)
+	}
+	src, err := addon.ParseModuleSource(o.from)
+	if err != nil {
+		return err
+	}
+	o.source = src
+	if o.workloadName != "" {
+		if err := validateLocalWorkloadName(o.ws, o.workloadName); err != nil {
+			return err
+		}
+	}
+	if o.addonName != "" {
+		if err := basicNameValidation(o.addonName); err != nil {
+			return fmt.Errorf("addon name %s is invalid: %w", o.addonName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the workload to add the addon to if it's not provided.
+func (o *addonAddOpts) Ask() error {
+	if o.workloadName != "" {
+		return nil
+	}
+	workload, err := o.sel.Workload(addonAddWorkloadPrompt, "")
+	if err != nil {
+		return fmt.Errorf("select workload: %w", err)
+	}
+	o.workloadName = workload
+	return nil
+}
+
+// Execute renders the module and writes it to the workload's addons directory.
+func (o *addonAddOpts) Execute() error {
+	module, err := o.fetcher.Fetch(o.source)
+	if err != nil {
+		return fmt.Errorf("fetch addon module: %w", err)
+	}
+	if o.addonName == "" {
+		o.addonName = module.Name
+	}
+	tpl, err := module.Render(o.values)
+	if err != nil {
+		return err
+	}
+	path, err := o.ws.WriteAddon(tpl, o.workloadName, o.addonName)
+	if err != nil {
+		var errExists *workspace.ErrFileExists
+		if errors.As(err, &errExists) {
+			return fmt.Errorf("addon file already exists: %w", errExists)
+		}
+		return fmt.Errorf("write addon %s: %w", o.addonName, err)
+	}
+	path, err = relPath(path)
+	if err != nil {
+		return err
+	}
+	log.Successf("Wrote CloudFormation template for module %s at %s\n", color.HighlightUserInput(module.Name), color.HighlightResource(path))
+
+	lock, err := readAddonModulesLock(o.ws)
+	if err != nil {
+		return err
+	}
+	source, err := lockedModuleSource(o.ws, module.Source)
+	if err != nil {
+		return err
+	}
+	lock.Set(addon.LockedModule{
+		Workload: o.workloadName,
+		Addon:    o.addonName,
+		Source:   source,
+		Commit:   module.Commit,
+		Values:   o.values,
+	})
+	if err := writeAddonModulesLock(o.ws, lock); err != nil {
+		return err
+	}
+	log.Infoln()
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *addonAddOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to deploy your addon resources.", color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", o.workloadName))),
+	})
+	return nil
+}
+
+func validateLocalWorkloadName(ws wlLister, name string) error {
+	names, err := ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("retrieve local workload names: %w", err)
+	}
+	for _, wl := range names {
+		if wl == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("workload %s not found in the workspace", name)
+}
+
+// lockedModuleSource returns the source of a module as it's recorded in the lockfile.
+// Local modules are recorded relative to the workspace root so that they can be upgraded from any directory.
+func lockedModuleSource(ws wsAddonModuleManager, src addon.ModuleSource) (string, error) {
+	if src.IsGit() {
+		return src.String(), nil
+	}
+	root, err := ws.Path()
+	if err != nil {
+		return "", fmt.Errorf("get workspace path: %w", err)
+	}
+	path, err := filepath.Abs(src.Path)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path of module %s: %w", src.Path, err)
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return "", fmt.Errorf("get path of module %s relative to the workspace: %w", src.Path, err)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func readAddonModulesLock(ws wsAddonModuleManager) (*addon.ModulesLock, error) {
+	data, err := ws.ReadAddonModulesLock()
+	if err != nil {
+		var errNotExist *workspace.ErrFileNotExists
+		if errors.As(err, &errNotExist) {
+			return &addon.ModulesLock{}, nil
+		}
+		return nil, fmt.Errorf("read addon modules lockfile: %w", err)
+	}
+	return addon.ParseModulesLock(data)
+}
+
+func writeAddonModulesLock(ws wsAddonModuleManager, lock *addon.ModulesLock) error {
+	path, err := ws.WriteAddonModulesLock(lock)
+	if err != nil {
+		return fmt.Errorf("write addon modules lockfile: %w", err)
+	}
+	path, err = relPath(path)
+	if err != nil {
+		return err
+	}
+	log.Successf("Recorded the module's source in %s\n", color.HighlightResource(path))
+	return nil
+}
+
+// buildAddonAddCmd builds the command for adding an addon from a module.
+func buildAddonAddCmd() *cobra.Command {
+	vars := addonAddVars{}
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Adds an addon to a workload from a module.",
+		Long: `Adds an addon to a workload from a module.
+A module is a directory with a module.yml file that declares its values and a template.yml file with the addon's CloudFormation template.
+The source of the module is recorded in copilot/addons.lock.yml so that the addon can be upgraded later.`,
+		Example: `
+  Add an ElastiCache cluster to the "api" service from a module in a local directory.
+  /code $ copilot addon add --from ../platform-modules/redis -w api
+  Add a KMS key from a module in a git repository pinned to the "v1.2.0" tag.
+  /code $ copilot addon add --from "git::https://github.com/acme/modules.git//kms?ref=v1.2.0" -w api -n keys --values KeyAlias=orders`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAddonAddOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVar(&vars.from, fromFlag, "", addonFromFlagDescription)
+	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", addonWorkloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.addonName, nameFlag, nameFlagShort, "", addonNameFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, addonValuesFlagDescription)
+	return cmd
+}
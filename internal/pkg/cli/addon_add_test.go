@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddonModuleManifest = `name: redis
+values:
+  NodeType:
+    default: cache.t3.micro
+`
+	testAddonModuleTemplate = `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Cluster:
+    Type: AWS::ElastiCache::CacheCluster
+    Properties:
+      CacheNodeType: {{.Values.NodeType}}
+`
+)
+
+func TestAddonAddOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inFrom     string
+		inWorkload string
+		inName     string
+		mockWs     func(m *mocks.MockwsAddonModuleManager)
+
+		wantedSource addon.ModuleSource
+		wantedErr    error
+	}{
+		"error if not in an application": {
+			mockWs:    func(m *mocks.MockwsAddonModuleManager) {},
+			wantedErr: errNoAppInWorkspace,
+		},
+		"error if the module is not specified": {
+			inAppName: "phonetool",
+			mockWs:    func(m *mocks.MockwsAddonModuleManager) {},
+			wantedErr: errors.New("--from is required"),
+		},
+		"error if the module source is invalid": {
+			inAppName: "phonetool",
+			inFrom:    "git::https://github.com/acme/modules.git?tag=v1",
+			mockWs:    func(m *mocks.MockwsAddonModuleManager) {},
+			wantedErr: errors.New(`module source git::https://github.com/acme/modules.git?tag=v1: unsupported query parameter "tag"`),
+		},
+		"error if the workload is not in the workspace": {
+			inAppName:  "phonetool",
+			inFrom:     "../modules/redis",
+			inWorkload: "api",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New("workload api not found in the workspace"),
+		},
+		"error if the addon name is invalid": {
+			inAppName: "phonetool",
+			inFrom:    "../modules/redis",
+			inName:    "my cache",
+			mockWs:    func(m *mocks.MockwsAddonModuleManager) {},
+			wantedErr: fmt.Errorf("addon name my cache is invalid: %w", errValueBadFormat),
+		},
+		"success": {
+			inAppName:  "phonetool",
+			inFrom:     "git::https://github.com/acme/modules.git//redis?ref=v1.0.0",
+			inWorkload: "api",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+			},
+			wantedSource: addon.ModuleSource{
+				URL:  "https://github.com/acme/modules.git",
+				Path: "redis",
+				Ref:  "v1.0.0",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsAddonModuleManager(ctrl)
+			tc.mockWs(ws)
+			opts := &addonAddOpts{
+				addonAddVars: addonAddVars{
+					from:         tc.inFrom,
+					workloadName: tc.inWorkload,
+					addonName:    tc.inName,
+				},
+				appName: tc.inAppName,
+				ws:      ws,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSource, opts.source)
+		})
+	}
+}
+
+func TestAddonAddOpts_Execute(t *testing.T) {
+	gitSrc := addon.ModuleSource{URL: "https://github.com/acme/modules.git", Path: "redis", Ref: "v1.0.0"}
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		inName   string
+		inSource *addon.ModuleSource
+		inValues map[string]string
+		mockWs   func(m *mocks.MockwsAddonModuleManager)
+
+		wantedErr error
+	}{
+		"error if the addon already exists": {
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "api", "redis").Return("", &workspace.ErrFileExists{FileName: "api/addons/redis.yml"})
+			},
+			wantedErr: errors.New("addon file already exists: file api/addons/redis.yml already exists"),
+		},
+		"error if a value is not declared by the module": {
+			inValues:  map[string]string{"Shards": "2"},
+			mockWs:    func(m *mocks.MockwsAddonModuleManager) {},
+			wantedErr: errors.New("value Shards is not declared by module redis"),
+		},
+		"error if the lockfile cannot be read": {
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "api", "redis").Return("/copilot/api/addons/redis.yml", nil)
+				m.EXPECT().ReadAddonModulesLock().Return(nil, testErr)
+			},
+			wantedErr: errors.New("read addon modules lockfile: some error"),
+		},
+		"creates the lockfile": {
+			inName:   "cache",
+			inValues: map[string]string{"NodeType": "cache.m5.large"},
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "api", "cache").DoAndReturn(func(tpl *addon.ModuleTemplate, _, _ string) (string, error) {
+					out, err := tpl.MarshalBinary()
+					require.NoError(t, err)
+					require.Contains(t, string(out), "CacheNodeType: cache.m5.large")
+					return "/copilot/api/addons/cache.yml", nil
+				})
+				m.EXPECT().ReadAddonModulesLock().Return(nil, &workspace.ErrFileNotExists{FileName: "addons.lock.yml"})
+				m.EXPECT().WriteAddonModulesLock(&addon.ModulesLock{
+					Modules: []addon.LockedModule{
+						{
+							Workload: "api",
+							Addon:    "cache",
+							Source:   gitSrc.String(),
+							Commit:   "abc123",
+							Values:   map[string]string{"NodeType": "cache.m5.large"},
+						},
+					},
+				}).Return("/copilot/addons.lock.yml", nil)
+			},
+		},
+		"records local modules relative to the workspace root": {
+			inSource: &addon.ModuleSource{Path: "modules/redis"},
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "api", "redis").Return("/copilot/api/addons/redis.yml", nil)
+				m.EXPECT().ReadAddonModulesLock().Return(nil, &workspace.ErrFileNotExists{FileName: "addons.lock.yml"})
+				m.EXPECT().Path().Return(filepath.Dir(wd), nil)
+				m.EXPECT().WriteAddonModulesLock(&addon.ModulesLock{
+					Modules: []addon.LockedModule{
+						{
+							Workload: "api",
+							Addon:    "redis",
+							Source:   filepath.Base(wd) + "/modules/redis",
+							Commit:   "abc123",
+						},
+					},
+				}).Return("/copilot/addons.lock.yml", nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsAddonModuleManager(ctrl)
+			tc.mockWs(ws)
+			src := gitSrc
+			if tc.inSource != nil {
+				src = *tc.inSource
+			}
+			module, err := addon.ParseModule([]byte(testAddonModuleManifest), []byte(testAddonModuleTemplate))
+			require.NoError(t, err)
+			module.Source = src
+			module.Commit = "abc123"
+			fetcher := mocks.NewMockaddonModuleFetcher(ctrl)
+			fetcher.EXPECT().Fetch(src).Return(module, nil)
+			opts := &addonAddOpts{
+				addonAddVars: addonAddVars{
+					workloadName: "api",
+					addonName:    tc.inName,
+					values:       tc.inValues,
+				},
+				ws:      ws,
+				fetcher: fetcher,
+				source:  src,
+			}
+
+			err = opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
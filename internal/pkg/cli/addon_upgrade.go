@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addonUpgradeWorkloadPrompt = "Which " + color.Emphasize("workload") + " has the addon you would like to upgrade?"
+	addonUpgradeNamePrompt     = "Which " + color.Emphasize("addon") + " would you like to upgrade?"
+)
+
+const (
+	addonUpgradeNameHelpPrompt = "Only addons that were added from a module can be upgraded."
+)
+
+type addonUpgradeVars struct {
+	workloadName string
+	addonName    string
+	ref          string
+}
+
+type addonUpgradeOpts struct {
+	addonUpgradeVars
+	appName string
+
+	ws      wsAddonModuleManager
+	fetcher addonModuleFetcher
+	sel     wsSelector
+	prompt  prompter
+}
+
+func newAddonUpgradeOpts(vars addonUpgradeVars) (*addonUpgradeOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store client: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace client: %w", err)
+	}
+	prompter := prompt.New()
+	return &addonUpgradeOpts{
+		addonUpgradeVars: vars,
+		appName:          tryReadingAppName(),
+
+		ws:      ws,
+		fetcher: addon.NewModuleFetcher(),
+		sel:     selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:  prompter,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *addonUpgradeOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.workloadName != "" {
+		return validateLocalWorkloadName(o.ws, o.workloadName)
+	}
+	return nil
+}
+
+// Ask prompts for the workload and the addon to upgrade if they're not provided.
+func (o *addonUpgradeOpts) Ask() error {
+	if o.workloadName == "" {
+		workload, err := o.sel.Workload(addonUpgradeWorkloadPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select workload: %w", err)
+		}
+		o.workloadName = workload
+	}
+	if o.addonName != "" {
+		return nil
+	}
+	lock, err := readAddonModulesLock(o.ws)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, m := range lock.Modules {
+		if m.Workload == o.workloadName {
+			names = append(names, m.Addon)
+		}
+	}
+	switch len(names) {
+	case 0:
+		return fmt.Errorf("no addons of workload %s were added from a module", o.workloadName)
+	case 1:
+		o.addonName = names[0]
+		return nil
+	}
+	name, err := o.prompt.SelectOne(addonUpgradeNamePrompt, addonUpgradeNameHelpPrompt, names, prompt.WithFinalMessage("Addon:"))
+	if err != nil {
+		return fmt.Errorf("select addon: %w", err)
+	}
+	o.addonName = name
+	return nil
+}
+
+// Execute fetches the latest version of the addon's module and re-renders the addon with the values in the lockfile.
+func (o *addonUpgradeOpts) Execute() error {
+	lock, err := readAddonModulesLock(o.ws)
+	if err != nil {
+		return err
+	}
+	locked, ok := lock.Get(o.workloadName, o.addonName)
+	if !ok {
+		return fmt.Errorf("addon %s of workload %s was not added from a module", o.addonName, o.workloadName)
+	}
+	src, err := addon.ParseModuleSource(locked.Source)
+	if err != nil {
+		return err
+	}
+	if o.ref != "" {
+		if !src.IsGit() {
+			return fmt.Errorf("--%s can only be used with modules from git repositories", refFlag)
+		}
+		src.Ref = o.ref
+	}
+	if !src.IsGit() {
+		// Local modules are recorded relative to the workspace root.
+		root, err := o.ws.Path()
+		if err != nil {
+			return fmt.Errorf("get workspace path: %w", err)
+		}
+		src.Path = filepath.Join(root, filepath.FromSlash(src.Path))
+	}
+	module, err := o.fetcher.Fetch(src)
+	if err != nil {
+		return fmt.Errorf("fetch addon module: %w", err)
+	}
+	tpl, err := module.Render(locked.Values)
+	if err != nil {
+		return err
+	}
+	path, err := o.ws.OverwriteAddon(tpl, o.workloadName, o.addonName)
+	if err != nil {
+		return fmt.Errorf("write addon %s: %w", o.addonName, err)
+	}
+	path, err = relPath(path)
+	if err != nil {
+		return err
+	}
+	if src.IsGit() {
+		locked.Source = src.String()
+	}
+	log.Successf("Upgraded addon %s to %s\n", color.HighlightResource(path), color.HighlightUserInput(locked.Source))
+
+	locked.Commit = module.Commit
+	lock.Set(locked)
+	if err := writeAddonModulesLock(o.ws, lock); err != nil {
+		return err
+	}
+	log.Infoln()
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *addonUpgradeOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Review the changes to the addon with %s.", color.HighlightCode("git diff")),
+		fmt.Sprintf("Run %s to deploy the upgraded addon.", color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", o.workloadName))),
+	})
+	return nil
+}
+
+// buildAddonUpgradeCmd builds the command for upgrading an addon to a newer version of its module.
+func buildAddonUpgradeCmd() *cobra.Command {
+	vars := addonUpgradeVars{}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrades an addon to a newer version of its module.",
+		Long: `Upgrades an addon to a newer version of its module.
+The addon is rendered again with the values recorded in copilot/addons.lock.yml.`,
+		Example: `
+  Fetch the "cache" addon's module again at the reference in the lockfile.
+  /code $ copilot addon upgrade -w api -n cache
+  Upgrade the "cache" addon to the "v1.3.0" tag of its git repository.
+  /code $ copilot addon upgrade -w api -n cache --ref v1.3.0`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAddonUpgradeOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", addonUpgradeWorkloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.addonName, nameFlag, nameFlagShort, "", addonUpgradeNameFlagDescription)
+	cmd.Flags().StringVar(&vars.ref, refFlag, "", addonUpgradeRefFlagDescription)
+	return cmd
+}
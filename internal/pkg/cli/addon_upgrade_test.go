@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddonModulesLock = `modules:
+  - workload: api
+    addon: cache
+    source: git::https://github.com/acme/modules.git//redis?ref=v1.0.0
+    commit: abc123
+    values:
+      NodeType: cache.m5.large
+  - workload: api
+    addon: keys
+    source: modules/kms
+  - workload: frontend
+    addon: cache
+    source: modules/redis
+`
+
+func TestAddonUpgradeOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inWorkload string
+		inName     string
+		mockWs     func(m *mocks.MockwsAddonModuleManager)
+		mockPrompt func(m *mocks.Mockprompter)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"does not prompt if the addon is provided": {
+			inWorkload: "api",
+			inName:     "cache",
+			mockWs:     func(m *mocks.MockwsAddonModuleManager) {},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedName: "cache",
+		},
+		"error if no addons of the workload were added from a module": {
+			inWorkload: "backend",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedErr:  errors.New("no addons of workload backend were added from a module"),
+		},
+		"selects the only addon of the workload": {
+			inWorkload: "frontend",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			wantedName: "cache",
+		},
+		"prompts for the addon": {
+			inWorkload: "api",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+			},
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(addonUpgradeNamePrompt, addonUpgradeNameHelpPrompt, []string{"cache", "keys"}, gomock.Any()).Return("keys", nil)
+			},
+			wantedName: "keys",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsAddonModuleManager(ctrl)
+			tc.mockWs(ws)
+			prompt := mocks.NewMockprompter(ctrl)
+			tc.mockPrompt(prompt)
+			opts := &addonUpgradeOpts{
+				addonUpgradeVars: addonUpgradeVars{
+					workloadName: tc.inWorkload,
+					addonName:    tc.inName,
+				},
+				ws:     ws,
+				prompt: prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.addonName)
+		})
+	}
+}
+
+func TestAddonUpgradeOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inWorkload  string
+		inName      string
+		inRef       string
+		mockWs      func(m *mocks.MockwsAddonModuleManager)
+		mockFetcher func(m *mocks.MockaddonModuleFetcher)
+
+		wantedErr error
+	}{
+		"error if the addon was not added from a module": {
+			inWorkload: "api",
+			inName:     "queue",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+			},
+			mockFetcher: func(m *mocks.MockaddonModuleFetcher) {},
+			wantedErr:   errors.New("addon queue of workload api was not added from a module"),
+		},
+		"error if a ref is provided for a local module": {
+			inWorkload: "frontend",
+			inName:     "cache",
+			inRef:      "v2.0.0",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+			},
+			mockFetcher: func(m *mocks.MockaddonModuleFetcher) {},
+			wantedErr:   errors.New("--ref can only be used with modules from git repositories"),
+		},
+		"upgrades the addon to a new ref and records it in the lockfile": {
+			inWorkload: "api",
+			inName:     "cache",
+			inRef:      "v2.0.0",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+				m.EXPECT().OverwriteAddon(gomock.Any(), "api", "cache").DoAndReturn(func(tpl *addon.ModuleTemplate, _, _ string) (string, error) {
+					out, err := tpl.MarshalBinary()
+					require.NoError(t, err)
+					require.Contains(t, string(out), "CacheNodeType: cache.m5.large")
+					return "/copilot/api/addons/cache.yml", nil
+				})
+				m.EXPECT().WriteAddonModulesLock(gomock.Any()).DoAndReturn(func(lock *addon.ModulesLock) (string, error) {
+					m, ok := lock.Get("api", "cache")
+					require.True(t, ok)
+					require.Equal(t, addon.LockedModule{
+						Workload: "api",
+						Addon:    "cache",
+						Source:   "git::https://github.com/acme/modules.git//redis?ref=v2.0.0",
+						Commit:   "def456",
+						Values:   map[string]string{"NodeType": "cache.m5.large"},
+					}, m)
+					require.Len(t, lock.Modules, 3)
+					return "/copilot/addons.lock.yml", nil
+				})
+			},
+			mockFetcher: func(m *mocks.MockaddonModuleFetcher) {
+				src := addon.ModuleSource{URL: "https://github.com/acme/modules.git", Path: "redis", Ref: "v2.0.0"}
+				module, err := addon.ParseModule([]byte(testAddonModuleManifest), []byte(testAddonModuleTemplate))
+				require.NoError(t, err)
+				module.Source = src
+				module.Commit = "def456"
+				m.EXPECT().Fetch(src).Return(module, nil)
+			},
+		},
+		"resolves local modules against the workspace root": {
+			inWorkload: "frontend",
+			inName:     "cache",
+			mockWs: func(m *mocks.MockwsAddonModuleManager) {
+				m.EXPECT().ReadAddonModulesLock().Return([]byte(testAddonModulesLock), nil)
+				m.EXPECT().Path().Return("/workspace", nil)
+				m.EXPECT().OverwriteAddon(gomock.Any(), "frontend", "cache").Return("/workspace/copilot/frontend/addons/cache.yml", nil)
+				m.EXPECT().WriteAddonModulesLock(gomock.Any()).DoAndReturn(func(lock *addon.ModulesLock) (string, error) {
+					m, ok := lock.Get("frontend", "cache")
+					require.True(t, ok)
+					require.Equal(t, "modules/redis", m.Source)
+					return "/workspace/copilot/addons.lock.yml", nil
+				})
+			},
+			mockFetcher: func(m *mocks.MockaddonModuleFetcher) {
+				src := addon.ModuleSource{Path: filepath.Join("/workspace", "modules", "redis")}
+				module, err := addon.ParseModule([]byte(testAddonModuleManifest), []byte(testAddonModuleTemplate))
+				require.NoError(t, err)
+				module.Source = src
+				m.EXPECT().Fetch(src).Return(module, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsAddonModuleManager(ctrl)
+			tc.mockWs(ws)
+			fetcher := mocks.NewMockaddonModuleFetcher(ctrl)
+			tc.mockFetcher(fetcher)
+			opts := &addonUpgradeOpts{
+				addonUpgradeVars: addonUpgradeVars{
+					workloadName: tc.inWorkload,
+					addonName:    tc.inName,
+					ref:          tc.inRef,
+				},
+				ws:      ws,
+				fetcher: fetcher,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -36,6 +36,7 @@ const (
 type initAppVars struct {
 	name         string
 	domainName   string
+	signatureKey string
 	resourceTags map[string]string
 }
 
@@ -105,6 +106,11 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
+	if o.signatureKey != "" {
+		if err := validateKMSKeyARN(o.signatureKey); err != nil {
+			return fmt.Errorf("signature key %s is invalid: %w", o.signatureKey, err)
+		}
+	}
 	return nil
 }
 
@@ -206,9 +212,13 @@ func (o *initAppOpts) Execute() error {
 		Domain:             o.domainName,
 		DomainHostedZoneID: hostedZoneID,
 		Tags:               o.resourceTags,
+		SignatureKey:       o.signatureKey,
 	}); err != nil {
 		return err
 	}
+	if err := o.storeSignatureKey(); err != nil {
+		return err
+	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
 	log.Infoln()
 	return nil
@@ -229,9 +239,28 @@ func (o *initAppOpts) validateAppName(name string) error {
 	if o.domainName != "" && app.Domain != o.domainName {
 		return fmt.Errorf("application named %s already exists with a different domain name %s", name, app.Domain)
 	}
+	if o.signatureKey != "" && app.SignatureKey != "" && app.SignatureKey != o.signatureKey {
+		return fmt.Errorf("application named %s already exists with a different signature key %s", name, app.SignatureKey)
+	}
 	return nil
 }
 
+// storeSignatureKey adds the signature key to an application that was created without one.
+func (o *initAppOpts) storeSignatureKey() error {
+	if o.signatureKey == "" {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if app.SignatureKey != "" {
+		return nil
+	}
+	app.SignatureKey = o.signatureKey
+	return o.store.UpdateApplication(app)
+}
+
 func (o *initAppOpts) isDomainOwned() error {
 	err := o.domainInfoGetter.IsRegisteredDomain(o.domainName)
 	if err == nil {
@@ -313,6 +342,8 @@ An application is a collection of containerized services that operate together.`
   /code $ copilot app init test
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
+  Create a new application that only deploys images signed with a KMS key to production environments.
+  /code $ copilot app init --signature-key arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab
   Create a new application with resource tags.
   /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
 		Args: reservedArgs,
@@ -328,6 +359,7 @@ An application is a collection of containerized services that operate together.`
 		}),
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
+	cmd.Flags().StringVar(&vars.signatureKey, signatureKeyFlag, "", signatureKeyFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	return cmd
 }
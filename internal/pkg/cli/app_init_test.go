@@ -27,8 +27,9 @@ type initAppMocks struct {
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName    string
-		inDomainName string
+		inAppName      string
+		inDomainName   string
+		inSignatureKey string
 
 		mock func(m *initAppMocks)
 
@@ -63,6 +64,28 @@ func TestInitAppOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("application named metrics already exists with a different domain name domain.com"),
 		},
+		"errors if application with different signature key already exists": {
+			inAppName:      "metrics",
+			inSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/new",
+			mock: func(m *initAppMocks) {
+				m.mockStore.EXPECT().GetApplication("metrics").Return(&config.Application{
+					Name:         "metrics",
+					SignatureKey: "arn:aws:kms:us-west-2:123456789012:key/old",
+				}, nil)
+			},
+
+			wantedError: errors.New("application named metrics already exists with a different signature key arn:aws:kms:us-west-2:123456789012:key/old"),
+		},
+		"invalid signature key that isn't a KMS key ARN": {
+			inSignatureKey: "cosign.pub",
+			mock:           func(m *initAppMocks) {},
+
+			wantedError: fmt.Errorf("signature key cosign.pub is invalid: %w", errKMSKeyARNInvalid),
+		},
+		"valid signature key": {
+			inSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			mock:           func(m *initAppMocks) {},
+		},
 		"skip checking if domain name is not set": {
 			inAppName:    "metrics",
 			inDomainName: "",
@@ -141,8 +164,9 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				domainInfoGetter: m.mockDomainInfoGetter,
 				store:            m.mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:         tc.inAppName,
+					domainName:   tc.inDomainName,
+					signatureKey: tc.inSignatureKey,
 				},
 			}
 
@@ -293,6 +317,7 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inDomainName         string
 		inDomainHostedZoneID string
+		inSignatureKey       string
 
 		expectedError error
 		mocking       func(t *testing.T,
@@ -342,6 +367,52 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
 			},
 		},
+		"add the signature key to an existing application without one": {
+			inSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/mock",
+
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name:      "myapp",
+					AccountID: "12345",
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(&config.Application{
+					Name:         "myapp",
+					AccountID:    "12345",
+					SignatureKey: "arn:aws:kms:us-west-2:123456789012:key/mock",
+				}).Return(nil)
+			},
+		},
+		"should return error if fail to add the signature key to an existing application": {
+			inSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/mock",
+			expectedError:  mockError,
+
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(gomock.Any()).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					Name: "myapp",
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(gomock.Any()).Return(mockError)
+			},
+		},
 		"should return error from workspace.Create": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
@@ -417,8 +488,9 @@ func TestInitAppOpts_Execute(t *testing.T) {
 
 			opts := &initAppOpts{
 				initAppVars: initAppVars{
-					name:       "myapp",
-					domainName: tc.inDomainName,
+					name:         "myapp",
+					domainName:   tc.inDomainName,
+					signatureKey: tc.inSignatureKey,
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
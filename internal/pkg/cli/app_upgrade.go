@@ -33,7 +33,8 @@ const (
 
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
-	name string
+	name         string
+	outputFormat string
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -97,6 +98,13 @@ func (o *appUpgradeOpts) Ask() error {
 // Execute updates the cloudformation stack as well as the stackset of an application to the latest version.
 // If any stack is busy updating, it spins and waits until the stack can be updated.
 func (o *appUpgradeOpts) Execute() error {
+	out := progressWriter(o.outputFormat, o.name)
+	err := o.upgrade(out)
+	writeResultEvent(out, deployResultEvent(o.name, "", "", err))
+	return err
+}
+
+func (o *appUpgradeOpts) upgrade(out termprogress.FileWriter) error {
 	version, err := o.versionGetter.Version()
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
@@ -116,7 +124,7 @@ func (o *appUpgradeOpts) Execute() error {
 		}
 		o.prog.Stop(log.Ssuccessf(fmtAppUpgradeComplete, color.HighlightUserInput(o.name), color.Emphasize(deploy.LatestAppTemplateVersion)))
 	}()
-	err = o.upgradeApplication(app, version, deploy.LatestAppTemplateVersion)
+	err = o.upgradeApplication(out, app, version, deploy.LatestAppTemplateVersion)
 	if err != nil {
 		return err
 	}
@@ -154,7 +162,7 @@ Are you using the latest version of AWS Copilot?`, appName, deploy.LatestAppTemp
 	return false
 }
 
-func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion, toVersion string) error {
+func (o *appUpgradeOpts) upgradeApplication(out termprogress.FileWriter, app *config.Application, fromVersion, toVersion string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
@@ -164,7 +172,7 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 		return err
 	}
 	// Upgrade app CloudFormation resources.
-	if err := o.upgrader.UpgradeApplication(&deploy.CreateAppInput{
+	if err := o.upgrader.UpgradeApplication(out, &deploy.CreateAppInput{
 		Name:               o.name,
 		AccountID:          caller.Account,
 		DomainName:         app.Domain,
@@ -200,6 +208,9 @@ func buildAppUpgradeCmd() *cobra.Command {
     Upgrade the application "my-app" to the latest version
     /code $ copilot app upgrade -n my-app`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if err := validateOutputFormat(vars.outputFormat); err != nil {
+				return err
+			}
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
 				return err
@@ -208,5 +219,6 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, textOutputFormat, outputFlagDescription)
 	return cmd
 }
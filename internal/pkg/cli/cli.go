@@ -14,11 +14,13 @@ import (
 	"github.com/dustin/go-humanize/english"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 const (
@@ -26,6 +28,14 @@ const (
 	svcAppNameHelpPrompt = "An application groups all of your services and jobs together."
 )
 
+// Output formats of the progress of a deployment.
+const (
+	textOutputFormat = "text"
+	jsonOutputFormat = "json"
+)
+
+var outputFormats = []string{textOutputFormat, jsonOutputFormat}
+
 // tryReadingAppName retrieves the application's name from the workspace if it exists and returns it.
 // If there is an error while retrieving the workspace summary, returns the empty string.
 func tryReadingAppName() string {
@@ -118,6 +128,45 @@ func run(cmd cmd) error {
 	return nil
 }
 
+func validateOutputFormat(format string) error {
+	if !contains(format, outputFormats) {
+		return fmt.Errorf("invalid --%s %s: must be one of %s", outputFlag, format, english.WordSeries(outputFormats, "or"))
+	}
+	return nil
+}
+
+// progressWriter returns where the progress of stack deployments is written. With the json output format,
+// the progress is written to stdout as newline-delimited JSON events instead of being rendered to stderr.
+// If stderr isn't a terminal, such as in the logs of a CI build, the progress is written as lines of status
+// prefixed with label, as spinners and cursor movements would garble the output.
+func progressWriter(format, label string) termprogress.FileWriter {
+	if format == jsonOutputFormat {
+		return termprogress.NewJSONEventWriter(os.Stdout)
+	}
+	if !term.IsTerminal(int(os.Stderr.Fd())) {
+		return termprogress.NewStatusLines(os.Stderr).Writer(label)
+	}
+	return os.Stderr
+}
+
+// writeResultEvent writes ev to out if the progress is written as events.
+func writeResultEvent(out termprogress.FileWriter, ev termprogress.Event) {
+	w, ok := out.(termprogress.EventWriter)
+	if !ok {
+		return
+	}
+	if err := w.WriteEvent(ev); err != nil {
+		log.Warningf("Failed to write the result event: %v\n", err)
+	}
+}
+
+// deployResultEvent returns the result event of deploying a workload.
+func deployResultEvent(appName, envName, name string, err error) termprogress.Event {
+	ev := termprogress.ResultEvent(err)
+	ev.App, ev.Env, ev.Workload = appName, envName, name
+	return ev
+}
+
 func logRecommendedActions(actions []string) {
 	if len(actions) == 0 {
 		return
@@ -4,13 +4,19 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker/cosign"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize/english"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -29,13 +35,32 @@ import (
 const (
 	svcWkldType = "svc"
 	jobWkldType = "job"
+
+	defaultDeployMaxConcurrency = 4
 )
 
-type deployOpts struct {
+type deployVars struct {
 	deployWkldVars
 
-	deployWkld     actionCommand
-	setupDeployCmd func(*deployOpts, string)
+	all            bool
+	maxConcurrency int
+}
+
+// workloadDeployer is a workload deploy command that can upload the artifacts of the workload separately from
+// deploying its stack, so that "deploy --all" can build every image before deploying the stacks in dependency order.
+type workloadDeployer interface {
+	actionCommand
+	manifest() (interface{}, error)
+	uploadArtifacts() error
+	deployWorkload(out termprogress.FileWriter) error
+}
+
+type deployOpts struct {
+	deployVars
+
+	deployWkld       actionCommand
+	setupDeployCmd   func(*deployOpts, string)
+	newEnvUpgradeCmd func(appName, envName string) (actionCommand, error)
 
 	sel    wsSelector
 	store  store
@@ -46,7 +71,7 @@ type deployOpts struct {
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	store, err := config.NewStore()
 	if err != nil {
 		return nil, fmt.Errorf("new config store: %w", err)
@@ -61,11 +86,11 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	}
 	prompter := prompt.New()
 	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewWorkspaceSelect(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+		deployVars: vars,
+		store:      store,
+		sel:        selector.NewWorkspaceSelect(prompter, store, ws),
+		ws:         ws,
+		prompt:     prompter,
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
 			switch {
@@ -82,6 +107,7 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					sel:             selector.NewWorkspaceSelect(o.prompt, o.store, o.ws),
 					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
+					imageSigner:     cosign.New(exec.NewCmd()),
 					sessProvider:    sessions.NewProvider(),
 				}
 			case contains(workloadType, manifest.ServiceTypes):
@@ -98,6 +124,7 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					prompt:          o.prompt,
 					now:             time.Now,
 					cmd:             exec.NewCmd(),
+					imageSigner:     cosign.New(exec.NewCmd()),
 					sessProvider:    sessions.NewProvider(),
 					newAppVersionGetter: func(appName string) (versionGetter, error) {
 						return describe.NewAppDescriber(appName)
@@ -108,10 +135,19 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 				o.deployWkld = opts
 			}
 		},
+		newEnvUpgradeCmd: func(appName, envName string) (actionCommand, error) {
+			return newEnvUpgradeOpts(envUpgradeVars{
+				appName: appName,
+				name:    envName,
+			})
+		},
 	}, nil
 }
 
 func (o *deployOpts) Run() error {
+	if o.all {
+		return o.runAll()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -166,9 +202,175 @@ func (o *deployOpts) loadWkldCmd() error {
 	return nil
 }
 
+// runAll builds the images of every workload in the workspace concurrently, and then deploys the workloads
+// in dependency order. Independent workloads are deployed in parallel.
+func (o *deployOpts) runAll() error {
+	if err := o.validateAll(); err != nil {
+		return err
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	if len(names) == 0 {
+		return errors.New("no service or job found in the workspace")
+	}
+	// Upgrade the environment once up front instead of concurrently from each workload deployment.
+	o.skipEnvUpgrade = true
+	deployers := make(map[string]workloadDeployer, len(names))
+	for _, name := range names {
+		o.name = name
+		if err := o.loadWkld(); err != nil {
+			return fmt.Errorf("load %s %s: %w", o.wlType, name, err)
+		}
+		deployer, ok := o.deployWkld.(workloadDeployer)
+		if !ok {
+			return fmt.Errorf("%s %s cannot be deployed with --%s", o.wlType, name, allFlag)
+		}
+		deployers[name] = deployer
+	}
+	dependencies, err := workloadDependencyGraph(names, deployers)
+	if err != nil {
+		return err
+	}
+
+	envUpgradeCmd, err := o.newEnvUpgradeCmd(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("new env upgrade command: %v", err)
+	}
+	if err := envUpgradeCmd.Execute(); err != nil {
+		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.envName, err)
+	}
+
+	log.Infof("Building and pushing the images of %s.\n", english.WordSeries(names, "and"))
+	uploadErrs := graph.New(names...).Traverse(o.maxConcurrency, func(name string) error {
+		if err := deployers[name].uploadArtifacts(); err != nil {
+			return fmt.Errorf("upload artifacts: %w", err)
+		}
+		return nil
+	})
+	out := progressWriter(o.outputFormat, o.name)
+	wkldOut := progressWriters(out, o.maxConcurrency > 1)
+	deployErrs := dependencies.Traverse(o.maxConcurrency, func(name string) error {
+		if err := uploadErrs[name]; err != nil {
+			return err
+		}
+		log.Infof("Deploying %s to environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(o.envName))
+		if err := deployers[name].deployWorkload(wkldOut(name)); err != nil {
+			log.Errorf("Failed to deploy %s: %v\n", color.HighlightUserInput(name), err)
+			return err
+		}
+		return nil
+	})
+	logDeploySummary(names, deployErrs)
+	for _, name := range names {
+		writeResultEvent(out, deployResultEvent(o.appName, o.envName, name, deployErrs[name]))
+	}
+	if len(deployErrs) > 0 {
+		return fmt.Errorf("%d of %d workloads were not deployed to environment %s", len(deployErrs), len(names), o.envName)
+	}
+	return nil
+}
+
+func (o *deployOpts) validateAll() error {
+	if o.name != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s flags", allFlag, nameFlag)
+	}
+	if o.maxConcurrency < 1 {
+		return fmt.Errorf("--%s must be at least 1", maxConcurrencyFlag)
+	}
+	if o.outputFormat != "" {
+		if err := validateOutputFormat(o.outputFormat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment("Select an environment to deploy to", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// workloadDependencyGraph returns the graph with an edge from every workload to the workloads that depend on it.
+// Dependencies on workloads outside of the workspace, such as services that publish to topics from another
+// repository, are already deployed and ignored.
+func workloadDependencyGraph(names []string, deployers map[string]workloadDeployer) (*graph.Graph, error) {
+	dependencies := graph.New(names...)
+	for _, name := range names {
+		mft, err := deployers[name].manifest()
+		if err != nil {
+			return nil, err
+		}
+		for _, dep := range manifest.WorkloadDependencies(mft) {
+			if _, ok := deployers[dep]; !ok {
+				continue
+			}
+			dependencies.Add(graph.Edge{
+				From: dep,
+				To:   name,
+			})
+		}
+	}
+	if cycle, acyclic := dependencies.IsAcyclic(); !acyclic {
+		sort.Strings(cycle)
+		return nil, fmt.Errorf("circular dependency between workloads: %s", english.WordSeries(cycle, "and"))
+	}
+	return dependencies, nil
+}
+
+// logDeploySummary logs the outcome of deploying each of the names, which are either workloads or environments.
+func logDeploySummary(names []string, errs map[string]error) {
+	log.Infoln()
+	log.Infoln("Summary:")
+	for _, name := range names {
+		err, ok := errs[name]
+		if !ok {
+			log.Successf("%s: deployed\n", name)
+			continue
+		}
+		var errUpstream *graph.ErrUpstreamFailed
+		if errors.As(err, &errUpstream) {
+			log.Warningf("%s: skipped because %s was not deployed\n", name, errUpstream.Upstream)
+			continue
+		}
+		log.Errorf("%s: %v\n", name, err)
+	}
+}
+
+// progressWriters returns the writer of the progress of each deployment labeled by name.
+// The progress of stacks deployed at the same time would overwrite each other in the terminal,
+// so concurrent deployments write their progress as lines of status instead.
+func progressWriters(out termprogress.FileWriter, concurrent bool) func(name string) termprogress.FileWriter {
+	if lines, ok := out.(*termprogress.StatusLineWriter); ok {
+		return func(name string) termprogress.FileWriter {
+			return lines.WithLabel(name)
+		}
+	}
+	if _, isEventWriter := out.(termprogress.EventWriter); isEventWriter || !concurrent {
+		return func(string) termprogress.FileWriter {
+			return out
+		}
+	}
+	lines := termprogress.NewStatusLines(out)
+	return func(name string) termprogress.FileWriter {
+		return lines.Writer(name)
+	}
+}
+
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
@@ -177,7 +379,9 @@ func BuildDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys every service and job in the workspace to a "test" environment in dependency order.
+  /code $ copilot deploy --all --env test`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeployOpts(vars)
 			if err != nil {
@@ -195,6 +399,11 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceBuild, forceBuildFlag, false, forceBuildFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipScan, skipScanFlag, false, skipScanFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, deployAllFlagDescription)
+	cmd.Flags().IntVar(&vars.maxConcurrency, maxConcurrencyFlag, defaultDeployMaxConcurrency, maxConcurrencyFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, textOutputFormat, outputFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -5,10 +5,16 @@ package cli
 
 import (
 	"errors"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -142,10 +148,12 @@ func TestDeployOpts_Run(t *testing.T) {
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
 				},
 				deployWkld: mockCmd,
 				sel:        mockSel,
@@ -164,3 +172,251 @@ func TestDeployOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+type fakeWorkloadDeployer struct {
+	name      string
+	mft       interface{}
+	deployErr error
+	calls     *deployAllCalls
+}
+
+type deployAllCalls struct {
+	mu       sync.Mutex
+	uploaded []string
+	deployed []string
+}
+
+func (d *fakeWorkloadDeployer) Ask() error              { return nil }
+func (d *fakeWorkloadDeployer) Validate() error         { return nil }
+func (d *fakeWorkloadDeployer) Execute() error          { return nil }
+func (d *fakeWorkloadDeployer) RecommendActions() error { return nil }
+
+func (d *fakeWorkloadDeployer) manifest() (interface{}, error) {
+	return d.mft, nil
+}
+
+func (d *fakeWorkloadDeployer) uploadArtifacts() error {
+	d.calls.mu.Lock()
+	defer d.calls.mu.Unlock()
+	d.calls.uploaded = append(d.calls.uploaded, d.name)
+	return nil
+}
+
+func (d *fakeWorkloadDeployer) deployWorkload(out termprogress.FileWriter) error {
+	d.calls.mu.Lock()
+	defer d.calls.mu.Unlock()
+	d.calls.deployed = append(d.calls.deployed, d.name)
+	return d.deployErr
+}
+
+func TestDeployOpts_RunAll(t *testing.T) {
+	mockWorkloads := []string{"api", "mailer", "reports", "worker"}
+	dependsOn := func(names ...string) interface{} {
+		return &manifest.ScheduledJob{
+			Workload: manifest.Workload{DependsOn: names},
+		}
+	}
+	mockWorker := &manifest.WorkerService{
+		WorkerServiceConfig: manifest.WorkerServiceConfig{
+			Subscribe: manifest.SubscribeConfig{
+				Topics: []manifest.TopicSubscription{
+					{Name: aws.String("orders"), Service: aws.String("api")},
+					{Name: aws.String("invoices"), Service: aws.String("billing")},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inName           string
+		inEnvName        string
+		inMaxConcurrency int
+		inManifests      map[string]interface{}
+		inDeployErrs     map[string]error
+
+		setupMocks func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand)
+
+		wantedUploaded []string
+		wantedDeployed []string
+		wantedErr      string
+	}{
+		"error if a workload name is specified": {
+			inName:           "api",
+			inMaxConcurrency: 1,
+			setupMocks:       func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {},
+
+			wantedErr: "cannot specify both --all and --name flags",
+		},
+		"error if max concurrency is less than 1": {
+			setupMocks: func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {},
+
+			wantedErr: "--max-concurrency must be at least 1",
+		},
+		"error if workloads depend on each other": {
+			inEnvName:        "test",
+			inMaxConcurrency: 1,
+			inManifests: map[string]interface{}{
+				"api":     dependsOn("reports"),
+				"mailer":  dependsOn(),
+				"reports": dependsOn("api"),
+				"worker":  dependsOn(),
+			},
+			setupMocks: func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {
+				ws.EXPECT().ListWorkloads().Return(mockWorkloads, nil)
+			},
+
+			wantedErr: "circular dependency between workloads: api and reports",
+		},
+		"deploys workloads in dependency order": {
+			inMaxConcurrency: 1,
+			inManifests: map[string]interface{}{
+				"api":     dependsOn(),
+				"mailer":  dependsOn("worker"),
+				"reports": dependsOn(),
+				"worker":  mockWorker,
+			},
+			setupMocks: func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {
+				m.EXPECT().Environment("Select an environment to deploy to", "", "phonetool").Return("test", nil)
+				ws.EXPECT().ListWorkloads().Return(mockWorkloads, nil)
+				envUpgrade.EXPECT().Execute().Return(nil)
+			},
+
+			wantedUploaded: []string{"api", "mailer", "reports", "worker"},
+			wantedDeployed: []string{"api", "reports", "worker", "mailer"},
+		},
+		"skips the dependents of a failed workload": {
+			inEnvName:        "test",
+			inMaxConcurrency: 1,
+			inManifests: map[string]interface{}{
+				"api":     dependsOn(),
+				"mailer":  dependsOn("worker"),
+				"reports": dependsOn(),
+				"worker":  mockWorker,
+			},
+			inDeployErrs: map[string]error{
+				"api": errors.New("some error"),
+			},
+			setupMocks: func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {
+				ws.EXPECT().ListWorkloads().Return(mockWorkloads, nil)
+				envUpgrade.EXPECT().Execute().Return(nil)
+			},
+
+			wantedUploaded: []string{"api", "mailer", "reports", "worker"},
+			wantedDeployed: []string{"api", "reports"},
+			wantedErr:      "3 of 4 workloads were not deployed to environment test",
+		},
+		"error if the environment fails to upgrade": {
+			inEnvName:        "test",
+			inMaxConcurrency: 1,
+			inManifests: map[string]interface{}{
+				"api":     dependsOn(),
+				"mailer":  dependsOn(),
+				"reports": dependsOn(),
+				"worker":  dependsOn(),
+			},
+			setupMocks: func(m *mocks.MockwsSelector, ws *mocks.MockwsWlDirReader, envUpgrade *mocks.MockactionCommand) {
+				ws.EXPECT().ListWorkloads().Return(mockWorkloads, nil)
+				envUpgrade.EXPECT().Execute().Return(errors.New("some error"))
+			},
+
+			wantedErr: `execute "env upgrade --app phonetool --name test": some error`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockEnvUpgrade := mocks.NewMockactionCommand(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetWorkload("phonetool", gomock.Any()).DoAndReturn(func(app, name string) (*config.Workload, error) {
+				return &config.Workload{App: app, Name: name, Type: manifest.BackendServiceType}, nil
+			}).AnyTimes()
+			tc.setupMocks(mockSel, mockWs, mockEnvUpgrade)
+			calls := &deployAllCalls{}
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "phonetool",
+						name:    tc.inName,
+						envName: tc.inEnvName,
+					},
+					all:            true,
+					maxConcurrency: tc.inMaxConcurrency,
+				},
+				sel:   mockSel,
+				store: mockStore,
+				ws:    mockWs,
+
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					require.True(t, o.skipEnvUpgrade, "workloads must not upgrade the environment upgraded by --all")
+					o.deployWkld = &fakeWorkloadDeployer{
+						name:      o.name,
+						mft:       tc.inManifests[o.name],
+						deployErr: tc.inDeployErrs[o.name],
+						calls:     calls,
+					}
+				},
+				newEnvUpgradeCmd: func(appName, envName string) (actionCommand, error) {
+					return mockEnvUpgrade, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedUploaded, calls.uploaded)
+			require.Equal(t, tc.wantedDeployed, calls.deployed)
+		})
+	}
+}
+
+func TestProgressWriters(t *testing.T) {
+	t.Run("deployments one at a time render to the progress writer", func(t *testing.T) {
+		writerFor := progressWriters(os.Stderr, false)
+		require.Equal(t, os.Stderr, writerFor("api"))
+	})
+	t.Run("event writers are shared by concurrent deployments", func(t *testing.T) {
+		out := termprogress.NewJSONEventWriter(new(strings.Builder))
+		writerFor := progressWriters(out, true)
+		require.Equal(t, out, writerFor("api"))
+	})
+	t.Run("concurrent deployments write lines of status", func(t *testing.T) {
+		writerFor := progressWriters(os.Stderr, true)
+		w, ok := writerFor("api").(*termprogress.StatusLineWriter)
+		require.True(t, ok)
+		require.Equal(t, os.Stderr.Fd(), w.Fd())
+	})
+	t.Run("lines of status are labeled by deployment", func(t *testing.T) {
+		// GIVEN
+		f, err := os.CreateTemp(t.TempDir(), "progress")
+		require.NoError(t, err)
+		defer f.Close()
+		out := termprogress.NewStatusLines(f).Writer("all")
+		writerFor := progressWriters(out, false)
+
+		// WHEN
+		w, ok := writerFor("api").(*termprogress.StatusLineWriter)
+		require.True(t, ok)
+		err = w.WriteEvent(termprogress.Event{
+			Type:              termprogress.StackResourceEventType,
+			LogicalResourceID: "Service",
+			ResourceType:      "AWS::ECS::Service",
+			ResourceStatus:    "UPDATE_COMPLETE",
+		})
+
+		// THEN
+		require.NoError(t, err)
+		written, err := os.ReadFile(f.Name())
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(written), "api: Service (AWS::ECS::Service)"), string(written))
+	})
+}
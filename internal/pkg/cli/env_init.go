@@ -7,13 +7,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
@@ -69,6 +76,9 @@ https://aws.github.io/copilot-cli/docs/credentials/#environment-credentials`
 	fmtAddEnvToAppStart      = "Linking account %s and region %s to application %s."
 	fmtAddEnvToAppFailed     = "Failed to link account %s and region %s to application %s.\n\n"
 	fmtAddEnvToAppComplete   = "Linked account %s and region %s to application %s.\n\n"
+
+	// ECR requires the credentials of upstream registries to be stored in secrets with this name prefix.
+	pullThroughCacheSecretNamePrefix = "ecr-pullthroughcache/"
 )
 
 var (
@@ -77,6 +87,14 @@ var (
 	envInitAdjustEnvResourcesSelectOption = "Yes, but I'd like configure the default resources (CIDR ranges, AZs)."
 	envInitImportEnvResourcesSelectOption = "No, I'd like to import existing resources (VPC, subnets)."
 	envInitCustomizedEnvTypes             = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption, envInitImportEnvResourcesSelectOption}
+
+	ecrRepositoryPrefixRegexp = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*(?:/[a-z0-9]+(?:[._-][a-z0-9]+)*)*$`)
+	// Upstream registries that ECR only pulls through with credentials.
+	upstreamRegistriesRequireCredentials = map[string]bool{
+		"registry-1.docker.io": true,
+		"ghcr.io":              true,
+		"registry.gitlab.com":  true,
+	}
 )
 
 type importVPCVars struct {
@@ -111,6 +129,18 @@ func (v adjustVPCVars) isSet() bool {
 	return false
 }
 
+type publicLBVars struct {
+	WebACLARN           string
+	ManagedRules        bool
+	AccessLogs          bool
+	AccessLogsPrefix    string
+	AccessLogsRetention int
+}
+
+func (v publicLBVars) isSet() bool {
+	return v.WebACLARN != "" || v.ManagedRules || v.AccessLogs
+}
+
 type tempCredsVars struct {
 	AccessKeyID     string
 	SecretAccessKey string
@@ -131,8 +161,17 @@ type initEnvVars struct {
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
 
+	vpcEndpoints   bool         // True means creating VPC endpoints instead of NAT gateways for private subnets.
+	importCertARNs []string     // Existing ACM certificates for the HTTPS listener of the public load balancer.
+	publicLB       publicLBVars // Web ACL and access logs of the public load balancer.
+
+	pullThroughCache      map[string]string // Upstream registry URLs keyed by the ECR repository prefix of their pull through cache rule.
+	pullThroughCacheCreds map[string]string // Secrets Manager secret ARNs keyed by the ECR repository prefix of a pull through cache rule.
+
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
+
+	outputFormat string // Format of the progress of the environment deployment.
 }
 
 type initEnvOpts struct {
@@ -148,6 +187,7 @@ type initEnvOpts struct {
 	ec2Client    ec2Client
 	iam          roleManager
 	cfn          stackExistChecker
+	cacheRules   pullThroughCacheRuleLister
 	prog         progress
 	prompt       prompter
 	selVPC       ec2Selector
@@ -156,8 +196,11 @@ type initEnvOpts struct {
 	appCFN       appResourcesGetter
 	newS3        func(string) (uploader, error)
 	uploader     customResourcesUploader
+	envAddons    templater // Optional. Addons shared by all workloads in the environment, only set inside a workspace.
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
+
+	existingCacheRules map[string]bool // Repository prefixes of the pull through cache rules that already exist in the region.
 }
 
 func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
@@ -176,7 +219,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 	}
 
 	prompter := prompt.New()
-	return &initEnvOpts{
+	opts := &initEnvOpts{
 		initEnvVars:  vars,
 		sessProvider: sessProvider,
 		store:        store,
@@ -199,7 +242,11 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 			}
 			return s3.New(sess), nil
 		},
-	}, nil
+	}
+	if envAddons, err := addon.NewEnv(); err == nil {
+		opts.envAddons = envAddons
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values passed by flags are invalid.
@@ -216,6 +263,20 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := o.validateCerts(); err != nil {
+		return err
+	}
+	if err := o.validatePublicLB(); err != nil {
+		return err
+	}
+	if err := o.validatePullThroughCache(); err != nil {
+		return err
+	}
+	if o.outputFormat != "" {
+		if err := validateOutputFormat(o.outputFormat); err != nil {
+			return err
+		}
+	}
 	return o.validateCredentials()
 }
 
@@ -238,12 +299,23 @@ func (o *initEnvOpts) Ask() error {
 
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
 func (o *initEnvOpts) Execute() error {
+	out := progressWriter(o.outputFormat, o.name)
+	err := o.initEnv(out)
+	writeResultEvent(out, deployResultEvent(o.appName, o.name, "", err))
+	return err
+}
+
+// initEnv deploys the environment, and renders the progress of its stack to out.
+func (o *initEnvOpts) initEnv(out termprogress.FileWriter) error {
 	o.initRuntimeClients()
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
 		// Ensure the app actually exists before we do a deployment.
 		return err
 	}
+	if app.RequiresDNSDelegation() && len(o.importCertARNs) > 0 {
+		return fmt.Errorf("cannot specify --%s for application %s that is associated with domain %s", certsFlag, app.Name, app.Domain)
+	}
 
 	envCaller, err := o.envIdentity.Get()
 	if err != nil {
@@ -288,9 +360,13 @@ func (o *initEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
 	}
+	addonsURL, err := pushEnvAddonsTemplateToS3Bucket(o.envAddons, s3Client, resources.S3Bucket, o.name)
+	if err != nil {
+		return err
+	}
 
 	// 4. Start creating the CloudFormation stack for the environment.
-	if err := o.deployEnv(app, urls); err != nil {
+	if err := o.deployEnv(out, app, urls, addonsURL); err != nil {
 		return err
 	}
 
@@ -300,7 +376,8 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig(), o.vpcEndpoints, o.importCertARNs, o.publicLBConfig(),
+		o.pullThroughCacheRules())
 
 	// 6. Store the environment in SSM.
 	if err := o.store.CreateEnvironment(env); err != nil {
@@ -330,6 +407,9 @@ func (o *initEnvOpts) initRuntimeClients() {
 	if o.iam == nil {
 		o.iam = iam.New(o.sess)
 	}
+	if o.cacheRules == nil {
+		o.cacheRules = ecr.New(o.sess)
+	}
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
@@ -339,6 +419,9 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	if o.importVPC.isSet() && o.vpcEndpoints {
+		return fmt.Errorf("cannot specify both import vpc flags and --%s", vpcEndpointsFlag)
+	}
 	if o.importVPC.isSet() {
 		// Allow passing in VPC without subnets, but error out early for too few subnets-- we won't prompt the user to select more of one type if they pass in any.
 		if len(o.importVPC.PublicSubnetIDs) == 1 {
@@ -356,6 +439,78 @@ func (o *initEnvOpts) validateCustomizedResources() error {
 	return nil
 }
 
+func (o *initEnvOpts) validateCerts() error {
+	for _, certARN := range o.importCertARNs {
+		parsed, err := arn.Parse(certARN)
+		if err != nil {
+			return fmt.Errorf("parse certificate ARN %s: %w", certARN, err)
+		}
+		if parsed.Service != acm.ServiceName {
+			return fmt.Errorf("certificate ARN %s is not an ACM certificate", certARN)
+		}
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validatePublicLB() error {
+	if o.publicLB.WebACLARN != "" && o.publicLB.ManagedRules {
+		return fmt.Errorf("cannot specify both --%s and --%s", webACLARNFlag, wafManagedRulesFlag)
+	}
+	if o.publicLB.WebACLARN != "" {
+		parsed, err := arn.Parse(o.publicLB.WebACLARN)
+		if err != nil {
+			return fmt.Errorf("parse web ACL ARN %s: %w", o.publicLB.WebACLARN, err)
+		}
+		if parsed.Service != wafv2.ServiceName || !strings.HasPrefix(parsed.Resource, "regional/webacl/") {
+			return fmt.Errorf("web ACL ARN %s is not a regional AWS WAF web ACL", o.publicLB.WebACLARN)
+		}
+	}
+	if !o.publicLB.AccessLogs {
+		if o.publicLB.AccessLogsPrefix != "" {
+			return fmt.Errorf("--%s requires --%s", albAccessLogsPrefixFlag, albAccessLogsFlag)
+		}
+		if o.publicLB.AccessLogsRetention != 0 {
+			return fmt.Errorf("--%s requires --%s", albAccessLogsDaysFlag, albAccessLogsFlag)
+		}
+		return nil
+	}
+	prefix := o.publicLB.AccessLogsPrefix
+	if strings.HasPrefix(prefix, "/") || strings.HasSuffix(prefix, "/") || strings.Contains(prefix, "AWSLogs") {
+		return fmt.Errorf(`access logs prefix %s cannot start or end with "/" or contain "AWSLogs"`, prefix)
+	}
+	if o.publicLB.AccessLogsRetention < 0 {
+		return fmt.Errorf("--%s must be a positive number of days", albAccessLogsDaysFlag)
+	}
+	return nil
+}
+
+func (o *initEnvOpts) validatePullThroughCache() error {
+	for prefix, upstream := range o.pullThroughCache {
+		if len(prefix) < 2 || len(prefix) > 30 || !ecrRepositoryPrefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("pull through cache prefix %s must be 2 to 30 lowercase letters, numbers, and separators", prefix)
+		}
+		if upstream == "" || strings.Contains(upstream, "://") {
+			return fmt.Errorf("upstream registry URL %q of pull through cache prefix %s must be a hostname like public.ecr.aws", upstream, prefix)
+		}
+		if _, ok := o.pullThroughCacheCreds[prefix]; !ok && upstreamRegistriesRequireCredentials[upstream] {
+			return fmt.Errorf("upstream registry %s requires credentials for pull through cache prefix %s in --%s", upstream, prefix, pullThroughCacheCredsFlag)
+		}
+	}
+	for prefix, secretARN := range o.pullThroughCacheCreds {
+		if _, ok := o.pullThroughCache[prefix]; !ok {
+			return fmt.Errorf("credentials of pull through cache prefix %s require an upstream registry in --%s", prefix, pullThroughCacheFlag)
+		}
+		parsed, err := arn.Parse(secretARN)
+		if err != nil {
+			return fmt.Errorf("parse secret ARN %s: %w", secretARN, err)
+		}
+		if parsed.Service != secretsmanager.ServiceName || !strings.HasPrefix(parsed.Resource, "secret:"+pullThroughCacheSecretNamePrefix) {
+			return fmt.Errorf("credentials of pull through cache prefix %s must be a Secrets Manager secret whose name starts with %s", prefix, pullThroughCacheSecretNamePrefix)
+		}
+	}
+	return nil
+}
+
 func (o *initEnvOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
@@ -433,6 +588,10 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	if o.adjustVPC.isSet() {
 		return o.askAdjustResources()
 	}
+	if o.vpcEndpoints {
+		// VPC endpoints are only created in a VPC managed by Copilot, so there is nothing to import.
+		return nil
+	}
 	adjustOrImport, err := o.prompt.SelectOne(
 		envInitDefaultEnvConfirmPrompt, "",
 		envInitCustomizedEnvTypes,
@@ -644,11 +803,84 @@ func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
 	}
 }
 
-func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map[string]string) error {
+func (o *initEnvOpts) publicLBConfig() *config.PublicLoadBalancer {
+	if !o.publicLB.isSet() {
+		return nil
+	}
+	lb := &config.PublicLoadBalancer{
+		WebACLARN:    o.publicLB.WebACLARN,
+		ManagedRules: o.publicLB.ManagedRules,
+	}
+	if o.publicLB.AccessLogs {
+		lb.AccessLogs = &config.ALBAccessLogs{
+			Prefix:        o.publicLB.AccessLogsPrefix,
+			RetentionDays: o.publicLB.AccessLogsRetention,
+		}
+	}
+	return lb
+}
+
+// pullThroughCacheRules returns the pull through cache rules sorted by repository prefix.
+func (o *initEnvOpts) pullThroughCacheRules() []config.PullThroughCacheRule {
+	if len(o.pullThroughCache) == 0 {
+		return nil
+	}
+	var rules []config.PullThroughCacheRule
+	for prefix, upstream := range o.pullThroughCache {
+		rules = append(rules, config.PullThroughCacheRule{
+			RepositoryPrefix:    prefix,
+			UpstreamRegistryURL: upstream,
+			CredentialARN:       o.pullThroughCacheCreds[prefix],
+			Existing:            o.existingCacheRules[prefix],
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].RepositoryPrefix < rules[j].RepositoryPrefix
+	})
+	return rules
+}
+
+// findExistingPullThroughCacheRules records the pull through cache rules that already exist in the environment's region.
+// Rules are scoped to the account and region, so the environment stack only creates the rules that don't exist yet.
+func (o *initEnvOpts) findExistingPullThroughCacheRules() error {
+	if len(o.pullThroughCache) == 0 {
+		return nil
+	}
+	stackName := stack.NameForEnv(o.appName, o.name)
+	exists, err := o.cfn.Exists(stackName)
+	if err != nil {
+		return fmt.Errorf("check if stack %s exists: %w", stackName, err)
+	}
+	if exists {
+		// The stack won't be updated, and the rules in the region might have been created by it.
+		return nil
+	}
+	rules, err := o.cacheRules.PullThroughCacheRules()
+	if err != nil {
+		return err
+	}
+	o.existingCacheRules = make(map[string]bool)
+	for prefix, upstream := range o.pullThroughCache {
+		existing, ok := rules[prefix]
+		if !ok {
+			continue
+		}
+		if existing != upstream {
+			return fmt.Errorf("pull through cache prefix %s already caches %s in region %s", prefix, existing, aws.StringValue(o.sess.Config.Region))
+		}
+		o.existingCacheRules[prefix] = true
+	}
+	return nil
+}
+
+func (o *initEnvOpts) deployEnv(out termprogress.FileWriter, app *config.Application, customResourcesURLs map[string]string, addonsURL string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	if err := o.findExistingPullThroughCacheRules(); err != nil {
+		return err
+	}
 	deployEnvInput := &deploy.CreateEnvironmentInput{
 		Name: o.name,
 		App: deploy.AppInformation{
@@ -656,18 +888,23 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 			DNSName:             app.Domain,
 			AccountPrincipalARN: caller.RootUserARN,
 		},
-		Prod:                o.isProduction,
-		AdditionalTags:      app.Tags,
-		CustomResourcesURLs: customResourcesURLs,
-		AdjustVPCConfig:     o.adjustVPCConfig(),
-		ImportVPCConfig:     o.importVPCConfig(),
-		Version:             deploy.LatestEnvTemplateVersion,
+		Prod:                  o.isProduction,
+		AdditionalTags:        app.Tags,
+		CustomResourcesURLs:   customResourcesURLs,
+		AdjustVPCConfig:       o.adjustVPCConfig(),
+		ImportVPCConfig:       o.importVPCConfig(),
+		VPCEndpoints:          o.vpcEndpoints,
+		ImportCertARNs:        o.importCertARNs,
+		PublicLoadBalancer:    o.publicLBConfig(),
+		PullThroughCacheRules: o.pullThroughCacheRules(),
+		AddonsTemplateURL:     addonsURL,
+		Version:               deploy.LatestEnvTemplateVersion,
 	}
 
 	if err := o.cleanUpDanglingRoles(o.appName, o.name); err != nil {
 		return err
 	}
-	if err := o.envDeployer.DeployAndRenderEnvironment(os.Stderr, deployEnvInput); err != nil {
+	if err := o.envDeployer.DeployAndRenderEnvironment(out, deployEnvInput); err != nil {
 		var existsErr *cloudformation.ErrStackAlreadyExists
 		if errors.As(err, &existsErr) {
 			// Do nothing if the stack already exists.
@@ -681,6 +918,27 @@ func (o *initEnvOpts) deployEnv(app *config.Application, customResourcesURLs map
 	return nil
 }
 
+// pushEnvAddonsTemplateToS3Bucket uploads the addons template shared by the workloads in an environment, and returns its URL.
+// If there are no environment addons, the URL is empty.
+func pushEnvAddonsTemplateToS3Bucket(addons templater, s3Client uploader, bucket, envName string) (string, error) {
+	if addons == nil {
+		return "", nil
+	}
+	tpl, err := addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve environment addons template: %w", err)
+	}
+	url, err := s3Client.Upload(bucket, fmt.Sprintf(deploy.EnvAddonsCfnTemplateNameFormat, envName), strings.NewReader(tpl))
+	if err != nil {
+		return "", fmt.Errorf("put environment addons artifact to bucket %s: %w", bucket, err)
+	}
+	return url, nil
+}
+
 func (o *initEnvOpts) addToStackset(opts *deploycfn.AddEnvToAppOpts) error {
 	o.prog.Start(fmt.Sprintf(fmtAddEnvToAppStart, color.Emphasize(opts.EnvAccountID), color.Emphasize(opts.EnvRegion), color.HighlightUserInput(o.appName)))
 	if err := o.appDeployer.AddEnvToApp(opts); err != nil {
@@ -781,7 +1039,20 @@ func buildEnvInitCmd() *cobra.Command {
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-az-names us-west-2b,us-west-2c \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment whose private subnets reach AWS services through VPC endpoints instead of NAT gateways.
+  /code $ copilot env init --name dev --default-config --vpc-endpoints
+
+  Creates an environment that serves HTTPS with existing ACM certificates.
+  /code $ copilot env init --name prod --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/5f2b1d5e-1a1f-4b39-9a56-8b6f0cba1f32
+
+  Creates an environment whose public load balancer is protected by AWS managed WAF rules and keeps access logs for 90 days.
+  /code $ copilot env init --name prod --waf-managed-rules --alb-access-logs --alb-access-logs-retention 90
+
+  Creates an environment that caches the public images of Amazon ECR Public and Docker Hub in ECR.
+  /code $ copilot env init --name test --pull-through-cache ecr-public=public.ecr.aws,docker-hub=registry-1.docker.io \
+  /code --pull-through-cache-credentials docker-hub=arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -803,6 +1074,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, certsFlag, nil, certsFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, overrideVPCCIDRFlag, net.IPNet{}, overrideVPCCIDRFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.AZs, overrideAZsFlag, nil, overrideAZsFlagDescription)
@@ -810,6 +1082,15 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, overridePublicSubnetCIDRsFlag, nil, overridePublicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, overridePrivateSubnetCIDRsFlag, nil, overridePrivateSubnetCIDRsFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.vpcEndpoints, vpcEndpointsFlag, false, vpcEndpointsFlagDescription)
+	cmd.Flags().StringVar(&vars.publicLB.WebACLARN, webACLARNFlag, "", webACLARNFlagDescription)
+	cmd.Flags().BoolVar(&vars.publicLB.ManagedRules, wafManagedRulesFlag, false, wafManagedRulesFlagDescription)
+	cmd.Flags().BoolVar(&vars.publicLB.AccessLogs, albAccessLogsFlag, false, albAccessLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.publicLB.AccessLogsPrefix, albAccessLogsPrefixFlag, "", albAccessLogsPrefixFlagDescription)
+	cmd.Flags().IntVar(&vars.publicLB.AccessLogsRetention, albAccessLogsDaysFlag, 0, albAccessLogsDaysFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.pullThroughCache, pullThroughCacheFlag, nil, pullThroughCacheFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.pullThroughCacheCreds, pullThroughCacheCredsFlag, nil, pullThroughCacheCredsFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, textOutputFormat, outputFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -821,24 +1102,37 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(outputFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(certsFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(overrideVPCCIDRFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(overrideAZsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(overridePublicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(overridePrivateSubnetCIDRsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcEndpointsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(pullThroughCacheFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(pullThroughCacheCredsFlag))
+
+	publicLBFlag := pflag.NewFlagSet("Configure Public Load Balancer", pflag.ContinueOnError)
+	publicLBFlag.AddFlag(cmd.Flags().Lookup(webACLARNFlag))
+	publicLBFlag.AddFlag(cmd.Flags().Lookup(wafManagedRulesFlag))
+	publicLBFlag.AddFlag(cmd.Flags().Lookup(albAccessLogsFlag))
+	publicLBFlag.AddFlag(cmd.Flags().Lookup(albAccessLogsPrefixFlag))
+	publicLBFlag.AddFlag(cmd.Flags().Lookup(albAccessLogsDaysFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                    "Common,Import Existing Resources,Configure Default Resources",
-		"Common":                      flags.FlagUsages(),
-		"Import Existing Resources":   resourcesImportFlag.FlagUsages(),
-		"Configure Default Resources": resourcesConfigFlag.FlagUsages(),
+		"sections":                       "Common,Import Existing Resources,Configure Default Resources,Configure Public Load Balancer",
+		"Common":                         flags.FlagUsages(),
+		"Import Existing Resources":      resourcesImportFlag.FlagUsages(),
+		"Configure Default Resources":    resourcesConfigFlag.FlagUsages(),
+		"Configure Public Load Balancer": publicLBFlag.FlagUsages(),
 	}
 
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
@@ -41,9 +41,15 @@ type initEnvMocks struct {
 
 func TestInitEnvOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inEnvName string
-		inAppName string
-		inDefault bool
+		inEnvName      string
+		inAppName      string
+		inDefault      bool
+		inVPCEndpoints bool
+		inCertARNs     []string
+		inPublicLB     publicLBVars
+
+		inPullThroughCache      map[string]string
+		inPullThroughCacheCreds map[string]string
 
 		inVPCID      string
 		inPublicIDs  []string
@@ -111,6 +117,141 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			},
 			wantedErrMsg: fmt.Sprintf("cannot import or configure vpc if --%s is set", defaultConfigFlag),
 		},
+		"cannot create VPC endpoints in an imported VPC": {
+			inEnvName:      "test-pdx",
+			inAppName:      "phonetool",
+			inVPCID:        "mockID",
+			inVPCEndpoints: true,
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot specify both import vpc flags and --%s", vpcEndpointsFlag),
+		},
+		"should err if a certificate ARN is invalid": {
+			inEnvName:  "test-pdx",
+			inAppName:  "phonetool",
+			inCertARNs: []string{"mockCert"},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "parse certificate ARN mockCert: arn: invalid prefix",
+		},
+		"should err if a certificate is not managed by ACM": {
+			inEnvName:  "test-pdx",
+			inAppName:  "phonetool",
+			inCertARNs: []string{"arn:aws:iam::123456789012:server-certificate/mockCert"},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "certificate ARN arn:aws:iam::123456789012:server-certificate/mockCert is not an ACM certificate",
+		},
+		"should err if both a web ACL ARN and managed rules are set": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPublicLB: publicLBVars{
+				WebACLARN:    "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mockACL/abc",
+				ManagedRules: true,
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("cannot specify both --%s and --%s", webACLARNFlag, wafManagedRulesFlag),
+		},
+		"should err if the web ACL is not regional": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPublicLB: publicLBVars{
+				WebACLARN: "arn:aws:wafv2:us-east-1:123456789012:global/webacl/mockACL/abc",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "web ACL ARN arn:aws:wafv2:us-east-1:123456789012:global/webacl/mockACL/abc is not a regional AWS WAF web ACL",
+		},
+		"should err if access logs options are set without access logs": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPublicLB: publicLBVars{
+				AccessLogsPrefix: "prod",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("--%s requires --%s", albAccessLogsPrefixFlag, albAccessLogsFlag),
+		},
+		"should err if the access logs prefix is invalid": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPublicLB: publicLBVars{
+				AccessLogs:       true,
+				AccessLogsPrefix: "prod/",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: `access logs prefix prod/ cannot start or end with "/" or contain "AWSLogs"`,
+		},
+		"should err if a pull through cache prefix is invalid": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPullThroughCache: map[string]string{
+				"ECR_Public": "public.ecr.aws",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "pull through cache prefix ECR_Public must be 2 to 30 lowercase letters, numbers, and separators",
+		},
+		"should err if an upstream registry requires credentials": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPullThroughCache: map[string]string{
+				"docker-hub": "registry-1.docker.io",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("upstream registry registry-1.docker.io requires credentials for pull through cache prefix docker-hub in --%s", pullThroughCacheCredsFlag),
+		},
+		"should err if the credentials of an upstream registry are not in a pull through cache secret": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPullThroughCache: map[string]string{
+				"docker-hub": "registry-1.docker.io",
+			},
+			inPullThroughCacheCreds: map[string]string{
+				"docker-hub": "arn:aws:secretsmanager:us-west-2:123456789012:secret:docker-hub-creds",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: "credentials of pull through cache prefix docker-hub must be a Secrets Manager secret whose name starts with ecr-pullthroughcache/",
+		},
+		"should err if credentials are set without an upstream registry": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPullThroughCacheCreds: map[string]string{
+				"docker-hub": "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+			wantedErrMsg: fmt.Sprintf("credentials of pull through cache prefix docker-hub require an upstream registry in --%s", pullThroughCacheFlag),
+		},
+		"should succeed with pull through cache rules": {
+			inEnvName: "test-pdx",
+			inAppName: "phonetool",
+			inPullThroughCache: map[string]string{
+				"ecr-public": "public.ecr.aws",
+				"docker-hub": "registry-1.docker.io",
+			},
+			inPullThroughCacheCreds: map[string]string{
+				"docker-hub": "arn:aws:secretsmanager:us-west-2:123456789012:secret:ecr-pullthroughcache/docker-hub",
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+			},
+		},
 		"should err if both profile and access key id are set": {
 			inAppName:     "phonetool",
 			inEnvName:     "test",
@@ -185,8 +326,14 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 			// GIVEN
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					name:          tc.inEnvName,
-					defaultConfig: tc.inDefault,
+					name:           tc.inEnvName,
+					defaultConfig:  tc.inDefault,
+					vpcEndpoints:   tc.inVPCEndpoints,
+					importCertARNs: tc.inCertARNs,
+					publicLB:       tc.inPublicLB,
+
+					pullThroughCache:      tc.inPullThroughCache,
+					pullThroughCacheCreds: tc.inPullThroughCacheCreds,
 					adjustVPC: adjustVPCVars{
 						AZs:               tc.inAZs,
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
@@ -256,6 +403,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inTempCreds     tempCredsVars
 		inRegion        string
 		inDefault       bool
+		inVPCEndpoints  bool
 		inImportVPCVars importVPCVars
 		inAdjustVPCVars adjustVPCVars
 
@@ -384,6 +532,16 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			},
 		},
+		"should not prompt for importing resources if VPC endpoints are enabled": {
+			inAppName:      mockApp,
+			inEnv:          mockEnv,
+			inProfile:      mockProfile,
+			inVPCEndpoints: true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
 		"fail to select whether to adjust or import resources": {
 			inAppName: mockApp,
 			inEnv:     mockEnv,
@@ -782,6 +940,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					tempCreds:     tc.inTempCreds,
 					region:        tc.inRegion,
 					defaultConfig: tc.inDefault,
+					vpcEndpoints:  tc.inVPCEndpoints,
 					adjustVPC:     tc.inAdjustVPCVars,
 					importVPC:     tc.inImportVPCVars,
 				},
@@ -810,7 +969,8 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 
 func TestInitEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
-		inProd bool
+		inProd             bool
+		inPullThroughCache map[string]string
 
 		expectStore             func(m *mocks.Mockstore)
 		expectDeployer          func(m *mocks.Mockdeployer)
@@ -820,6 +980,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		expectCFN               func(m *mocks.MockstackExistChecker)
 		expectAppCFN            func(m *mocks.MockappResourcesGetter)
 		expectResourcesUploader func(m *mocks.MockcustomResourcesUploader)
+		expectCacheRules        func(m *mocks.MockpullThroughCacheRuleLister)
 
 		wantedErrorS string
 	}{
@@ -1057,6 +1218,129 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
 			},
 		},
+		"errors if a pull through cache prefix caches another upstream registry": {
+			inPullThroughCache: map[string]string{
+				"ecr-public": "public.ecr.aws",
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(false, nil)
+			},
+			expectCacheRules: func(m *mocks.MockpullThroughCacheRuleLister) {
+				m.EXPECT().PullThroughCacheRules().Return(map[string]string{
+					"ecr-public": "quay.io",
+				}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+			},
+			wantedErrorS: "pull through cache prefix ecr-public already caches quay.io in region us-west-2",
+		},
+		"only creates the pull through cache rules that don't exist in the region": {
+			inPullThroughCache: map[string]string{
+				"ecr-public": "public.ecr.aws",
+				"quay":       "quay.io",
+			},
+			expectStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.EXPECT().CreateEnvironment(&config.Environment{
+					App:       "phonetool",
+					Name:      "test",
+					AccountID: "1234",
+					Region:    "mars-1",
+					CustomConfig: &config.CustomizeEnv{
+						PullThroughCacheRules: []config.PullThroughCacheRule{
+							{
+								RepositoryPrefix:    "ecr-public",
+								UpstreamRegistryURL: "public.ecr.aws",
+								Existing:            true,
+							},
+							{
+								RepositoryPrefix:    "quay",
+								UpstreamRegistryURL: "quay.io",
+							},
+						},
+					},
+				}).Return(nil)
+			},
+			expectIdentity: func(m *mocks.MockidentityService) {
+				m.EXPECT().Get().Return(identity.Caller{RootUserARN: "some arn", Account: "1234"}, nil).Times(2)
+			},
+			expectIAM: func(m *mocks.MockroleManager) {
+				m.EXPECT().CreateECSServiceLinkedRole().Return(nil)
+				m.EXPECT().ListRoleTags(gomock.Any()).Return(nil, errors.New("does not exist")).Times(2)
+			},
+			expectCFN: func(m *mocks.MockstackExistChecker) {
+				m.EXPECT().Exists("phonetool-test").Return(false, nil).Times(2)
+			},
+			expectCacheRules: func(m *mocks.MockpullThroughCacheRuleLister) {
+				m.EXPECT().PullThroughCacheRules().Return(map[string]string{
+					"ecr-public": "public.ecr.aws",
+				}, nil)
+			},
+			expectProgress: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddEnvToAppStart, "1234", "us-west-2", "phonetool"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddEnvToAppComplete, "1234", "us-west-2", "phonetool"))
+			},
+			expectDeployer: func(m *mocks.Mockdeployer) {
+				m.EXPECT().DeployAndRenderEnvironment(gomock.Any(), &deploy.CreateEnvironmentInput{
+					Name: "test",
+					App: deploy.AppInformation{
+						Name:                "phonetool",
+						AccountPrincipalARN: "some arn",
+					},
+					PullThroughCacheRules: []config.PullThroughCacheRule{
+						{
+							RepositoryPrefix:    "ecr-public",
+							UpstreamRegistryURL: "public.ecr.aws",
+							Existing:            true,
+						},
+						{
+							RepositoryPrefix:    "quay",
+							UpstreamRegistryURL: "quay.io",
+						},
+					},
+					Version: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					AccountID: "1234",
+					Region:    "mars-1",
+					Name:      "test",
+					App:       "phonetool",
+				}, nil)
+				m.EXPECT().AddEnvToApp(gomock.Any()).Return(nil)
+			},
+			expectAppCFN: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+			},
+			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
+				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
+			},
+		},
 		"skips creating stack if environment stack already exists": {
 			expectStore: func(m *mocks.Mockstore) {
 				m.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
@@ -1195,9 +1479,13 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockCFN := mocks.NewMockstackExistChecker(ctrl)
 			mockResourcesUploader := mocks.NewMockcustomResourcesUploader(ctrl)
 			mockUploader := mocks.NewMockuploader(ctrl)
+			mockCacheRules := mocks.NewMockpullThroughCacheRuleLister(ctrl)
 			if tc.expectStore != nil {
 				tc.expectStore(mockStore)
 			}
+			if tc.expectCacheRules != nil {
+				tc.expectCacheRules(mockCacheRules)
+			}
 			if tc.expectDeployer != nil {
 				tc.expectDeployer(mockDeployer)
 			}
@@ -1225,9 +1513,10 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 
 			opts := &initEnvOpts{
 				initEnvVars: initEnvVars{
-					name:         "test",
-					appName:      "phonetool",
-					isProduction: tc.inProd,
+					name:             "test",
+					appName:          "phonetool",
+					isProduction:     tc.inProd,
+					pullThroughCache: tc.inPullThroughCache,
 				},
 				store:       mockStore,
 				envDeployer: mockDeployer,
@@ -1236,6 +1525,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				envIdentity: mockIdentity,
 				iam:         mockIAM,
 				cfn:         mockCFN,
+				cacheRules:  mockCacheRules,
 				prog:        mockProgress,
 				sess:        sess,
 				appCFN:      mockAppCFN,
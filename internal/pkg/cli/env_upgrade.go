@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -55,6 +56,7 @@ type envUpgradeOpts struct {
 	prog               progress
 	appCFN             appResourcesGetter
 	uploader           customResourcesUploader
+	envAddons          templater // Optional. Addons shared by all workloads in the environment, only set inside a workspace.
 
 	// Constructors for clients that can be initialized only at runtime.
 	// These functions are overridden in tests to provide mocks.
@@ -72,7 +74,7 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &envUpgradeOpts{
+	opts := &envUpgradeOpts{
 		envUpgradeVars: vars,
 
 		store: store,
@@ -112,7 +114,11 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 			}
 			return s3.New(sess), nil
 		},
-	}, nil
+	}
+	if envAddons, err := addon.NewEnv(); err == nil {
+		opts.envAddons = envAddons
+	}
+	return opts, nil
 }
 
 // Validate returns an error if the values passed by flags are invalid.
@@ -180,7 +186,11 @@ func (o *envUpgradeOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("upload custom resources to bucket %s: %w", resources.S3Bucket, err)
 		}
-		if err := o.upgrade(env, urls); err != nil {
+		addonsURL, err := pushEnvAddonsTemplateToS3Bucket(o.envAddons, s3Client, resources.S3Bucket, env.Name)
+		if err != nil {
+			return err
+		}
+		if err := o.upgrade(env, urls, addonsURL); err != nil {
 			return err
 		}
 	}
@@ -208,12 +218,14 @@ func (o *envUpgradeOpts) listEnvsToUpgrade() ([]*config.Environment, error) {
 	return envs, nil
 }
 
-func (o *envUpgradeOpts) upgrade(env *config.Environment, customResourcesURLs map[string]string) (err error) {
+func (o *envUpgradeOpts) upgrade(env *config.Environment, customResourcesURLs map[string]string, addonsURL string) (err error) {
 	version, err := o.envVersion(env.Name)
 	if err != nil {
 		return err
 	}
-	if !shouldUpgradeEnv(env.Name, version) {
+	// Environments on the latest version are still updated so that changes to their addons are deployed.
+	redeployAddons := addonsURL != "" && version == deploy.LatestEnvTemplateVersion
+	if !redeployAddons && !shouldUpgradeEnv(env.Name, version) {
 		return nil
 	}
 
@@ -230,9 +242,28 @@ func (o *envUpgradeOpts) upgrade(env *config.Environment, customResourcesURLs ma
 		return err
 	}
 	if version == deploy.LegacyEnvTemplateVersion {
-		return o.upgradeLegacyEnvironment(upgrader, env, customResourcesURLs, version, deploy.LatestEnvTemplateVersion)
+		return o.upgradeLegacyEnvironment(upgrader, env, customResourcesURLs, addonsURL, version, deploy.LatestEnvTemplateVersion)
+	}
+	var deployedAddons *deploy.EnvAddons
+	if addonsURL == "" {
+		// Keep the addons that were deployed from another workspace, or when upgrading before deploying a workload.
+		if deployedAddons, err = deployedEnvAddons(upgrader, env); err != nil {
+			return err
+		}
+	}
+	return o.upgradeEnvironment(upgrader, env, customResourcesURLs, addonsURL, deployedAddons, version, deploy.LatestEnvTemplateVersion)
+}
+
+func deployedEnvAddons(cfn envTemplater, env *config.Environment) (*deploy.EnvAddons, error) {
+	tpl, err := cfn.EnvironmentTemplate(env.App, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s template body: %v", env.Name, err)
+	}
+	addons, err := stack.DeployedEnvAddons(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("read addons of environment %s: %v", env.Name, err)
 	}
-	return o.upgradeEnvironment(upgrader, env, customResourcesURLs, version, deploy.LatestEnvTemplateVersion)
+	return addons, nil
 }
 
 func (o *envUpgradeOpts) envVersion(name string) (string, error) {
@@ -267,12 +298,20 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 }
 
 func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.Environment,
-	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
+	customResourcesURLs map[string]string, addonsURL string, deployedAddons *deploy.EnvAddons, fromVersion, toVersion string) error {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
+	var vpcEndpoints bool
+	var importedCerts []string
+	var publicLB *config.PublicLoadBalancer
+	var cacheRules []config.PullThroughCacheRule
 	if conf.CustomConfig != nil {
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
+		vpcEndpoints = conf.CustomConfig.VPCEndpoints
+		importedCerts = conf.CustomConfig.ImportCertARNs
+		publicLB = conf.CustomConfig.PublicLoadBalancer
+		cacheRules = conf.CustomConfig.PullThroughCacheRules
 	}
 
 	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
@@ -280,11 +319,17 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		App: deploy.AppInformation{
 			Name: conf.App,
 		},
-		Name:                conf.Name,
-		CustomResourcesURLs: customResourcesURLs,
-		ImportVPCConfig:     importedVPC,
-		AdjustVPCConfig:     adjustedVPC,
-		CFNServiceRoleARN:   conf.ExecutionRoleARN,
+		Name:                  conf.Name,
+		CustomResourcesURLs:   customResourcesURLs,
+		ImportVPCConfig:       importedVPC,
+		AdjustVPCConfig:       adjustedVPC,
+		VPCEndpoints:          vpcEndpoints,
+		ImportCertARNs:        importedCerts,
+		PublicLoadBalancer:    publicLB,
+		PullThroughCacheRules: cacheRules,
+		AddonsTemplateURL:     addonsURL,
+		DeployedAddons:        deployedAddons,
+		CFNServiceRoleARN:     conf.ExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 	}
@@ -292,7 +337,7 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 }
 
 func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, conf *config.Environment,
-	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
+	customResourcesURLs map[string]string, addonsURL, fromVersion, toVersion string) error {
 	isDefaultEnv, err := o.isDefaultLegacyTemplate(upgrader, conf.App, conf.Name)
 	if err != nil {
 		return err
@@ -309,13 +354,14 @@ func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, co
 			},
 			Name:                conf.Name,
 			CustomResourcesURLs: customResourcesURLs,
+			AddonsTemplateURL:   addonsURL,
 			CFNServiceRoleARN:   conf.ExecutionRoleARN,
 		}, albWorkloads...); err != nil {
 			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
 		}
 		return nil
 	}
-	return o.upgradeLegacyEnvironmentWithVPCOverrides(upgrader, conf, fromVersion, toVersion, albWorkloads)
+	return o.upgradeLegacyEnvironmentWithVPCOverrides(upgrader, conf, addonsURL, fromVersion, toVersion, albWorkloads)
 }
 
 func (o *envUpgradeOpts) isDefaultLegacyTemplate(cfn envTemplater, appName, envName string) (bool, error) {
@@ -346,7 +392,7 @@ func (o *envUpgradeOpts) listLBWebServices() ([]string, error) {
 }
 
 func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legacyEnvUpgrader, conf *config.Environment,
-	fromVersion, toVersion string, albWorkloads []string) error {
+	addonsURL, fromVersion, toVersion string, albWorkloads []string) error {
 	if conf.CustomConfig != nil {
 		if err := upgrader.UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
 			Version: toVersion,
@@ -356,6 +402,8 @@ func (o *envUpgradeOpts) upgradeLegacyEnvironmentWithVPCOverrides(upgrader legac
 			Name:              conf.Name,
 			ImportVPCConfig:   conf.CustomConfig.ImportVPC,
 			AdjustVPCConfig:   conf.CustomConfig.VPCConfig,
+			VPCEndpoints:      conf.CustomConfig.VPCEndpoints,
+			AddonsTemplateURL: addonsURL,
 			CFNServiceRoleARN: conf.ExecutionRoleARN,
 		}, albWorkloads...); err != nil {
 			return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
@@ -246,6 +246,70 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should redeploy environments on the latest version that have addons": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return(deploy.LatestEnvTemplateVersion, nil)
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{
+						App:              "phonetool",
+						Name:             "test",
+						Region:           "us-west-2",
+						ExecutionRoleARN: "execARN",
+					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+				mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+				mockUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+				mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().Return("Resources: {}", nil)
+				mockS3 := mocks.NewMockuploader(ctrl)
+				mockS3.EXPECT().Upload("mockBucket", "environments/test.addons.stack.yml", gomock.Any()).Return("mockAddonsURL", nil)
+
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					Version: deploy.LatestEnvTemplateVersion,
+					App: deploy.AppInformation{
+						Name: "phonetool",
+					},
+					Name:                "test",
+					CFNServiceRoleARN:   "execARN",
+					CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+					AddonsTemplateURL:   "mockAddonsURL",
+				}).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					prog:  mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newTemplateUpgrader: func(conf *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+					uploader:  mockUploader,
+					appCFN:    mockAppCFN,
+					envAddons: mockAddons,
+					newS3: func(region string) (uploader, error) {
+						return mockS3, nil
+					},
+				}
+			},
+		},
 		"should upgrade non-legacy environments with UpgradeEnvironment call": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
 				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
@@ -278,6 +342,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
 
 				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return("Resources: {}", nil)
 				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
 					Version: deploy.LatestEnvTemplateVersion,
 					App: deploy.AppInformation{
@@ -312,6 +377,92 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should keep the deployed addons when upgrading without an addons directory": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v0.1.0", nil) // Legacy versions are v0.0.0
+
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").
+					Return(&config.Environment{
+						App:              "phonetool",
+						Name:             "test",
+						Region:           "us-west-2",
+						ExecutionRoleARN: "execARN",
+						CustomConfig: &config.CustomizeEnv{
+							ImportVPC: &config.ImportVPC{
+								ID: "abc",
+							},
+						},
+					}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+				mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						S3Bucket: "mockBucket",
+					}, nil)
+				mockUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+				mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
+
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return(`Resources:
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      Parameters:
+        App: !Ref AppName
+        Env: !Ref EnvironmentName
+        DBName: orders
+      TemplateURL: mockAddonsURL
+Outputs:
+  VpcId:
+    Value: !Ref VPC
+  AddonsDBEndpoint:
+    Value: !GetAtt AddonsStack.Outputs.DBEndpoint
+`, nil)
+				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					Version: deploy.LatestEnvTemplateVersion,
+					App: deploy.AppInformation{
+						Name: "phonetool",
+					},
+					Name: "test",
+					ImportVPCConfig: &config.ImportVPC{
+						ID: "abc",
+					},
+					DeployedAddons: &deploy.EnvAddons{
+						TemplateURL: "mockAddonsURL",
+						Parameters:  "DBName: orders\n",
+						Outputs:     []string{"DBEndpoint"},
+					},
+					CFNServiceRoleARN:   "execARN",
+					CustomResourcesURLs: map[string]string{"mockCustomResource": "mockURL"},
+				}).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					prog:  mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newTemplateUpgrader: func(conf *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+					uploader: mockUploader,
+					appCFN:   mockAppCFN,
+					newS3: func(region string) (uploader, error) {
+						return mocks.NewMockuploader(ctrl), nil
+					},
+				}
+			},
+		},
 		"should upgrade default legacy environments without any VPC configuration": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
 				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
@@ -30,7 +30,15 @@ const (
 	// Command specific flags.
 	dockerFileFlag        = "dockerfile"
 	dockerFileContextFlag = "build-context"
+	buildTargetFlag       = "target"
 	imageTagFlag          = "tag"
+	forceBuildFlag        = "force-build"
+	skipScanFlag          = "skip-scan"
+	maxConcurrencyFlag    = "max-concurrency"
+	allEnvsFlag           = "all-envs"
+	parallelFlag          = "parallel"
+	confirmProdFlag       = "confirm-prod"
+	outputFlag            = "output"
 	resourceTagsFlag      = "resource-tags"
 	stackOutputDirFlag    = "output-dir"
 	limitFlag             = "limit"
@@ -49,9 +57,12 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	domainNameFlag        = "domain"
+	signatureKeyFlag      = "signature-key"
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	watchFlag             = "watch"
+	waitFlag              = "wait"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -82,17 +93,29 @@ const (
 	entrypointFlag      = "entrypoint"
 	taskDefaultFlag     = "default"
 	generateCommandFlag = "generate-cmd"
+	fromWorkloadFlag    = "from-workload"
 	osFlag              = "platform-os"
 	archFlag            = "platform-arch"
 
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
+	certsFlag          = "import-cert-arns"
 
 	overrideVPCCIDRFlag            = "override-vpc-cidr"
 	overrideAZsFlag                = "override-az-names"
 	overridePublicSubnetCIDRsFlag  = "override-public-cidrs"
 	overridePrivateSubnetCIDRsFlag = "override-private-cidrs"
+	vpcEndpointsFlag               = "vpc-endpoints"
+
+	webACLARNFlag           = "waf-web-acl-arn"
+	wafManagedRulesFlag     = "waf-managed-rules"
+	albAccessLogsFlag       = "alb-access-logs"
+	albAccessLogsPrefixFlag = "alb-access-logs-prefix"
+	albAccessLogsDaysFlag   = "alb-access-logs-retention"
+
+	pullThroughCacheFlag      = "pull-through-cache"
+	pullThroughCacheCredsFlag = "pull-through-cache-credentials"
 
 	defaultConfigFlag = "default-config"
 
@@ -105,10 +128,19 @@ const (
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
 
-	taskIDFlag    = "task-id"
-	containerFlag = "container"
+	taskIDFlag     = "task-id"
+	containerFlag  = "container"
+	remoteHostFlag = "remote-host"
+
+	queueFlag       = "queue"
+	deadLetterFlag  = "dlq"
+	liveQueueFlag   = "live"
+	maxMessagesFlag = "max"
+	rateFlag        = "rate"
 
 	valuesFlag        = "values"
+	fromFlag          = "from"
+	refFlag           = "ref"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
 
@@ -145,6 +177,8 @@ Mutually exclusive with -%s, --%s.`, dockerFileFlagShort, dockerFileFlag)
 Mutually exclusive with -%s, --%s.`, imageFlagShort, imageFlag)
 	dockerFileContextFlagDescription = fmt.Sprintf(`Path to the Docker build context.
 Mutually exclusive with -%s, --%s.`, imageFlagShort, imageFlag)
+	buildTargetFlagDescription = fmt.Sprintf(`Optional. Name of the Dockerfile stage to build.
+Defaults to the final stage. Mutually exclusive with -%s, --%s.`, imageFlagShort, imageFlag)
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
 	jobTypeFlagDescription = fmt.Sprintf(`Type of job to create. Must be one of:
@@ -161,6 +195,8 @@ Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
 	taskRunDefaultFlagDescription = fmt.Sprintf(`Optional. Run tasks in default cluster and default subnets. 
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
 	taskExecDefaultFlagDescription = fmt.Sprintf(`Optional. Execute commands in running tasks in default cluster and default subnets. 
+Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
+	taskPortForwardDefaultFlagDescription = fmt.Sprintf(`Optional. Forward a port to running tasks in default cluster and default subnets.
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
 	taskDeleteDefaultFlagDescription = fmt.Sprintf(`Optional. Delete a task which was launched in the default cluster and subnets.
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
@@ -183,8 +219,10 @@ Supported providers are: %s`, strings.Join(manifest.PipelineProviders, ", "))
 )
 
 const (
-	appFlagDescription      = "Name of the application."
-	envFlagDescription      = "Name of the environment."
+	appFlagDescription          = "Name of the application."
+	envFlagDescription          = "Name of the environment."
+	svcDeployEnvFlagDescription = `Name of the environment.
+A comma-separated list deploys the service to each environment in order.`
 	svcFlagDescription      = "Name of the service."
 	jobFlagDescription      = "Name of the job."
 	workloadFlagDescription = "Name of the service or job."
@@ -196,7 +234,24 @@ const (
 	jsonFlagDescription     = "Optional. Outputs in JSON format."
 	forceFlagDescription    = "Optional. Force a new service deployment using the existing image."
 
-	imageTagFlagDescription     = `Optional. The container image tag.`
+	imageTagFlagDescription   = `Optional. The container image tag.`
+	forceBuildFlagDescription = `Optional. Build and push the container image even if
+an image built from the same inputs already exists in the repository.`
+	skipScanFlagDescription = `Optional. Deploy even if the vulnerability scan of the image finds
+vulnerabilities at or above the "image.scan.fail_on" severity.
+Not allowed for production environments.`
+	deployAllFlagDescription = `Optional. Deploy every service and job in the workspace
+in dependency order. Mutually exclusive with -n, --name.`
+	maxConcurrencyFlagDescription = `Optional. Maximum number of images built and stacks deployed
+at the same time with --all.`
+	allEnvsFlagDescription = `Optional. Deploy to every environment in the application,
+production environments last. Mutually exclusive with -e, --env.`
+	parallelFlagDescription = `Optional. Deploy to environments in different regions
+at the same time when deploying to multiple environments.`
+	confirmProdFlagDescription = `Optional. Prompt for confirmation before deploying
+to a production environment.`
+	outputFlagDescription = `Optional. Format of the deployment progress: "text" or "json".
+"json" writes newline-delimited JSON events to stdout.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
 Allows you to categorize resources.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
@@ -204,8 +259,12 @@ Allows you to categorize resources.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
-	followFlagDescription = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	followFlagDescription      = "Optional. Specifies if the logs should be streamed."
+	taskRunWaitFlagDescription = `Optional. Wait for the tasks to stop and report their exit codes.
+Exits with the first non-zero container exit code.`
+	taskRunTimeoutFlagDescription = `Optional. Stop the tasks if they have not completed within a duration like 30m or 1h.
+Requires --wait or --follow. Exits with code 124 if the tasks are stopped.`
+	sinceFlagDescription = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
@@ -221,6 +280,8 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
+	signatureKeyFlagDescription      = `Optional. ARN of the asymmetric KMS key that verifies the signatures
+of all images deployed to production environments.`
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
@@ -228,11 +289,24 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "The port on which your service listens."
+	pipelineWatchFlagDescription     = `Optional. Re-render the status in place until the pipeline finishes.
+Exits with code 2 if a stage failed.`
+	svcWatchFlagDescription = `Optional. Re-render the status in place until the service is stable.
+Exits with code 2 if the deployment rollout failed.`
 
 	noSubscriptionFlagDescription  = "Optional. Turn off selection for adding subscriptions for worker services."
 	subscribeTopicsFlagDescription = `Optional. SNS Topics to subscribe to from other services in your application.
 Must be of format '<svcName>:<topicName>'`
 
+	addonFromFlagDescription = `Location of the addon module.
+Either a local directory or a git repository of the format 'git::<url>//<directory>?ref=<tag>'.`
+	addonNameFlagDescription            = "Optional. Name of the addon. Defaults to the name of the module."
+	addonWorkloadFlagDescription        = "Name of the service or job to add the addon to."
+	addonValuesFlagDescription          = "Optional. Values to render the module with. Specified as <name>=<value> separated by commas."
+	addonUpgradeWorkloadFlagDescription = "Name of the service or job that the addon belongs to."
+	addonUpgradeNameFlagDescription     = "Name of the addon to upgrade."
+	addonUpgradeRefFlagDescription      = "Optional. The git branch, tag or commit to upgrade the module to. Defaults to the reference in the lockfile."
+
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageWorkloadFlagDescription     = "Name of the service or job to associate with storage."
 	storagePartitionKeyFlagDescription = `Partition key for the DDB table.
@@ -265,10 +339,14 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	fromWorkloadFlagDescription = `Optional. Name of a deployed service or job whose task definition is used to run the task.
+The task reuses the workload's image, IAM roles, secrets, sidecars, storage and network configuration.
+Requires --app and --env. Only --command can be overridden.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	certsFlagDescription          = "Optional. Apply existing ACM certificates to the HTTPS listener of the public load balancer."
 
 	overrideVPCCIDRFlagDescription = `Optional. Global CIDR to use for VPC.
 (default 10.0.0.0/16)`
@@ -278,6 +356,21 @@ Cannot be specified with any other flags.`
 (default 10.0.0.0/24,10.0.1.0/24)`
 	overridePrivateSubnetCIDRsFlagDescription = `Optional. CIDR to use for private subnets.
 (default 10.0.2.0/24,10.0.3.0/24)`
+	vpcEndpointsFlagDescription = `Optional. Reach AWS services from private subnets through VPC endpoints instead of NAT gateways.
+Workloads placed in private subnets can't reach the internet.`
+
+	webACLARNFlagDescription       = "Optional. Associate an existing AWS WAF web ACL with the public load balancer."
+	wafManagedRulesFlagDescription = `Optional. Protect the public load balancer with a new AWS WAF web ACL
+that uses AWS managed rule groups.`
+	albAccessLogsFlagDescription       = "Optional. Store the access logs of the public load balancer in an S3 bucket owned by the environment."
+	albAccessLogsPrefixFlagDescription = "Optional. Prefix for the access log objects in the S3 bucket. Requires --alb-access-logs."
+	albAccessLogsDaysFlagDescription   = `Optional. Number of days to keep the access logs before they expire.
+Requires --alb-access-logs. (default keeps the logs indefinitely)`
+
+	pullThroughCacheFlagDescription = `Optional. ECR pull through cache rules that cache the images of upstream registries,
+as ECR repository prefixes mapped to upstream registry URLs, e.g. ecr-public=public.ecr.aws.`
+	pullThroughCacheCredsFlagDescription = `Optional. ARNs of the Secrets Manager secrets with the credentials of upstream registries,
+keyed by ECR repository prefix. Secret names must start with "ecr-pullthroughcache/".`
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
 
@@ -301,5 +394,27 @@ are also accepted.`
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
+	cpContainerFlagDescription = "Optional. The specific container you want to copy files with. By default the first essential container will be used."
+
+	portForwardPortFlagDescription = `Port mapping of the form "<local>:<remote>", or "<port>" to use the same port locally and remotely.
+For example: "8080:80", "5432".`
+	portForwardTaskIDFlagDescription    = "Optional. ID of the task you want to forward the port to."
+	portForwardContainerFlagDescription = "Optional. The specific container you want to forward the port through. By default the first essential container will be used."
+	svcRemoteHostFlagDescription        = `Optional. A host reachable from the container to forward the port to, such as a database endpoint.
+If the value is the name of an output of the service's addons stack, the output's value is used.`
+	taskRemoteHostFlagDescription = "Optional. A host reachable from the container to forward the port to, such as a database endpoint."
+
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+
+	queueFlagDescription = `Optional. Name of the queue, for example "EventsQueue" or "apiordersEventsQueue".
+Defaults to the service's main queue, "EventsQueue".`
+	queueStatsFlagDescription = `Optional. Name of the queue, for example "EventsQueue" or "apiordersEventsQueue".
+By default the stats of all the service's queues are shown.`
+	deadLetterFlagDescription = "Optional. Inspect the dead-letter queue instead of the queue."
+	liveQueueFlagDescription  = `Optional. Peek at the queue that the service consumes instead of its dead-letter queue.
+The service can't receive the messages while they're peeked, and their receive count is incremented.`
+	peekMaxFlagDescription    = "Optional. Maximum number of messages to print. Up to 100."
+	redriveMaxFlagDescription = `Optional. Maximum number of messages to move. By default all messages in the
+dead-letter queue when the command starts are moved.`
+	redriveRateFlagDescription = "Optional. Maximum number of messages to move per second."
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/cosign"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -53,6 +54,7 @@ type initVars struct {
 	wkldType       string
 	svcName        string
 	dockerfilePath string
+	buildTarget    string
 	image          string
 	imageTag       string
 
@@ -173,6 +175,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		spinner:         spin,
 		now:             time.Now,
 		cmd:             exec.NewCmd(),
+		imageSigner:     cosign.New(exec.NewCmd()),
 		sessProvider:    sessProvider,
 		snsTopicGetter:  deployStore,
 
@@ -195,6 +198,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		sel:             sel,
 		spinner:         spin,
 		cmd:             exec.NewCmd(),
+		imageSigner:     cosign.New(exec.NewCmd()),
 		sessProvider:    sessProvider,
 	}
 	fs := &afero.Afero{Fs: afero.NewOsFs()}
@@ -219,6 +223,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 				wkldType:       wkldType,
 				name:           vars.svcName,
 				dockerfilePath: vars.dockerfilePath,
+				buildTarget:    vars.buildTarget,
 				image:          vars.image,
 			}
 			switch t := wkldType; {
@@ -490,6 +495,7 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", workloadFlagDescription)
 	cmd.Flags().StringVarP(&vars.wkldType, typeFlag, typeFlagShort, "", wkldTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTarget, buildTargetFlag, "", buildTargetFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeploy, deployFlag, false, deployTestFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
@@ -17,11 +17,15 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cp"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -164,6 +168,22 @@ type secretDeleter interface {
 
 type imageBuilderPusher interface {
 	BuildAndPush(docker repository.ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error)
+	Copy(docker repository.ContainerLoginBuildPusher, src string, args *dockerengine.BuildArguments) error
+	Digest(tag string) (string, error)
+	Login(docker repository.ContainerLoginBuildPusher) error
+	Tag(digest string, args *dockerengine.BuildArguments) error
+	URI() string
+}
+
+type imageScanner interface {
+	EnableScanOnPush(repoName string) error
+	ImageScanFindings(repoName, digest string) (*ecr.ImageScanFindings, error)
+}
+
+type imageSigner interface {
+	Sign(image, key string) error
+	Verify(image, key string) (string, error)
+	CopySignature(image, dstRepo string) error
 }
 
 type repositoryURIGetter interface {
@@ -196,6 +216,10 @@ type eventsWriter interface {
 	WriteEventsUntilStopped() error
 }
 
+type taskWaiter interface {
+	Wait(tasks []*task.Task) ([]*task.Result, error)
+}
+
 type defaultSessionProvider interface {
 	Default() (*session.Session, error)
 }
@@ -404,6 +428,12 @@ type domainInfoGetter interface {
 type dockerfileParser interface {
 	GetExposedPorts() ([]dockerfile.Port, error)
 	GetHealthCheck() (*dockerfile.HealthCheck, error)
+	GetStages() ([]dockerfile.Stage, error)
+	GetBuildArgs() ([]dockerfile.BuildArg, error)
+	GetEnvVars() (map[string]string, error)
+	GetUser() (string, error)
+	GetEntrypoint() ([]string, error)
+	GetCommand() ([]string, error)
 }
 
 type statusDescriber interface {
@@ -418,8 +448,10 @@ type versionGetter interface {
 	Version() (string, error)
 }
 
-type endpointGetter interface {
+type envStackDescriber interface {
 	ServiceDiscoveryEndpoint() (string, error)
+	Outputs() (map[string]string, error)
+	Version() (string, error)
 }
 
 type envTemplater interface {
@@ -441,7 +473,7 @@ type envTemplateUpgrader interface {
 }
 
 type appUpgrader interface {
-	UpgradeApplication(in *deploy.CreateAppInput) error
+	UpgradeApplication(out termprogress.FileWriter, in *deploy.CreateAppInput) error
 }
 
 type pipelineGetter interface {
@@ -562,6 +594,19 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type ecsPortForwarder interface {
+	ForwardPort(in ssm.ForwardPortInput) error
+}
+
+type containerFileCopier interface {
+	Upload(src string, dst cp.Container, dstDir string) error
+	Download(src cp.Container, srcPath, dstDir string) error
+}
+
+type addonsOutputsGetter interface {
+	AddonsOutputs() (map[string]string, error)
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -591,6 +636,10 @@ type stackExistChecker interface {
 	Exists(string) (bool, error)
 }
 
+type pullThroughCacheRuleLister interface {
+	PullThroughCacheRules() (map[string]string, error)
+}
+
 type runningTaskSelector interface {
 	RunningTask(prompt, help string, opts ...selector.TaskOpts) (*awsecs.Task, error)
 }
@@ -620,6 +669,17 @@ type servicePauser interface {
 	PauseService(svcARN string) error
 }
 
+type workerQueueDescriber interface {
+	Queues() ([]*describe.WorkerQueue, error)
+	QueueStats(q *describe.WorkerQueue) (*describe.QueueStats, error)
+}
+
+type queueMessenger interface {
+	Peek(url string, max int) ([]*sqs.Message, error)
+	MoveMessages(srcURL, dstURL string, max int) (int, error)
+	QueueAttributes(url string) (*sqs.QueueAttributes, error)
+}
+
 type timeoutError interface {
 	error
 	Timeout() bool
@@ -628,3 +688,16 @@ type timeoutError interface {
 type interpolator interface {
 	Interpolate(s string) (string, error)
 }
+
+type wsAddonModuleManager interface {
+	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	OverwriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	ReadAddonModulesLock() ([]byte, error)
+	WriteAddonModulesLock(marshaler encoding.BinaryMarshaler) (string, error)
+	Path() (string, error)
+	wlLister
+}
+
+type addonModuleFetcher interface {
+	Fetch(src addon.ModuleSource) (*addon.Module, error)
+}
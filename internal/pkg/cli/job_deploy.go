@@ -7,11 +7,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/copilot-cli/internal/pkg/docker/cosign"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -53,26 +54,34 @@ type deployJobOpts struct {
 	appCFN             appResourcesGetter
 	jobCFN             cloudformation.CloudFormation
 	imageBuilderPusher imageBuilderPusher
+	newRegistryPusher  func(registry *manifest.ImageRegistry) (imageBuilderPusher, error)
+	imageScanner       imageScanner
+	imageSigner        imageSigner
+	contentHash        func(args *dockerengine.BuildArguments) (string, error)
 	sessProvider       sessionProvider
 	s3                 uploader
 	envUpgradeCmd      actionCommand
-	endpointGetter     endpointGetter
+	envStack           envStackDescriber
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
 
 	// cached variables
-	targetApp         *config.Application
-	targetEnvironment *config.Environment
-	targetJob         *config.Workload
-	appEnvResources   *stack.AppRegionalResources
-	appliedManifest   interface{}
-	workspacePath     string
-	addonsURL         string
-	envFileARN        string
-	imageDigest       string
-	buildRequired     bool
+	targetApp           *config.Application
+	targetEnvironment   *config.Environment
+	targetJob           *config.Workload
+	appEnvResources     *stack.AppRegionalResources
+	appliedManifest     interface{}
+	workspacePath       string
+	addonsURL           string
+	envFileARN          string
+	imageDigest         string
+	sidecarImageDigests map[string]string
+	buildRequired       bool
+	registryURI         string          // URI of the third-party repository that images are pushed to instead of ECR.
+	verifiedImage       *stack.ECRImage // Image location pinned to the digest that its verified signature attests to.
+	pinImageDigests     bool            // Deploy the built images by digest rather than by tag once their signatures are verified.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -102,6 +111,8 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessions.NewProvider(),
 		newInterpolator: newManifestInterpolator,
+		contentHash:     dockerengine.ContentHash,
+		imageSigner:     cosign.New(exec.NewCmd()),
 	}, nil
 }
 
@@ -120,6 +131,11 @@ func (o *deployJobOpts) Validate() error {
 			return err
 		}
 	}
+	if o.outputFormat != "" {
+		if err := validateOutputFormat(o.outputFormat); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -134,8 +150,19 @@ func (o *deployJobOpts) Ask() error {
 	return nil
 }
 
-// Execute builds and pushes the container image for the job.
+// Execute builds and pushes the container image for the job, and deploys the job.
 func (o *deployJobOpts) Execute() error {
+	out := progressWriter(o.outputFormat, o.name)
+	err := o.uploadArtifacts()
+	if err == nil {
+		err = o.deployWorkload(out)
+	}
+	writeResultEvent(out, deployResultEvent(o.appName, o.envName, o.name, err))
+	return err
+}
+
+// uploadArtifacts builds and pushes the images of the job, and uploads the rest of its artifacts to S3.
+func (o *deployJobOpts) uploadArtifacts() error {
 	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
@@ -155,16 +182,20 @@ func (o *deployJobOpts) Execute() error {
 	if err := o.configureClients(); err != nil {
 		return err
 	}
-	if err := o.envUpgradeCmd.Execute(); err != nil {
-		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+	if !o.skipEnvUpgrade {
+		if err := o.envUpgradeCmd.Execute(); err != nil {
+			return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+		}
 	}
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
-	if err := o.pushArtifactsToS3(); err != nil {
-		return err
-	}
-	return o.deployJob()
+	return o.pushArtifactsToS3()
+}
+
+// deployWorkload deploys the stack of the job once its artifacts are uploaded, and renders the progress to out.
+func (o *deployJobOpts) deployWorkload(out termprogress.FileWriter) error {
+	return o.deployJob(out)
 }
 
 func (o *deployJobOpts) pushArtifactsToS3() error {
@@ -275,12 +306,14 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newRegistryPusher = newThirdPartyImageBuilderPusher(repoName, defaultSessEnvRegion)
+	o.imageScanner = registry
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
 	// CF client against env account profile AND target environment region
 	o.jobCFN = cloudformation.New(envSession)
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	o.envStack, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -322,20 +355,92 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
+	if err := o.configureImageRegistry(job); err != nil {
+		return err
+	}
+	signatureKey, err := o.imageSignatureKey(job)
+	if err != nil {
+		return err
+	}
+	prodKey := prodSignatureKey(o.targetApp, o.targetEnvironment)
 	if !required {
+		image, err := verifyImageLocation(o.imageSigner, manifest.ImageLocation(job), signatureKey, prodKey, o.targetEnvironment)
+		if err != nil {
+			return err
+		}
+		o.verifiedImage = image
+	}
+	// If it is built from local Dockerfile, build and push to the ECR repo or the third-party registry.
+	var buildArg *dockerengine.BuildArguments
+	if required {
+		buildArg, err = o.dfBuildArgs(job)
+		if err != nil {
+			return err
+		}
+	}
+	sidecarBuildArg, err := o.dfSidecarBuildArgs(job)
+	if err != nil {
+		return err
+	}
+	if buildArg == nil && len(sidecarBuildArg) == 0 {
 		return nil
 	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(job)
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	scan, err := enableImageScan(o.imageScanner, repoName, manifest.ImageScanConfig(job), o.skipScan, o.targetEnvironment)
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	digest, sidecarDigests, err := buildAndPushImages(o.imageBuilderPusher, o.contentHash, buildArg, sidecarBuildArg, o.forceBuild)
 	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+		return err
+	}
+	if scan != nil {
+		if err := checkImageScanFindings(o.imageScanner, repoName, aws.StringValue(scan.FailOn), imageDigestsByName(o.name, digest, sidecarDigests)); err != nil {
+			return err
+		}
+	}
+	if required && signatureKey != "" {
+		if err := signImages(o.imageSigner, o.imageBuilderPusher.URI(), signatureKey, imageDigestsByName(o.name, digest, sidecarDigests)); err != nil {
+			return err
+		}
+	}
+	if prodKey != "" {
+		if err := verifyPushedImages(o.imageSigner, o.imageBuilderPusher.URI(), prodKey, imageDigestsByName(o.name, digest, sidecarDigests), o.targetEnvironment); err != nil {
+			return err
+		}
+		o.pinImageDigests = true
 	}
 	o.imageDigest = digest
-	o.buildRequired = true
+	o.sidecarImageDigests = sidecarDigests
+	o.buildRequired = required
+	return nil
+}
+
+// imageSignatureKey returns the key of the image signature in the manifest.
+// Paths to key files are relative to the workspace root.
+func (o *deployJobOpts) imageSignatureKey(mft interface{}) (string, error) {
+	key := manifest.ImageSignatureKey(mft)
+	if !isRelativeKeyPath(key) {
+		return key, nil
+	}
+	if err := o.retrieveWorkspacePath(); err != nil {
+		return "", err
+	}
+	return filepath.Join(o.workspacePath, key), nil
+}
+
+// configureImageRegistry pushes images to the third-party registry of the manifest instead of the ECR repo if there is one.
+func (o *deployJobOpts) configureImageRegistry(mft interface{}) error {
+	registry := manifest.ThirdPartyImageRegistry(mft)
+	if registry == nil {
+		return nil
+	}
+	pusher, err := o.newRegistryPusher(registry)
+	if err != nil {
+		return fmt.Errorf("initiate image builder pusher for registry %s: %w", aws.StringValue(registry.URI), err)
+	}
+	o.imageBuilderPusher = pusher
+	o.registryURI = pusher.URI()
 	return nil
 }
 
@@ -346,12 +451,22 @@ func (o *deployJobOpts) dfBuildArgs(job interface{}) (*dockerengine.BuildArgumen
 	return buildArgs(o.name, o.imageTag, o.workspacePath, job)
 }
 
-func (o *deployJobOpts) deployJob() error {
+func (o *deployJobOpts) dfSidecarBuildArgs(job interface{}) (map[string]*dockerengine.BuildArguments, error) {
+	if len(manifest.DockerfileBuildSidecars(job)) == 0 {
+		return nil, nil
+	}
+	if err := o.retrieveWorkspacePath(); err != nil {
+		return nil, err
+	}
+	return sidecarBuildArgs(o.name, o.imageBuilderPusher.URI(), o.imageTag, o.workspacePath, job)
+}
+
+func (o *deployJobOpts) deployJob(out termprogress.FileWriter) error {
 	conf, err := o.stackConfiguration()
 	if err != nil {
 		return err
 	}
-	if err := o.jobCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	if err := o.jobCFN.DeployService(out, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
 		return fmt.Errorf("deploy job: %w", err)
 	}
 	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
@@ -363,6 +478,11 @@ func (o *deployJobOpts) stackConfiguration() (cloudformation.StackConfiguration,
 	if err != nil {
 		return nil, err
 	}
+	logInternetEgressWarning(o.name, o.targetEnvironment, mft)
+	logWildcardIAMWarning(o.name, o.targetEnvironment, mft)
+	if err := validateEnvEventBusVersion(mft, o.targetEnvironment.Name, o.envStack); err != nil {
+		return nil, err
+	}
 	rc, err := o.runtimeConfig()
 	if err != nil {
 		return nil, err
@@ -381,42 +501,72 @@ func (o *deployJobOpts) stackConfiguration() (cloudformation.StackConfiguration,
 }
 
 func (o *deployJobOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
-	endpoint, err := o.endpointGetter.ServiceDiscoveryEndpoint()
+	endpoint, err := o.envStack.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	envAddonsOutputs, err := deployedEnvAddonsOutputs(o.envStack)
 	if err != nil {
 		return nil, err
 	}
-	if !o.buildRequired {
+	if !o.buildRequired && len(o.sidecarImageDigests) == 0 {
 		return &stack.RuntimeConfig{
+			Image:                    o.verifiedImage,
 			AddonsTemplateURL:        o.addonsURL,
 			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
 			ServiceDiscoveryEndpoint: endpoint,
+			EnvAddonsOutputs:         envAddonsOutputs,
+			PullThroughCachePrefixes: o.targetEnvironment.CustomConfig.PullThroughCachePrefixes(),
 			AccountID:                o.targetEnvironment.AccountID,
 			Region:                   o.targetEnvironment.Region,
 		}, nil
 	}
-	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
+	repoURL, err := o.imageRepoURL()
+	if err != nil {
 		return nil, err
 	}
+	imageTag := o.imageTag
+	if o.pinImageDigests {
+		imageTag = ""
+	}
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:        o.addonsURL,
+		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
+		SidecarImages:            sidecarECRImages(repoURL, imageTag, o.sidecarImageDigests),
+		ServiceDiscoveryEndpoint: endpoint,
+		EnvAddonsOutputs:         envAddonsOutputs,
+		PullThroughCachePrefixes: o.targetEnvironment.CustomConfig.PullThroughCachePrefixes(),
+		AccountID:                o.targetEnvironment.AccountID,
+		Region:                   o.targetEnvironment.Region,
+	}
+	rc.Image = o.verifiedImage
+	if o.buildRequired {
+		rc.Image = &stack.ECRImage{
+			RepoURL:  repoURL,
+			ImageTag: imageTag,
+			Digest:   o.imageDigest,
+		}
+	}
+	return rc, nil
+}
+
+// imageRepoURL returns the URL of the repository that the images built from local Dockerfiles are pushed to.
+func (o *deployJobOpts) imageRepoURL() (string, error) {
+	if o.registryURI != "" {
+		return o.registryURI, nil
+	}
+	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
+		return "", err
+	}
 	repoURL, ok := o.appEnvResources.RepositoryURLs[o.name]
 	if !ok {
-		return nil, &errRepoNotFound{
+		return "", &errRepoNotFound{
 			wlName:       o.name,
 			envRegion:    o.targetEnvironment.Region,
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		Image: &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.imageTag,
-			Digest:   o.imageDigest,
-		},
-		AddonsTemplateURL:        o.addonsURL,
-		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
-		ServiceDiscoveryEndpoint: endpoint,
-		AccountID:                o.targetEnvironment.AccountID,
-		Region:                   o.targetEnvironment.Region,
-	}, nil
+	return repoURL, nil
 }
 
 func (o *deployJobOpts) manifest() (interface{}, error) {
@@ -522,6 +672,9 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceBuild, forceBuildFlag, false, forceBuildFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipScan, skipScanFlag, false, skipScanFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, textOutputFormat, outputFlagDescription)
 
 	return cmd
 }
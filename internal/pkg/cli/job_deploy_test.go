@@ -231,8 +231,9 @@ on:
   schedule: "@daily"`)
 
 	tests := map[string]struct {
-		inputJob   string
-		setupMocks func(mocks deployJobMocks)
+		inputJob     string
+		inForceBuild bool
+		setupMocks   func(mocks deployJobMocks)
 
 		wantErr      error
 		wantedDigest string
@@ -284,11 +285,59 @@ on:
 					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockManifest, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("", mockError),
 				)
 			},
 			wantErr: fmt.Errorf("build and push image: mockError"),
 		},
+		"should return error if fail to get the image with the same content hash": {
+			inputJob: "mailer",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", mockError),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: mockError,
+		},
+		"should reuse the image with the same content hash": {
+			inputJob: "mailer",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().Tag("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+					}).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should build and push without checking the content hash if forced": {
+			inputJob:     "mailer",
+			inForceBuild: true,
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest(gomock.Any()).Times(0),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"success": {
 			inputJob: "mailer",
 			setupMocks: func(m deployJobMocks) {
@@ -296,9 +345,11 @@ on:
 					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockManifest, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -311,9 +362,11 @@ on:
 					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockMftBuildString, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockMftBuildString)).Return(string(mockMftBuildString), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -326,9 +379,11 @@ on:
 					m.mockWs.EXPECT().ReadWorkloadManifest("mailer").Return(mockMftNoContext, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockMftNoContext)).Return(string(mockMftNoContext), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -352,11 +407,15 @@ on:
 			test.setupMocks(mocks)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputJob,
+					name:       test.inputJob,
+					forceBuild: test.inForceBuild,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
-				ws:                 mockWorkspace,
+				contentHash: func(*dockerengine.BuildArguments) (string, error) {
+					return "mockHash", nil
+				},
+				ws: mockWorkspace,
 				newInterpolator: func(app, env string) interpolator {
 					return mockInterpolator
 				},
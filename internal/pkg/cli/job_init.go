@@ -136,6 +136,9 @@ func (o *initJobOpts) Validate() error {
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
+	if o.buildTarget != "" && o.image != "" {
+		return fmt.Errorf("--%s and --%s cannot be specified together", buildTargetFlag, imageFlag)
+	}
 	if o.dockerfilePath != "" {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -201,16 +204,26 @@ func (o *initJobOpts) Ask() error {
 func (o *initJobOpts) Execute() error {
 	// Check for a valid healthcheck and add it to the opts.
 	var hc manifest.ContainerHealthCheck
+	var settings dockerfileSettings
 	var err error
 	if o.dockerfilePath != "" {
-		hc, err = parseHealthCheck(o.initParser(o.dockerfilePath))
+		df := o.initParser(o.dockerfilePath)
+		hc, err = parseHealthCheck(df)
 		if err != nil {
 			log.Warningf("Cannot parse the HEALTHCHECK instruction from the Dockerfile: %v\n", err)
 		}
+		settings, err = parseDockerfileSettings(df, o.buildTarget)
+		var noStageErr *errNoBuildStage
+		if errors.As(err, &noStageErr) {
+			return err
+		}
+		if err != nil {
+			log.Warningf("Cannot parse the build and runtime settings from the Dockerfile: %v\n", err)
+		}
 	}
 	// If the user passes in an image, their docker engine isn't necessarily running, and we can't do anything with the platform because we're not building the Docker image.
 	if o.image == "" {
-		platform, err := legitimizePlatform(o.dockerEngine, o.wkldType)
+		platform, err := buildPlatform(o.dockerEngine, o.wkldType, settings.platform)
 		if err != nil {
 			return err
 		}
@@ -228,6 +241,8 @@ func (o *initJobOpts) Execute() error {
 			Platform: manifest.PlatformArgsOrString{
 				PlatformString: o.platform,
 			},
+			BuildTarget:       settings.target,
+			DockerfileRuntime: settings.runtime,
 		},
 
 		Schedule:    o.schedule,
@@ -405,6 +420,7 @@ func buildJobInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.wkldType, jobTypeFlag, typeFlagShort, "", jobTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTarget, buildTargetFlag, "", buildTargetFlagDescription)
 	cmd.Flags().StringVarP(&vars.schedule, scheduleFlag, scheduleFlagShort, "", scheduleFlagDescription)
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
@@ -548,6 +548,7 @@ func TestJobInitOpts_Execute(t *testing.T) {
 					StartPeriod: second,
 					Retries:     zero,
 				}, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
@@ -572,6 +573,43 @@ func TestJobInitOpts_Execute(t *testing.T) {
 				}).Return("manifest/path", nil)
 			},
 		},
+		"pre-fills the build args detected in the Dockerfile": {
+			inApp:              "sample",
+			inName:             "mailer",
+			inType:             manifest.ScheduledJobType,
+			inDf:               "./Dockerfile",
+			inSchedule:         "@hourly",
+			wantedManifestPath: "manifest/path",
+
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				m.EXPECT().GetStages().Return([]dockerfile.Stage{{Image: "python:3.11"}}, nil)
+				m.EXPECT().GetBuildArgs().Return([]dockerfile.BuildArg{{Name: "SMTP_HOST"}}, nil)
+				m.EXPECT().GetEnvVars().Return(nil, nil)
+				m.EXPECT().GetEntrypoint().Return(nil, nil)
+				m.EXPECT().GetCommand().Return([]string{"python", "mailer.py"}, nil)
+				m.EXPECT().GetUser().Return("root", nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {
+				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
+			},
+			mockJobInit: func(m *mocks.MockjobInitializer) {
+				m.EXPECT().Job(&initialize.JobProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:            "sample",
+						Name:           "mailer",
+						Type:           "Scheduled Job",
+						DockerfilePath: "./Dockerfile",
+						Platform:       manifest.PlatformArgsOrString{},
+						DockerfileRuntime: manifest.DockerfileRuntime{
+							Command:        []string{"python", "mailer.py"},
+							UnsetBuildArgs: []string{"SMTP_HOST"},
+						},
+					},
+					Schedule: "@hourly",
+				}).Return("manifest/path", nil)
+			},
+		},
 		"fail to init job": {
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
@@ -106,7 +106,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			addonsWriter:     ioutil.Discard,
 			fs:               &afero.Afero{Fs: afero.NewOsFs()},
 			stackSerializer:  o.stackSerializer,
-			newEndpointGetter: func(app, env string) (endpointGetter, error) {
+			newEnvStackDescriber: func(app, env string) (envStackDescriber, error) {
 				d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 					App:         app,
 					Env:         env,
@@ -11,13 +11,17 @@ import (
 	time "time"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
+	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
+	cp "github.com/aws/copilot-cli/internal/pkg/cp"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -1493,6 +1497,195 @@ func (mr *MockimageBuilderPusherMockRecorder) BuildAndPush(docker, args interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockimageBuilderPusher)(nil).BuildAndPush), docker, args)
 }
 
+// Copy mocks base method.
+func (m *MockimageBuilderPusher) Copy(docker repository.ContainerLoginBuildPusher, src string, args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", docker, src, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockimageBuilderPusherMockRecorder) Copy(docker, src, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockimageBuilderPusher)(nil).Copy), docker, src, args)
+}
+
+// Digest mocks base method.
+func (m *MockimageBuilderPusher) Digest(tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digest", tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockimageBuilderPusherMockRecorder) Digest(tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockimageBuilderPusher)(nil).Digest), tag)
+}
+
+// Login mocks base method.
+func (m *MockimageBuilderPusher) Login(docker repository.ContainerLoginBuildPusher) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", docker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockimageBuilderPusherMockRecorder) Login(docker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockimageBuilderPusher)(nil).Login), docker)
+}
+
+// Tag mocks base method.
+func (m *MockimageBuilderPusher) Tag(digest string, args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tag", digest, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tag indicates an expected call of Tag.
+func (mr *MockimageBuilderPusherMockRecorder) Tag(digest, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockimageBuilderPusher)(nil).Tag), digest, args)
+}
+
+// URI mocks base method.
+func (m *MockimageBuilderPusher) URI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockimageBuilderPusherMockRecorder) URI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockimageBuilderPusher)(nil).URI))
+}
+
+// MockimageScanner is a mock of imageScanner interface.
+type MockimageScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScannerMockRecorder
+}
+
+// MockimageScannerMockRecorder is the mock recorder for MockimageScanner.
+type MockimageScannerMockRecorder struct {
+	mock *MockimageScanner
+}
+
+// NewMockimageScanner creates a new mock instance.
+func NewMockimageScanner(ctrl *gomock.Controller) *MockimageScanner {
+	mock := &MockimageScanner{ctrl: ctrl}
+	mock.recorder = &MockimageScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanner) EXPECT() *MockimageScannerMockRecorder {
+	return m.recorder
+}
+
+// EnableScanOnPush mocks base method.
+func (m *MockimageScanner) EnableScanOnPush(repoName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableScanOnPush", repoName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableScanOnPush indicates an expected call of EnableScanOnPush.
+func (mr *MockimageScannerMockRecorder) EnableScanOnPush(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableScanOnPush", reflect.TypeOf((*MockimageScanner)(nil).EnableScanOnPush), repoName)
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageScanner) ImageScanFindings(repoName, digest string) (*ecr.ImageScanFindings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", repoName, digest)
+	ret0, _ := ret[0].(*ecr.ImageScanFindings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageScannerMockRecorder) ImageScanFindings(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageScanner)(nil).ImageScanFindings), repoName, digest)
+}
+
+// MockimageSigner is a mock of imageSigner interface.
+type MockimageSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageSignerMockRecorder
+}
+
+// MockimageSignerMockRecorder is the mock recorder for MockimageSigner.
+type MockimageSignerMockRecorder struct {
+	mock *MockimageSigner
+}
+
+// NewMockimageSigner creates a new mock instance.
+func NewMockimageSigner(ctrl *gomock.Controller) *MockimageSigner {
+	mock := &MockimageSigner{ctrl: ctrl}
+	mock.recorder = &MockimageSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageSigner) EXPECT() *MockimageSignerMockRecorder {
+	return m.recorder
+}
+
+// CopySignature mocks base method.
+func (m *MockimageSigner) CopySignature(image, dstRepo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySignature", image, dstRepo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopySignature indicates an expected call of CopySignature.
+func (mr *MockimageSignerMockRecorder) CopySignature(image, dstRepo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySignature", reflect.TypeOf((*MockimageSigner)(nil).CopySignature), image, dstRepo)
+}
+
+// Sign mocks base method.
+func (m *MockimageSigner) Sign(image, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", image, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockimageSignerMockRecorder) Sign(image, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockimageSigner)(nil).Sign), image, key)
+}
+
+// Verify mocks base method.
+func (m *MockimageSigner) Verify(image, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", image, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockimageSignerMockRecorder) Verify(image, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockimageSigner)(nil).Verify), image, key)
+}
+
 // MockrepositoryURIGetter is a mock of repositoryURIGetter interface.
 type MockrepositoryURIGetter struct {
 	ctrl     *gomock.Controller
@@ -1568,6 +1761,63 @@ func (mr *MockrepositoryServiceMockRecorder) BuildAndPush(docker, args interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPush", reflect.TypeOf((*MockrepositoryService)(nil).BuildAndPush), docker, args)
 }
 
+// Copy mocks base method.
+func (m *MockrepositoryService) Copy(docker repository.ContainerLoginBuildPusher, src string, args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Copy", docker, src, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Copy indicates an expected call of Copy.
+func (mr *MockrepositoryServiceMockRecorder) Copy(docker, src, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockrepositoryService)(nil).Copy), docker, src, args)
+}
+
+// Digest mocks base method.
+func (m *MockrepositoryService) Digest(tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Digest", tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockrepositoryServiceMockRecorder) Digest(tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockrepositoryService)(nil).Digest), tag)
+}
+
+// Login mocks base method.
+func (m *MockrepositoryService) Login(docker repository.ContainerLoginBuildPusher) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", docker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockrepositoryServiceMockRecorder) Login(docker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockrepositoryService)(nil).Login), docker)
+}
+
+// Tag mocks base method.
+func (m *MockrepositoryService) Tag(digest string, args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tag", digest, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Tag indicates an expected call of Tag.
+func (mr *MockrepositoryServiceMockRecorder) Tag(digest, args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockrepositoryService)(nil).Tag), digest, args)
+}
+
 // URI mocks base method.
 func (m *MockrepositoryService) URI() string {
 	m.ctrl.T.Helper()
@@ -1789,6 +2039,44 @@ func (mr *MockeventsWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MockeventsWriter)(nil).WriteEventsUntilStopped))
 }
 
+// MocktaskWaiter is a mock of taskWaiter interface.
+type MocktaskWaiter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskWaiterMockRecorder
+}
+
+// MocktaskWaiterMockRecorder is the mock recorder for MocktaskWaiter.
+type MocktaskWaiterMockRecorder struct {
+	mock *MocktaskWaiter
+}
+
+// NewMocktaskWaiter creates a new mock instance.
+func NewMocktaskWaiter(ctrl *gomock.Controller) *MocktaskWaiter {
+	mock := &MocktaskWaiter{ctrl: ctrl}
+	mock.recorder = &MocktaskWaiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskWaiter) EXPECT() *MocktaskWaiterMockRecorder {
+	return m.recorder
+}
+
+// Wait mocks base method.
+func (m *MocktaskWaiter) Wait(tasks []*task.Task) ([]*task.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", tasks)
+	ret0, _ := ret[0].([]*task.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MocktaskWaiterMockRecorder) Wait(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MocktaskWaiter)(nil).Wait), tasks)
+}
+
 // MockdefaultSessionProvider is a mock of defaultSessionProvider interface.
 type MockdefaultSessionProvider struct {
 	ctrl     *gomock.Controller
@@ -4214,6 +4502,66 @@ func (m *MockdockerfileParser) EXPECT() *MockdockerfileParserMockRecorder {
 	return m.recorder
 }
 
+// GetBuildArgs mocks base method.
+func (m *MockdockerfileParser) GetBuildArgs() ([]dockerfile.BuildArg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildArgs")
+	ret0, _ := ret[0].([]dockerfile.BuildArg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildArgs indicates an expected call of GetBuildArgs.
+func (mr *MockdockerfileParserMockRecorder) GetBuildArgs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildArgs", reflect.TypeOf((*MockdockerfileParser)(nil).GetBuildArgs))
+}
+
+// GetCommand mocks base method.
+func (m *MockdockerfileParser) GetCommand() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommand")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommand indicates an expected call of GetCommand.
+func (mr *MockdockerfileParserMockRecorder) GetCommand() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommand", reflect.TypeOf((*MockdockerfileParser)(nil).GetCommand))
+}
+
+// GetEntrypoint mocks base method.
+func (m *MockdockerfileParser) GetEntrypoint() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntrypoint")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntrypoint indicates an expected call of GetEntrypoint.
+func (mr *MockdockerfileParserMockRecorder) GetEntrypoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntrypoint", reflect.TypeOf((*MockdockerfileParser)(nil).GetEntrypoint))
+}
+
+// GetEnvVars mocks base method.
+func (m *MockdockerfileParser) GetEnvVars() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvVars")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvVars indicates an expected call of GetEnvVars.
+func (mr *MockdockerfileParserMockRecorder) GetEnvVars() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvVars", reflect.TypeOf((*MockdockerfileParser)(nil).GetEnvVars))
+}
+
 // GetExposedPorts mocks base method.
 func (m *MockdockerfileParser) GetExposedPorts() ([]dockerfile.Port, error) {
 	m.ctrl.T.Helper()
@@ -4244,6 +4592,36 @@ func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
 }
 
+// GetStages mocks base method.
+func (m *MockdockerfileParser) GetStages() ([]dockerfile.Stage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStages")
+	ret0, _ := ret[0].([]dockerfile.Stage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStages indicates an expected call of GetStages.
+func (mr *MockdockerfileParserMockRecorder) GetStages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStages", reflect.TypeOf((*MockdockerfileParser)(nil).GetStages))
+}
+
+// GetUser mocks base method.
+func (m *MockdockerfileParser) GetUser() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockdockerfileParserMockRecorder) GetUser() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockdockerfileParser)(nil).GetUser))
+}
+
 // MockstatusDescriber is a mock of statusDescriber interface.
 type MockstatusDescriber struct {
 	ctrl     *gomock.Controller
@@ -4358,31 +4736,46 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
-// MockendpointGetter is a mock of endpointGetter interface.
-type MockendpointGetter struct {
+// MockenvStackDescriber is a mock of envStackDescriber interface.
+type MockenvStackDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockendpointGetterMockRecorder
+	recorder *MockenvStackDescriberMockRecorder
 }
 
-// MockendpointGetterMockRecorder is the mock recorder for MockendpointGetter.
-type MockendpointGetterMockRecorder struct {
-	mock *MockendpointGetter
+// MockenvStackDescriberMockRecorder is the mock recorder for MockenvStackDescriber.
+type MockenvStackDescriberMockRecorder struct {
+	mock *MockenvStackDescriber
 }
 
-// NewMockendpointGetter creates a new mock instance.
-func NewMockendpointGetter(ctrl *gomock.Controller) *MockendpointGetter {
-	mock := &MockendpointGetter{ctrl: ctrl}
-	mock.recorder = &MockendpointGetterMockRecorder{mock}
+// NewMockenvStackDescriber creates a new mock instance.
+func NewMockenvStackDescriber(ctrl *gomock.Controller) *MockenvStackDescriber {
+	mock := &MockenvStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvStackDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockendpointGetter) EXPECT() *MockendpointGetterMockRecorder {
+func (m *MockenvStackDescriber) EXPECT() *MockenvStackDescriberMockRecorder {
 	return m.recorder
 }
 
+// Outputs mocks base method.
+func (m *MockenvStackDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvStackDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvStackDescriber)(nil).Outputs))
+}
+
 // ServiceDiscoveryEndpoint mocks base method.
-func (m *MockendpointGetter) ServiceDiscoveryEndpoint() (string, error) {
+func (m *MockenvStackDescriber) ServiceDiscoveryEndpoint() (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ServiceDiscoveryEndpoint")
 	ret0, _ := ret[0].(string)
@@ -4391,9 +4784,24 @@ func (m *MockendpointGetter) ServiceDiscoveryEndpoint() (string, error) {
 }
 
 // ServiceDiscoveryEndpoint indicates an expected call of ServiceDiscoveryEndpoint.
-func (mr *MockendpointGetterMockRecorder) ServiceDiscoveryEndpoint() *gomock.Call {
+func (mr *MockenvStackDescriberMockRecorder) ServiceDiscoveryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockenvStackDescriber)(nil).ServiceDiscoveryEndpoint))
+}
+
+// Version mocks base method.
+func (m *MockenvStackDescriber) Version() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockenvStackDescriberMockRecorder) Version() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockendpointGetter)(nil).ServiceDiscoveryEndpoint))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockenvStackDescriber)(nil).Version))
 }
 
 // MockenvTemplater is a mock of envTemplater interface.
@@ -4623,17 +5031,17 @@ func (m *MockappUpgrader) EXPECT() *MockappUpgraderMockRecorder {
 }
 
 // UpgradeApplication mocks base method.
-func (m *MockappUpgrader) UpgradeApplication(in *deploy.CreateAppInput) error {
+func (m *MockappUpgrader) UpgradeApplication(out progress.FileWriter, in *deploy.CreateAppInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeApplication", in)
+	ret := m.ctrl.Call(m, "UpgradeApplication", out, in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpgradeApplication indicates an expected call of UpgradeApplication.
-func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomock.Call {
+func (mr *MockappUpgraderMockRecorder) UpgradeApplication(out, in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), out, in)
 }
 
 // MockpipelineGetter is a mock of pipelineGetter interface.
@@ -5911,6 +6319,132 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// MockecsPortForwarder is a mock of ecsPortForwarder interface.
+type MockecsPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsPortForwarderMockRecorder
+}
+
+// MockecsPortForwarderMockRecorder is the mock recorder for MockecsPortForwarder.
+type MockecsPortForwarderMockRecorder struct {
+	mock *MockecsPortForwarder
+}
+
+// NewMockecsPortForwarder creates a new mock instance.
+func NewMockecsPortForwarder(ctrl *gomock.Controller) *MockecsPortForwarder {
+	mock := &MockecsPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockecsPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsPortForwarder) EXPECT() *MockecsPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// ForwardPort mocks base method.
+func (m *MockecsPortForwarder) ForwardPort(in ssm.ForwardPortInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardPort", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForwardPort indicates an expected call of ForwardPort.
+func (mr *MockecsPortForwarderMockRecorder) ForwardPort(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardPort", reflect.TypeOf((*MockecsPortForwarder)(nil).ForwardPort), in)
+}
+
+// MockcontainerFileCopier is a mock of containerFileCopier interface.
+type MockcontainerFileCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerFileCopierMockRecorder
+}
+
+// MockcontainerFileCopierMockRecorder is the mock recorder for MockcontainerFileCopier.
+type MockcontainerFileCopierMockRecorder struct {
+	mock *MockcontainerFileCopier
+}
+
+// NewMockcontainerFileCopier creates a new mock instance.
+func NewMockcontainerFileCopier(ctrl *gomock.Controller) *MockcontainerFileCopier {
+	mock := &MockcontainerFileCopier{ctrl: ctrl}
+	mock.recorder = &MockcontainerFileCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerFileCopier) EXPECT() *MockcontainerFileCopierMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockcontainerFileCopier) Download(src cp.Container, srcPath, dstDir string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", src, srcPath, dstDir)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockcontainerFileCopierMockRecorder) Download(src, srcPath, dstDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockcontainerFileCopier)(nil).Download), src, srcPath, dstDir)
+}
+
+// Upload mocks base method.
+func (m *MockcontainerFileCopier) Upload(src string, dst cp.Container, dstDir string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", src, dst, dstDir)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockcontainerFileCopierMockRecorder) Upload(src, dst, dstDir interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockcontainerFileCopier)(nil).Upload), src, dst, dstDir)
+}
+
+// MockaddonsOutputsGetter is a mock of addonsOutputsGetter interface.
+type MockaddonsOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsOutputsGetterMockRecorder
+}
+
+// MockaddonsOutputsGetterMockRecorder is the mock recorder for MockaddonsOutputsGetter.
+type MockaddonsOutputsGetterMockRecorder struct {
+	mock *MockaddonsOutputsGetter
+}
+
+// NewMockaddonsOutputsGetter creates a new mock instance.
+func NewMockaddonsOutputsGetter(ctrl *gomock.Controller) *MockaddonsOutputsGetter {
+	mock := &MockaddonsOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockaddonsOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsOutputsGetter) EXPECT() *MockaddonsOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// AddonsOutputs mocks base method.
+func (m *MockaddonsOutputsGetter) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs.
+func (mr *MockaddonsOutputsGetterMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockaddonsOutputsGetter)(nil).AddonsOutputs))
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -6206,6 +6740,44 @@ func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
 }
 
+// MockpullThroughCacheRuleLister is a mock of pullThroughCacheRuleLister interface.
+type MockpullThroughCacheRuleLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullThroughCacheRuleListerMockRecorder
+}
+
+// MockpullThroughCacheRuleListerMockRecorder is the mock recorder for MockpullThroughCacheRuleLister.
+type MockpullThroughCacheRuleListerMockRecorder struct {
+	mock *MockpullThroughCacheRuleLister
+}
+
+// NewMockpullThroughCacheRuleLister creates a new mock instance.
+func NewMockpullThroughCacheRuleLister(ctrl *gomock.Controller) *MockpullThroughCacheRuleLister {
+	mock := &MockpullThroughCacheRuleLister{ctrl: ctrl}
+	mock.recorder = &MockpullThroughCacheRuleListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullThroughCacheRuleLister) EXPECT() *MockpullThroughCacheRuleListerMockRecorder {
+	return m.recorder
+}
+
+// PullThroughCacheRules mocks base method.
+func (m *MockpullThroughCacheRuleLister) PullThroughCacheRules() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullThroughCacheRules")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullThroughCacheRules indicates an expected call of PullThroughCacheRules.
+func (mr *MockpullThroughCacheRuleListerMockRecorder) PullThroughCacheRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullThroughCacheRules", reflect.TypeOf((*MockpullThroughCacheRuleLister)(nil).PullThroughCacheRules))
+}
+
 // MockrunningTaskSelector is a mock of runningTaskSelector interface.
 type MockrunningTaskSelector struct {
 	ctrl     *gomock.Controller
@@ -6490,6 +7062,127 @@ func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
+// MockworkerQueueDescriber is a mock of workerQueueDescriber interface.
+type MockworkerQueueDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkerQueueDescriberMockRecorder
+}
+
+// MockworkerQueueDescriberMockRecorder is the mock recorder for MockworkerQueueDescriber.
+type MockworkerQueueDescriberMockRecorder struct {
+	mock *MockworkerQueueDescriber
+}
+
+// NewMockworkerQueueDescriber creates a new mock instance.
+func NewMockworkerQueueDescriber(ctrl *gomock.Controller) *MockworkerQueueDescriber {
+	mock := &MockworkerQueueDescriber{ctrl: ctrl}
+	mock.recorder = &MockworkerQueueDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkerQueueDescriber) EXPECT() *MockworkerQueueDescriberMockRecorder {
+	return m.recorder
+}
+
+// QueueStats mocks base method.
+func (m *MockworkerQueueDescriber) QueueStats(q *describe.WorkerQueue) (*describe.QueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueStats", q)
+	ret0, _ := ret[0].(*describe.QueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueStats indicates an expected call of QueueStats.
+func (mr *MockworkerQueueDescriberMockRecorder) QueueStats(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueStats", reflect.TypeOf((*MockworkerQueueDescriber)(nil).QueueStats), q)
+}
+
+// Queues mocks base method.
+func (m *MockworkerQueueDescriber) Queues() ([]*describe.WorkerQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Queues")
+	ret0, _ := ret[0].([]*describe.WorkerQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Queues indicates an expected call of Queues.
+func (mr *MockworkerQueueDescriberMockRecorder) Queues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Queues", reflect.TypeOf((*MockworkerQueueDescriber)(nil).Queues))
+}
+
+// MockqueueMessenger is a mock of queueMessenger interface.
+type MockqueueMessenger struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueMessengerMockRecorder
+}
+
+// MockqueueMessengerMockRecorder is the mock recorder for MockqueueMessenger.
+type MockqueueMessengerMockRecorder struct {
+	mock *MockqueueMessenger
+}
+
+// NewMockqueueMessenger creates a new mock instance.
+func NewMockqueueMessenger(ctrl *gomock.Controller) *MockqueueMessenger {
+	mock := &MockqueueMessenger{ctrl: ctrl}
+	mock.recorder = &MockqueueMessengerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueMessenger) EXPECT() *MockqueueMessengerMockRecorder {
+	return m.recorder
+}
+
+// MoveMessages mocks base method.
+func (m *MockqueueMessenger) MoveMessages(srcURL, dstURL string, max int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMessages", srcURL, dstURL, max)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMessages indicates an expected call of MoveMessages.
+func (mr *MockqueueMessengerMockRecorder) MoveMessages(srcURL, dstURL, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMessages", reflect.TypeOf((*MockqueueMessenger)(nil).MoveMessages), srcURL, dstURL, max)
+}
+
+// Peek mocks base method.
+func (m *MockqueueMessenger) Peek(url string, max int) ([]*sqs.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", url, max)
+	ret0, _ := ret[0].([]*sqs.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockqueueMessengerMockRecorder) Peek(url, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockqueueMessenger)(nil).Peek), url, max)
+}
+
+// QueueAttributes mocks base method.
+func (m *MockqueueMessenger) QueueAttributes(url string) (*sqs.QueueAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueAttributes", url)
+	ret0, _ := ret[0].(*sqs.QueueAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueAttributes indicates an expected call of QueueAttributes.
+func (mr *MockqueueMessengerMockRecorder) QueueAttributes(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueAttributes", reflect.TypeOf((*MockqueueMessenger)(nil).QueueAttributes), url)
+}
+
 // MocktimeoutError is a mock of timeoutError interface.
 type MocktimeoutError struct {
 	ctrl     *gomock.Controller
@@ -6578,3 +7271,154 @@ func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
 }
+
+// MockwsAddonModuleManager is a mock of wsAddonModuleManager interface.
+type MockwsAddonModuleManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsAddonModuleManagerMockRecorder
+}
+
+// MockwsAddonModuleManagerMockRecorder is the mock recorder for MockwsAddonModuleManager.
+type MockwsAddonModuleManagerMockRecorder struct {
+	mock *MockwsAddonModuleManager
+}
+
+// NewMockwsAddonModuleManager creates a new mock instance.
+func NewMockwsAddonModuleManager(ctrl *gomock.Controller) *MockwsAddonModuleManager {
+	mock := &MockwsAddonModuleManager{ctrl: ctrl}
+	mock.recorder = &MockwsAddonModuleManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsAddonModuleManager) EXPECT() *MockwsAddonModuleManagerMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsAddonModuleManager) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsAddonModuleManagerMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsAddonModuleManager)(nil).ListWorkloads))
+}
+
+// Path mocks base method.
+func (m *MockwsAddonModuleManager) Path() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Path")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Path indicates an expected call of Path.
+func (mr *MockwsAddonModuleManagerMockRecorder) Path() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsAddonModuleManager)(nil).Path))
+}
+
+// OverwriteAddon mocks base method.
+func (m *MockwsAddonModuleManager) OverwriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteAddon", f, svc, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteAddon indicates an expected call of OverwriteAddon.
+func (mr *MockwsAddonModuleManagerMockRecorder) OverwriteAddon(f, svc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteAddon", reflect.TypeOf((*MockwsAddonModuleManager)(nil).OverwriteAddon), f, svc, name)
+}
+
+// ReadAddonModulesLock mocks base method.
+func (m *MockwsAddonModuleManager) ReadAddonModulesLock() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAddonModulesLock")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAddonModulesLock indicates an expected call of ReadAddonModulesLock.
+func (mr *MockwsAddonModuleManagerMockRecorder) ReadAddonModulesLock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonModulesLock", reflect.TypeOf((*MockwsAddonModuleManager)(nil).ReadAddonModulesLock))
+}
+
+// WriteAddon mocks base method.
+func (m *MockwsAddonModuleManager) WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAddon", f, svc, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAddon indicates an expected call of WriteAddon.
+func (mr *MockwsAddonModuleManagerMockRecorder) WriteAddon(f, svc, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonModuleManager)(nil).WriteAddon), f, svc, name)
+}
+
+// WriteAddonModulesLock mocks base method.
+func (m *MockwsAddonModuleManager) WriteAddonModulesLock(marshaler encoding.BinaryMarshaler) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteAddonModulesLock", marshaler)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteAddonModulesLock indicates an expected call of WriteAddonModulesLock.
+func (mr *MockwsAddonModuleManagerMockRecorder) WriteAddonModulesLock(marshaler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddonModulesLock", reflect.TypeOf((*MockwsAddonModuleManager)(nil).WriteAddonModulesLock), marshaler)
+}
+
+// MockaddonModuleFetcher is a mock of addonModuleFetcher interface.
+type MockaddonModuleFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonModuleFetcherMockRecorder
+}
+
+// MockaddonModuleFetcherMockRecorder is the mock recorder for MockaddonModuleFetcher.
+type MockaddonModuleFetcherMockRecorder struct {
+	mock *MockaddonModuleFetcher
+}
+
+// NewMockaddonModuleFetcher creates a new mock instance.
+func NewMockaddonModuleFetcher(ctrl *gomock.Controller) *MockaddonModuleFetcher {
+	mock := &MockaddonModuleFetcher{ctrl: ctrl}
+	mock.recorder = &MockaddonModuleFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonModuleFetcher) EXPECT() *MockaddonModuleFetcherMockRecorder {
+	return m.recorder
+}
+
+// Fetch mocks base method.
+func (m *MockaddonModuleFetcher) Fetch(src addon.ModuleSource) (*addon.Module, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fetch", src)
+	ret0, _ := ret[0].(*addon.Module)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fetch indicates an expected call of Fetch.
+func (mr *MockaddonModuleFetcherMockRecorder) Fetch(src interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockaddonModuleFetcher)(nil).Fetch), src)
+}
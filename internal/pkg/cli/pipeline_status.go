@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -34,6 +35,7 @@ type pipelineStatusVars struct {
 	appName          string
 	shouldOutputJSON bool
 	pipelineName     string
+	watch            bool
 }
 
 type pipelineStatusOpts struct {
@@ -47,6 +49,7 @@ type pipelineStatusOpts struct {
 	sel           appSelector
 	prompt        prompter
 	initDescriber func(opts *pipelineStatusOpts) error
+	watchStatus   func(in watchStatusInput) error
 }
 
 func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error) {
@@ -82,6 +85,7 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 			o.describer = d
 			return nil
 		},
+		watchStatus: watchStatus,
 	}, nil
 }
 
@@ -114,6 +118,15 @@ func (o *pipelineStatusOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
 	}
+	if o.watch {
+		return o.watchStatus(watchStatusInput{
+			resource:         fmt.Sprintf("pipeline %s", o.pipelineName),
+			poller:           describe.NewStatusPoller(o.describer),
+			shouldOutputJSON: o.shouldOutputJSON,
+			renderOut:        os.Stderr,
+			jsonOut:          o.w,
+		})
+	}
 	pipelineStatus, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
@@ -227,7 +240,9 @@ func buildPipelineStatusCmd() *cobra.Command {
 
 		Example: `
 Shows status of the pipeline "pipeline-myapp-myrepo".
-/code $ copilot pipeline status -n pipeline-myapp-myrepo`,
+/code $ copilot pipeline status -n pipeline-myapp-myrepo
+Waits until the pipeline "pipeline-myapp-myrepo" finishes and exits with a non-zero code if a stage failed.
+/code $ copilot pipeline status -n pipeline-myapp-myrepo --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPipelineStatusOpts(vars)
 			if err != nil {
@@ -239,6 +254,7 @@ Shows status of the pipeline "pipeline-myapp-myrepo".
 	cmd.Flags().StringVarP(&vars.pipelineName, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, pipelineWatchFlagDescription)
 
 	return cmd
 }
@@ -311,6 +311,8 @@ func TestPipelineStatus_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		shouldOutputJSON bool
 		pipelineName     string
+		watch            bool
+		watchErr         error
 		setupMocks       func(m pipelineStatusMocks)
 
 		expectedContent string
@@ -332,6 +334,21 @@ func TestPipelineStatus_Execute(t *testing.T) {
 			expectedContent: "mockData",
 			expectedError:   nil,
 		},
+		"watches the pipeline until it settles": {
+			pipelineName: mockPipelineName,
+			watch:        true,
+			setupMocks:   func(m pipelineStatusMocks) {},
+		},
+		"returns the error if the watched pipeline failed": {
+			pipelineName: mockPipelineName,
+			watch:        true,
+			watchErr: &errStatusFailed{
+				resource: "pipeline pipeline-dinder-badgoose-repo",
+				reason:   "stage Build failed",
+			},
+			setupMocks:    func(m pipelineStatusMocks) {},
+			expectedError: errors.New("pipeline pipeline-dinder-badgoose-repo settled in a failed state: stage Build failed"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -353,10 +370,15 @@ func TestPipelineStatus_Execute(t *testing.T) {
 				pipelineStatusVars: pipelineStatusVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
 					pipelineName:     tc.pipelineName,
+					watch:            tc.watch,
 				},
 				describer:     mockDescriber,
 				initDescriber: func(o *pipelineStatusOpts) error { return nil },
-				w:             b,
+				watchStatus: func(in watchStatusInput) error {
+					require.Equal(t, "pipeline "+tc.pipelineName, in.resource)
+					return tc.watchErr
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	portMappingSeparator = ":"
+	minPortNumber        = 1
+	maxPortNumber        = 65535
+)
+
+type portForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	taskID           string
+	containerName    string
+	ports            string // Port mapping specified as "<local>:<remote>" or "<port>".
+	remoteHost       string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+// parsePortMapping returns the local and remote ports of a "<local>:<remote>" or "<port>" mapping.
+func parsePortMapping(mapping string) (local, remote string, err error) {
+	if mapping == "" {
+		return "", "", fmt.Errorf("port mapping must be specified with --%s", svcPortFlag)
+	}
+	ports := strings.Split(mapping, portMappingSeparator)
+	switch len(ports) {
+	case 1:
+		local, remote = ports[0], ports[0]
+	case 2:
+		local, remote = ports[0], ports[1]
+	default:
+		return "", "", fmt.Errorf("port mapping %s must be of format <local>:<remote> or <port>", mapping)
+	}
+	for _, port := range []string{local, remote} {
+		num, err := strconv.Atoi(port)
+		if err != nil {
+			return "", "", fmt.Errorf("port %s in mapping %s must be a number", port, mapping)
+		}
+		if num < minPortNumber || num > maxPortNumber {
+			return "", "", fmt.Errorf("port %d in mapping %s must be between %d and %d", num, mapping, minPortNumber, maxPortNumber)
+		}
+	}
+	return local, remote, nil
+}
@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortMapping(t *testing.T) {
+	testCases := map[string]struct {
+		inMapping string
+
+		wantedLocal  string
+		wantedRemote string
+		wantedError  string
+	}{
+		"error if mapping is empty": {
+			wantedError: "port mapping must be specified with --port",
+		},
+		"error if mapping has too many ports": {
+			inMapping:   "80:80:80",
+			wantedError: "port mapping 80:80:80 must be of format <local>:<remote> or <port>",
+		},
+		"error if port is not a number": {
+			inMapping:   "8080:http",
+			wantedError: "port http in mapping 8080:http must be a number",
+		},
+		"error if port is out of range": {
+			inMapping:   "70000:80",
+			wantedError: "port 70000 in mapping 70000:80 must be between 1 and 65535",
+		},
+		"single port is used locally and remotely": {
+			inMapping:    "5432",
+			wantedLocal:  "5432",
+			wantedRemote: "5432",
+		},
+		"local and remote ports": {
+			inMapping:    "8080:80",
+			wantedLocal:  "8080",
+			wantedRemote: "80",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			local, remote, err := parsePortMapping(tc.inMapping)
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLocal, local)
+			require.Equal(t, tc.wantedRemote, remote)
+		})
+	}
+}
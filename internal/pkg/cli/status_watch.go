@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	exitCodeStatusFailed = 2 // Exit code when a watched resource settles in a failed state.
+)
+
+// errStatusFailed is returned when a watched resource settles in a failed state.
+type errStatusFailed struct {
+	resource string
+	reason   string
+}
+
+func (e *errStatusFailed) Error() string {
+	return fmt.Sprintf("%s settled in a failed state: %s", e.resource, e.reason)
+}
+
+// ExitCode returns the exit code of the program so that scripts can tell a failed resource apart from a command error.
+func (e *errStatusFailed) ExitCode() int {
+	return exitCodeStatusFailed
+}
+
+// watchStatusInput holds the configuration to watch the status of a resource until it settles.
+type watchStatusInput struct {
+	resource         string              // Human readable name of the resource being watched, used in errors.
+	poller           stream.StatusPoller // Poller that describes the latest status of the resource.
+	shouldOutputJSON bool                // If true, each snapshot is written as a JSON line to jsonOut.
+
+	renderOut termprogress.FileWriter // Terminal where the status is re-rendered in place.
+	jsonOut   io.Writer               // Writer where JSON snapshots are written.
+}
+
+// watchStatus polls the resource until it reaches a terminal state.
+// If the resource settles in a failed state, returns an *errStatusFailed.
+func watchStatus(in watchStatusInput) error {
+	streamer := stream.NewStatusStreamer(in.poller)
+	g, ctx := errgroup.WithContext(context.Background())
+	if in.shouldOutputJSON {
+		sub := streamer.Subscribe()
+		g.Go(func() error {
+			for snapshot := range sub {
+				fmt.Fprint(in.jsonOut, snapshot.JSON)
+			}
+			return nil
+		})
+	} else {
+		renderer := termprogress.ListeningStatusRenderer(streamer, termprogress.RenderOptions{})
+		g.Go(func() error {
+			return termprogress.Render(ctx, termprogress.NewTabbedFileWriter(in.renderOut), renderer)
+		})
+	}
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if last := streamer.Last(); last.Failed {
+		return &errStatusFailed{
+			resource: in.resource,
+			reason:   last.Reason,
+		}
+	}
+	return nil
+}
@@ -26,12 +26,16 @@ const (
 	dynamoDBStorageType = "DynamoDB"
 	s3StorageType       = "S3"
 	rdsStorageType      = "Aurora"
+	redisStorageType    = "Redis"
+	sqsStorageType      = "SQS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	sqsStorageType,
 }
 
 // Displayed options for storage types
@@ -39,12 +43,16 @@ const (
 	dynamoDBStorageTypeOption = "DynamoDB"
 	s3StorageTypeOption       = "S3"
 	rdsStorageTypeOption      = "Aurora Serverless"
+	redisStorageTypeOption    = "ElastiCache Redis"
+	sqsStorageTypeOption      = "SQS"
 )
 
 var optionToStorageType = map[string]string{
 	dynamoDBStorageTypeOption: dynamoDBStorageType,
 	s3StorageTypeOption:       s3StorageType,
 	rdsStorageTypeOption:      rdsStorageType,
+	redisStorageTypeOption:    redisStorageType,
+	sqsStorageTypeOption:      sqsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -60,12 +68,22 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "In-memory cache",
+	},
+	sqsStorageType: {
+		Value: sqsStorageTypeOption,
+		Hint:  "Queue",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Replication Group"
+	sqsQueueFriendlyText      = "SQS Queue"
 )
 
 // General-purpose prompts, collected for all storage resources.
@@ -75,6 +93,8 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is a managed in-memory data store, deployed in your environment's private subnets.
+SQS is a fully managed message queue, created with a dead-letter queue for messages that fail to process.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -220,6 +240,10 @@ func (o *initStorageOpts) Validate() error {
 			err = s3BucketNameValidation(o.storageName)
 		case rdsStorageType:
 			err = rdsNameValidation(o.storageName)
+		case redisStorageType:
+			err = redisNameValidation(o.storageName)
+		case sqsStorageType:
+			err = sqsQueueNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
 			err = dynamoTableNameValidation(o.storageName)
@@ -361,6 +385,12 @@ func (o *initStorageOpts) askStorageName() error {
 	case dynamoDBStorageType:
 		validator = dynamoTableNameValidation
 		friendlyText = dynamoDBTableFriendlyText
+	case redisStorageType:
+		validator = redisNameValidation
+		friendlyText = redisFriendlyText
+	case sqsStorageType:
+		validator = sqsQueueNameValidation
+		friendlyText = sqsQueueFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
 	}
@@ -657,6 +687,10 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 		templateBlob, err = o.newS3Template()
 	case rdsStorageType:
 		templateBlob, err = o.newRDSTemplate()
+	case redisStorageType:
+		templateBlob, err = o.newRedisTemplate()
+	case sqsStorageType:
+		templateBlob, err = o.newSQSTemplate()
 	}
 	if err != nil {
 		return nil, err
@@ -709,6 +743,24 @@ func (o *initStorageOpts) newS3Template() (*addon.S3Template, error) {
 	return addon.NewS3Template(props), nil
 }
 
+func (o *initStorageOpts) newRedisTemplate() (*addon.RedisTemplate, error) {
+	props := &addon.RedisProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+	}
+	return addon.NewRedisTemplate(props), nil
+}
+
+func (o *initStorageOpts) newSQSTemplate() (*addon.SQSTemplate, error) {
+	props := &addon.SQSProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+	}
+	return addon.NewSQSTemplate(props), nil
+}
+
 func (o *initStorageOpts) newRDSTemplate() (*addon.RDSTemplate, error) {
 	var engine string
 	switch o.rdsEngine {
@@ -781,6 +833,18 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		endpointVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		portVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Port")
+		newVar = template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName))
+		retrieveEnvVarCode = fmt.Sprintf(`const redis = require('redis');
+const client = redis.createClient({
+    url: `+"`rediss://${process.env.%s}:${process.env.%s}`"+`,
+    password: process.env.%s,
+});`, endpointVar, portVar, newVar)
+	case sqsStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "QueueURL")
+		retrieveEnvVarCode = fmt.Sprintf("const queueURL = process.env.%s", newVar)
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -817,7 +881,11 @@ Resource names are injected into your containers as environment variables for ea
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create an ElastiCache Redis replication group attached to the "frontend" service.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend
+  Create an SQS queue with a dead-letter queue attached to the "worker" service.
+  /code $ copilot storage init -n my-queue -t SQS -w worker`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
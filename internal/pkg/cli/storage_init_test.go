@@ -90,6 +90,30 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inStorageName: "my-cool_table.3",
 			wantedErr:     nil,
 		},
+		"successfully validates valid Redis name": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: redisStorageType,
+			inStorageName: "my-cache",
+			wantedErr:     nil,
+		},
+		"redis bad character": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: redisStorageType,
+			inStorageName: "my.cache",
+			wantedErr:     errInvalidRedisNameCharacters,
+		},
+		"sqs bad character": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: sqsStorageType,
+			inStorageName: "my.queue",
+			wantedErr:     errValueBadFormatWithUnderscore,
+		},
 		"s3 bad character": {
 			mockWs:        func(m *mocks.MockwsAddonManager) {},
 			mockStore:     func(m *mocks.Mockstore) {},
@@ -266,6 +290,14 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "In-memory cache",
+					},
+					{
+						Value: sqsStorageTypeOption,
+						Hint:  "Queue",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -975,6 +1007,32 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"happy calls for Redis": {
+			inAppName:     wantedAppName,
+			inStorageType: redisStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-cache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-cache").Return("/frontend/addons/my-cache.yml", nil)
+			},
+
+			wantedErr: nil,
+		},
+		"happy calls for SQS": {
+			inAppName:     wantedAppName,
+			inStorageType: sqsStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Worker Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-queue").Return("/frontend/addons/my-queue.yml", nil)
+			},
+
+			wantedErr: nil,
+		},
 		"happy calls for RDS with LBWS": {
 			inSvcName:        wantedSvcName,
 			inStorageType:    rdsStorageType,
@@ -32,6 +32,9 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
+	cmd.AddCommand(buildSvcCpCmd())
+	cmd.AddCommand(buildSvcQueueCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
 
@@ -0,0 +1,284 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cp"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCpNamePrompt     = "Which service would you like to copy files with?"
+	svcCpNameHelpPrompt = `Copilot copies files with one of your chosen service's tasks.
+The task is chosen at random unless the task ID is part of the container path, and the first essential container is used.`
+
+	cpTaskPathSeparator = ":"
+)
+
+var errCpNoContainerPath = errors.New("one of the source or destination must be a container path of the form [<task-id>]:<path>")
+
+type svcCpVars struct {
+	appName          string
+	envName          string
+	name             string
+	containerName    string
+	src              string
+	dst              string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcCpOpts struct {
+	svcCpVars
+	store            store
+	sel              deploySelector
+	newSvcDescriber  func(*session.Session) serviceDescriber
+	newCopier        func(*session.Session) containerFileCopier
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	// Override in unit test
+	randInt func(int) int
+
+	// Parsed from the source and destination arguments.
+	upload     bool
+	taskID     string
+	remotePath string
+	localPath  string
+}
+
+func newSvcCpOpts(vars svcCpVars) (*svcCpOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcCpOpts{
+		svcCpVars: vars,
+		store:     ssmStore,
+		sel:       selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newCopier: func(s *session.Session) containerFileCopier {
+			copier := cp.New(awsecs.New(s))
+			copier.Progress = os.Stderr
+			return copier
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcCpOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetService(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	if err := o.parsePaths(); err != nil {
+		return err
+	}
+	if o.upload {
+		if _, err := os.Stat(o.localPath); err != nil {
+			return fmt.Errorf("check local path %s: %w", o.localPath, err)
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcCpOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcCpNamePrompt, svcCpNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute copies files between the local file system and a running container of the service.
+func (o *svcCpOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("copying files with a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	task, err := selectTaskByIDPrefix(tasks, o.taskID, o.randInt)
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(task.TaskArn), err)
+	}
+	container := cp.Container{
+		Cluster: svcDesc.ClusterName,
+		Task:    taskID,
+		Name:    o.containerName,
+	}
+	if container.Name == "" {
+		// The first essential container is named with the workload name.
+		container.Name = o.name
+	}
+	copier := o.newCopier(sess)
+	if o.upload {
+		log.Infof("Copy %s to %s in container %s in task %s.\n", color.HighlightUserInput(o.localPath),
+			color.HighlightUserInput(o.remotePath), color.HighlightUserInput(container.Name), color.HighlightResource(taskID))
+		if err := copier.Upload(o.localPath, container, o.remotePath); err != nil {
+			return err
+		}
+	} else {
+		log.Infof("Copy %s from container %s in task %s to %s.\n", color.HighlightUserInput(o.remotePath),
+			color.HighlightUserInput(container.Name), color.HighlightResource(taskID), color.HighlightUserInput(o.localPath))
+		if err := copier.Download(container, o.remotePath, o.localPath); err != nil {
+			return err
+		}
+	}
+	log.Successln("Copied files.")
+	return nil
+}
+
+// parsePaths sets the direction of the copy from the source and destination arguments.
+// Exactly one of them must be a container path.
+func (o *svcCpOpts) parsePaths() error {
+	srcTask, srcPath, srcRemote := parseContainerPath(o.src)
+	dstTask, dstPath, dstRemote := parseContainerPath(o.dst)
+	switch {
+	case srcRemote && dstRemote:
+		return errors.New("copying files between two containers is not supported")
+	case dstRemote:
+		o.upload, o.taskID, o.remotePath, o.localPath = true, dstTask, dstPath, o.src
+	case srcRemote:
+		o.upload, o.taskID, o.remotePath, o.localPath = false, srcTask, srcPath, o.dst
+	default:
+		return errCpNoContainerPath
+	}
+	return nil
+}
+
+// parseContainerPath splits a path of the form "[<task-id>]:<path>" into the task ID prefix and the path in the container.
+// Returns false if arg is a local path.
+func parseContainerPath(arg string) (taskID, path string, ok bool) {
+	if filepath.VolumeName(arg) != "" {
+		// Windows paths such as "C:\logs" are local.
+		return "", "", false
+	}
+	parts := strings.SplitN(arg, cpTaskPathSeparator, 2)
+	if len(parts) != 2 || strings.ContainsAny(parts[0], `/\`) {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// buildSvcCpCmd builds the command for copying files between the local file system and a running container in a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := svcCpVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp <src> <dst>",
+		Short: "Copy files and directories between your machine and a running container part of a service.",
+		Long: `Copy files and directories between your machine and a running container part of a service.
+Refer to a path in the container as "<task-id>:<path>", where the task ID can be a prefix.
+Leave the task ID out, as in ":<path>", to use a task chosen at random.
+Paths in the container are copied into the local directory, and local paths into the container directory.`,
+		Example: `
+  Copy the "config" directory into /etc/app of a random task part of the "frontend" service.
+  /code $ copilot svc cp -e test -n frontend ./config :/etc/app
+  Download a heap dump from the task prefixed with ID "8c38184" into the current directory.
+  /code $ copilot svc cp -e test -n backend 8c38184:/tmp/heap.hprof .`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("requires a source and a destination argument")
+			}
+			return nil
+		},
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.src, vars.dst = args[0], args[1]
+			opts, err := newSvcCpOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", cpContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
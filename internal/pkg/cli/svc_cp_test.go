@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/cp"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSrc string
+		inDst string
+
+		wantedUpload     bool
+		wantedTaskID     string
+		wantedRemotePath string
+		wantedLocalPath  string
+		wantedError      error
+	}{
+		"error if neither path is in a container": {
+			inSrc:       "./config",
+			inDst:       "/tmp",
+			wantedError: errCpNoContainerPath,
+		},
+		"error if both paths are in a container": {
+			inSrc:       "8c38184:/tmp/a",
+			inDst:       ":/tmp/b",
+			wantedError: errors.New("copying files between two containers is not supported"),
+		},
+		"error if the local source does not exist": {
+			inSrc:       "./does-not-exist",
+			inDst:       ":/tmp",
+			wantedError: errors.New("check local path ./does-not-exist: stat ./does-not-exist: no such file or directory"),
+		},
+		"upload to a random task": {
+			inSrc: ".",
+			inDst: ":/etc/app",
+
+			wantedUpload:     true,
+			wantedRemotePath: "/etc/app",
+			wantedLocalPath:  ".",
+		},
+		"download from a task prefixed with an ID": {
+			inSrc: "8c38184:/tmp/heap.hprof",
+			inDst: "./dumps",
+
+			wantedTaskID:     "8c38184",
+			wantedRemotePath: "/tmp/heap.hprof",
+			wantedLocalPath:  "./dumps",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMPluginManager := mocks.NewMockssmPluginManager(ctrl)
+			mockSSMPluginManager.EXPECT().ValidateBinary().Return(nil).AnyTimes()
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					src: tc.inSrc,
+					dst: tc.inDst,
+				},
+				ssmPluginManager: mockSSMPluginManager,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUpload, opts.upload)
+			require.Equal(t, tc.wantedTaskID, opts.taskID)
+			require.Equal(t, tc.wantedRemotePath, opts.remotePath)
+			require.Equal(t, tc.wantedLocalPath, opts.localPath)
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Backend Service",
+	}
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				LastStatus: aws.String("RUNNING"),
+			},
+		},
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		upload        bool
+		containerName string
+		setupMocks    func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, copier *mocks.MockcontainerFileCopier)
+
+		wantedError error
+	}{
+		"return error if no running task found": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, _ *mocks.MockcontainerFileCopier) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedError: fmt.Errorf("found no running task for service mockSvc in environment mockEnv"),
+		},
+		"return error if fail to upload": {
+			upload: true,
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, copier *mocks.MockcontainerFileCopier) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+				copier.EXPECT().Upload("./config", cp.Container{
+					Cluster: "mockCluster",
+					Task:    "mockTaskID",
+					Name:    "mockSvc",
+				}, "/etc/app").Return(mockError)
+			},
+			wantedError: mockError,
+		},
+		"download from the selected container": {
+			containerName: "sidecar",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, copier *mocks.MockcontainerFileCopier) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+				copier.EXPECT().Download(cp.Container{
+					Cluster: "mockCluster",
+					Task:    "mockTaskID",
+					Name:    "sidecar",
+				}, "/etc/app", "./config").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockCopier := mocks.NewMockcontainerFileCopier(ctrl)
+			tc.setupMocks(mockStore, mockDescriber, mockCopier)
+
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					appName:       "mockApp",
+					envName:       "mockEnv",
+					name:          "mockSvc",
+					containerName: tc.containerName,
+				},
+				store: mockStore,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return mockDescriber
+				},
+				newCopier: func(_ *session.Session) containerFileCopier {
+					return mockCopier
+				},
+				randInt:    func(i int) int { return 0 },
+				upload:     tc.upload,
+				remotePath: "/etc/app",
+				localPath:  "./config",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"text/tabwriter"
 	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
@@ -18,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/docker/cosign"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -31,6 +35,7 @@ import (
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -47,20 +52,35 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	fmtForceUpdateSvcStart    = "Forcing an update for service %s from environment %s"
 	fmtForceUpdateSvcFailed   = "Failed to force an update for service %s from environment %s: %v.\n"
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
+
+	contentHashTagPrefix = "content-"
+
+	// Format of the table of image scan findings.
+	imageScanMinCellWidth     = 10
+	imageScanTabWidth         = 4
+	imageScanCellPaddingWidth = 2
+	maxImageScanFindingsShown = 10
 )
 
 var aliasUsedWithoutDomainFriendlyText = fmt.Sprintf("To use %s, your application must be associated with a domain: %s.\n",
 	color.HighlightCode("http.alias"),
 	color.HighlightCode("copilot app init --domain example.com"))
 
+var aliasUsedWithoutDomainOrCertsFriendlyText = fmt.Sprintf("To use %s, your application must be associated with a domain: %s,\nor your environment must import certificates: %s.\n",
+	color.HighlightCode("http.alias"),
+	color.HighlightCode("copilot app init --domain example.com"),
+	color.HighlightCode("copilot env init --import-cert-arns arn:aws:acm:us-east-1:123456789012:certificate/example"))
+
 type deployWkldVars struct {
 	appName        string
 	name           string
@@ -68,6 +88,10 @@ type deployWkldVars struct {
 	imageTag       string
 	resourceTags   map[string]string
 	forceNewUpdate bool
+	forceBuild     bool
+	skipScan       bool
+	outputFormat   string
+	skipEnvUpgrade bool // Set when the caller already upgraded the environment, such as "deploy --all".
 }
 
 type uploadCustomResourcesOpts struct {
@@ -83,6 +107,10 @@ type deploySvcOpts struct {
 	ws                  wsSvcDirReader
 	fs                  *afero.Afero
 	imageBuilderPusher  imageBuilderPusher
+	newRegistryPusher   func(registry *manifest.ImageRegistry) (imageBuilderPusher, error)
+	imageScanner        imageScanner
+	imageSigner         imageSigner
+	contentHash         func(args *dockerengine.BuildArguments) (string, error)
 	unmarshal           func([]byte) (manifest.WorkloadManifest, error)
 	newInterpolator     func(app, env string) interpolator
 	s3                  uploader
@@ -94,7 +122,7 @@ type deploySvcOpts struct {
 	sessProvider        sessionProvider
 	envUpgradeCmd       actionCommand
 	newAppVersionGetter func(string) (versionGetter, error)
-	endpointGetter      endpointGetter
+	envStack            envStackDescriber
 	snsTopicGetter      deployedEnvironmentLister
 	identity            identityService
 	subnetLister        vpcSubnetLister
@@ -105,19 +133,25 @@ type deploySvcOpts struct {
 	prompt  prompter
 
 	// cached variables
-	targetApp         *config.Application
-	targetEnvironment *config.Environment
-	targetSvc         *config.Workload
-	appliedManifest   interface{}
-	imageDigest       string
-	buildRequired     bool
-	addonsURL         string
-	envFileARN        string
-	appEnvResources   *stack.AppRegionalResources
-	workspacePath     string
-	rdSvcAlias        string
-	svcUpdater        svcForceUpdater
-	now               func() time.Time
+	targetApp           *config.Application
+	targetEnvironment   *config.Environment
+	targetSvc           *config.Workload
+	appliedManifest     interface{}
+	imageDigest         string
+	sidecarImageDigests map[string]string
+	buildRequired       bool
+	registryURI         string          // URI of the third-party repository that images are pushed to instead of ECR.
+	verifiedImage       *stack.ECRImage // Image location pinned to the digest that its verified signature attests to.
+	pinImageDigests     bool            // Deploy the built images by digest rather than by tag once their signatures are verified.
+	srcImages           *pushedImages   // Images pushed for another environment that are copied instead of building them again.
+	pushed              *pushedImages   // Images pushed for the environment.
+	addonsURL           string
+	envFileARN          string
+	appEnvResources     *stack.AppRegionalResources
+	workspacePath       string
+	rdSvcAlias          string
+	svcUpdater          svcForceUpdater
+	now                 func() time.Time
 
 	subscriptions []manifest.TopicSubscription
 
@@ -161,6 +195,8 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		now:             time.Now,
 		sessProvider:    sessions.NewProvider(),
 		snsTopicGetter:  deployStore,
+		contentHash:     dockerengine.ContentHash,
+		imageSigner:     cosign.New(exec.NewCmd()),
 	}
 	opts.uploadOpts = newUploadCustomResourcesOpts(opts)
 	return opts, err
@@ -185,6 +221,11 @@ func (o *deploySvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.outputFormat != "" {
+		if err := validateOutputFormat(o.outputFormat); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -199,8 +240,19 @@ func (o *deploySvcOpts) Ask() error {
 	return nil
 }
 
-// Execute builds and pushes the container image for the service,
+// Execute builds and pushes the container image for the service, and deploys the service.
 func (o *deploySvcOpts) Execute() error {
+	out := progressWriter(o.outputFormat, o.name)
+	err := o.uploadArtifacts()
+	if err == nil {
+		err = o.deployWorkload(out)
+	}
+	writeResultEvent(out, deployResultEvent(o.appName, o.envName, o.name, err))
+	return err
+}
+
+// uploadArtifacts builds and pushes the images of the service, and uploads the rest of its artifacts to S3.
+func (o *deploySvcOpts) uploadArtifacts() error {
 	o.imageTag = imageTagFromGit(o.cmd, o.imageTag) // Best effort assign git tag.
 	env, err := targetEnv(o.store, o.appName, o.envName)
 	if err != nil {
@@ -223,19 +275,33 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
-	if err := o.envUpgradeCmd.Execute(); err != nil {
-		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+	if !o.skipEnvUpgrade {
+		if err := o.envUpgradeCmd.Execute(); err != nil {
+			return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
+		}
 	}
 
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
 
-	if err := o.pushArtifactsToS3(); err != nil {
-		return err
-	}
+	return o.pushArtifactsToS3()
+}
+
+// pushedImages returns the images that uploadArtifacts pushed, or nil if the service doesn't build any image.
+func (o *deploySvcOpts) pushedImages() *pushedImages {
+	return o.pushed
+}
+
+// reuseImages makes uploadArtifacts copy the images pushed for another environment by digest,
+// instead of building, scanning and signing them again, if they're built from the same inputs.
+func (o *deploySvcOpts) reuseImages(images *pushedImages) {
+	o.srcImages = images
+}
 
-	if err := o.deploySvc(); err != nil {
+// deployWorkload deploys the stack of the service once its artifacts are uploaded, and renders the progress to out.
+func (o *deploySvcOpts) deployWorkload(out termprogress.FileWriter) error {
+	if err := o.deploySvc(out); err != nil {
 		return err
 	}
 	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
@@ -340,6 +406,8 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newRegistryPusher = newThirdPartyImageBuilderPusher(repoName, defaultSessEnvRegion)
+	o.imageScanner = registry
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
@@ -350,7 +418,7 @@ func (o *deploySvcOpts) configureClients() error {
 	// CF client against env account profile AND target environment region.
 	o.svcCFN = cloudformation.New(envSession)
 
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	o.envStack, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -412,21 +480,123 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	if err != nil {
 		return err
 	}
+	if err := o.configureImageRegistry(svc); err != nil {
+		return err
+	}
+	signatureKey, err := o.imageSignatureKey(svc)
+	if err != nil {
+		return err
+	}
+	prodKey := prodSignatureKey(o.targetApp, o.targetEnvironment)
 	if !required {
-		return nil
+		image, err := verifyImageLocation(o.imageSigner, manifest.ImageLocation(svc), signatureKey, prodKey, o.targetEnvironment)
+		if err != nil {
+			return err
+		}
+		o.verifiedImage = image
 	}
-	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(svc)
+	// If it is built from local Dockerfile, build and push to the ECR repo or the third-party registry.
+	var buildArg *dockerengine.BuildArguments
+	if required {
+		buildArg, err = o.dfBuildArgs(svc)
+		if err != nil {
+			return err
+		}
+	}
+	sidecarBuildArg, err := o.dfSidecarBuildArgs(svc)
 	if err != nil {
 		return err
 	}
+	if buildArg == nil && len(sidecarBuildArg) == 0 {
+		return nil
+	}
 
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	scan, err := enableImageScan(o.imageScanner, repoName, manifest.ImageScanConfig(svc), o.skipScan, o.targetEnvironment)
 	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+		return err
+	}
+	var signingKey string
+	if required {
+		signingKey = signatureKey
+	}
+	inputs := imageBuildInputs(o.name, buildArg, sidecarBuildArg)
+	var digest string
+	var sidecarDigests map[string]string
+	src := o.srcImages
+	reused := src.reusableFor(inputs, signingKey)
+	if reused {
+		// The images were already built, scanned and signed for another environment: tag or copy them by digest.
+		digest, sidecarDigests = src.digest, src.sidecarDigests
+		// Third-party registries are shared by all environments, so the images are already there with their tags.
+		if o.registryURI == "" || src.repo.URI() != o.imageBuilderPusher.URI() {
+			if err := copyImages(o.imageBuilderPusher, o.imageSigner, src, o.name, buildArg, sidecarBuildArg); err != nil {
+				return err
+			}
+		}
+	} else {
+		digest, sidecarDigests, err = buildAndPushImages(o.imageBuilderPusher, o.contentHash, buildArg, sidecarBuildArg, o.forceBuild)
+		if err != nil {
+			return err
+		}
+	}
+	scanned := reused && src.scanned
+	if scan != nil && !scanned {
+		if err := checkImageScanFindings(o.imageScanner, repoName, aws.StringValue(scan.FailOn), imageDigestsByName(o.name, digest, sidecarDigests)); err != nil {
+			return err
+		}
+		scanned = true
+	}
+	if signingKey != "" && !reused {
+		if err := signImages(o.imageSigner, o.imageBuilderPusher.URI(), signingKey, imageDigestsByName(o.name, digest, sidecarDigests)); err != nil {
+			return err
+		}
+	}
+	o.pushed = &pushedImages{
+		repo:           o.imageBuilderPusher,
+		digest:         digest,
+		sidecarDigests: sidecarDigests,
+		inputs:         inputs,
+		signatureKey:   signingKey,
+		scanned:        scanned,
+	}
+	if prodKey != "" {
+		if err := verifyPushedImages(o.imageSigner, o.imageBuilderPusher.URI(), prodKey, imageDigestsByName(o.name, digest, sidecarDigests), o.targetEnvironment); err != nil {
+			return err
+		}
+		o.pinImageDigests = true
 	}
 	o.imageDigest = digest
-	o.buildRequired = true
+	o.sidecarImageDigests = sidecarDigests
+	o.buildRequired = required
+	return nil
+}
+
+// imageSignatureKey returns the key of the image signature in the manifest.
+// Paths to key files are relative to the workspace root.
+func (o *deploySvcOpts) imageSignatureKey(mft interface{}) (string, error) {
+	key := manifest.ImageSignatureKey(mft)
+	if !isRelativeKeyPath(key) {
+		return key, nil
+	}
+	if err := o.retrieveWorkspacePath(); err != nil {
+		return "", err
+	}
+	return filepath.Join(o.workspacePath, key), nil
+}
+
+// configureImageRegistry pushes images to the third-party registry of the manifest instead of the ECR repo if there is one.
+func (o *deploySvcOpts) configureImageRegistry(mft interface{}) error {
+	registry := manifest.ThirdPartyImageRegistry(mft)
+	if registry == nil {
+		return nil
+	}
+	pusher, err := o.newRegistryPusher(registry)
+	if err != nil {
+		return fmt.Errorf("initiate image builder pusher for registry %s: %w", aws.StringValue(registry.URI), err)
+	}
+	o.imageBuilderPusher = pusher
+	o.registryURI = pusher.URI()
 	return nil
 }
 
@@ -437,6 +607,16 @@ func (o *deploySvcOpts) dfBuildArgs(svc interface{}) (*dockerengine.BuildArgumen
 	return buildArgs(o.name, o.imageTag, o.workspacePath, svc)
 }
 
+func (o *deploySvcOpts) dfSidecarBuildArgs(svc interface{}) (map[string]*dockerengine.BuildArguments, error) {
+	if len(manifest.DockerfileBuildSidecars(svc)) == 0 {
+		return nil, nil
+	}
+	if err := o.retrieveWorkspacePath(); err != nil {
+		return nil, err
+	}
+	return sidecarBuildArgs(o.name, o.imageBuilderPusher.URI(), o.imageTag, o.workspacePath, svc)
+}
+
 func (o *deploySvcOpts) pushArtifactsToS3() error {
 	mft, err := o.manifest()
 	if err != nil {
@@ -532,47 +712,76 @@ func (o *deploySvcOpts) manifest() (interface{}, error) {
 }
 
 func (o *deploySvcOpts) runtimeConfig() (*stack.RuntimeConfig, error) {
-	endpoint, err := o.endpointGetter.ServiceDiscoveryEndpoint()
+	endpoint, err := o.envStack.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	envAddonsOutputs, err := deployedEnvAddonsOutputs(o.envStack)
 	if err != nil {
 		return nil, err
 	}
 
-	if !o.buildRequired {
+	if !o.buildRequired && len(o.sidecarImageDigests) == 0 {
 		return &stack.RuntimeConfig{
+			Image:                    o.verifiedImage,
 			AddonsTemplateURL:        o.addonsURL,
 			EnvFileARN:               o.envFileARN,
 			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
 			ServiceDiscoveryEndpoint: endpoint,
+			EnvAddonsOutputs:         envAddonsOutputs,
+			PullThroughCachePrefixes: o.targetEnvironment.CustomConfig.PullThroughCachePrefixes(),
 			AccountID:                o.targetEnvironment.AccountID,
 			Region:                   o.targetEnvironment.Region,
 		}, nil
 	}
 
-	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
+	repoURL, err := o.imageRepoURL()
+	if err != nil {
 		return nil, err
 	}
+	imageTag := o.imageTag
+	if o.pinImageDigests {
+		imageTag = ""
+	}
+	rc := &stack.RuntimeConfig{
+		AddonsTemplateURL:        o.addonsURL,
+		EnvFileARN:               o.envFileARN,
+		AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
+		SidecarImages:            sidecarECRImages(repoURL, imageTag, o.sidecarImageDigests),
+		ServiceDiscoveryEndpoint: endpoint,
+		EnvAddonsOutputs:         envAddonsOutputs,
+		PullThroughCachePrefixes: o.targetEnvironment.CustomConfig.PullThroughCachePrefixes(),
+		AccountID:                o.targetEnvironment.AccountID,
+		Region:                   o.targetEnvironment.Region,
+	}
+	rc.Image = o.verifiedImage
+	if o.buildRequired {
+		rc.Image = &stack.ECRImage{
+			RepoURL:  repoURL,
+			ImageTag: imageTag,
+			Digest:   o.imageDigest,
+		}
+	}
+	return rc, nil
+}
 
+// imageRepoURL returns the URL of the repository that the images built from local Dockerfiles are pushed to.
+func (o *deploySvcOpts) imageRepoURL() (string, error) {
+	if o.registryURI != "" {
+		return o.registryURI, nil
+	}
+	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
+		return "", err
+	}
 	repoURL, ok := o.appEnvResources.RepositoryURLs[o.name]
 	if !ok {
-		return nil, &errRepoNotFound{
+		return "", &errRepoNotFound{
 			wlName:       o.name,
 			envRegion:    o.targetEnvironment.Region,
 			appAccountID: o.targetApp.AccountID,
 		}
 	}
-	return &stack.RuntimeConfig{
-		AddonsTemplateURL: o.addonsURL,
-		EnvFileARN:        o.envFileARN,
-		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
-		Image: &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.imageTag,
-			Digest:   o.imageDigest,
-		},
-		ServiceDiscoveryEndpoint: endpoint,
-		AccountID:                o.targetEnvironment.AccountID,
-		Region:                   o.targetEnvironment.Region,
-	}, nil
+	return repoURL, nil
 }
 
 func uploadCustomResources(o *uploadCustomResourcesOpts, appEnvResources *stack.AppRegionalResources) (map[string]string, error) {
@@ -596,6 +805,11 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 	if err != nil {
 		return nil, err
 	}
+	logInternetEgressWarning(o.name, o.targetEnvironment, mft)
+	logWildcardIAMWarning(o.name, o.targetEnvironment, mft)
+	if err := validateEnvEventBusVersion(mft, o.targetEnvironment.Name, o.envStack); err != nil {
+		return nil, err
+	}
 	rc, err := o.runtimeConfig()
 	if err != nil {
 		return nil, err
@@ -606,10 +820,15 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		if o.targetApp.Domain == "" && t.HasAliases() {
+		importedCerts := o.targetEnvironment.CustomConfig.HasImportedCerts()
+		if o.targetApp.Domain == "" && !t.NLBConfig.Aliases.IsEmpty() {
 			log.Errorf(aliasUsedWithoutDomainFriendlyText)
 			return nil, errors.New("alias specified when application is not associated with a domain")
 		}
+		if o.targetApp.Domain == "" && !t.RoutingRule.Alias.IsEmpty() && !importedCerts {
+			log.Errorf(aliasUsedWithoutDomainOrCertsFriendlyText)
+			return nil, errors.New("alias specified when application is not associated with a domain")
+		}
 
 		var opts []stack.LoadBalancedWebServiceOption
 
@@ -638,6 +857,9 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 				DNSName:             o.targetApp.Domain,
 				AccountPrincipalARN: caller.RootUserARN,
 			}))
+		} else if importedCerts && !t.RoutingRule.Alias.IsEmpty() {
+			// The aliases live in DNS zones that Copilot doesn't manage, and are served with the environment's imported certificates.
+			opts = append(opts, stack.WithImportedCerts())
 		}
 		if !t.NLBConfig.IsEmpty() {
 			cidrBlocks, err := o.publicCIDRBlocks()
@@ -727,14 +949,14 @@ func (o *deploySvcOpts) stackConfiguration() (cloudformation.StackConfiguration,
 	return conf, nil
 }
 
-func (o *deploySvcOpts) deploySvc() error {
+func (o *deploySvcOpts) deploySvc(out termprogress.FileWriter) error {
 	conf, err := o.stackConfiguration()
 	if err != nil {
 		return err
 	}
 
 	cmdRunAt := o.now()
-	if err := o.svcCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	if err := o.svcCFN.DeployService(out, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if !errors.As(err, &errEmptyCS) {
 			return fmt.Errorf("deploy service: %w", err)
@@ -774,6 +996,374 @@ func (o *deploySvcOpts) forceDeploy() error {
 	return nil
 }
 
+// buildAndPushImage builds and pushes the image unless the repository already has an image built from the same inputs,
+// in which case the existing image is tagged with the build tags and its digest is returned.
+// The check is skipped if forceBuild is true. Third-party registries can't look up images by tag, so their images are always built.
+func buildAndPushImage(pusher imageBuilderPusher, contentHash func(*dockerengine.BuildArguments) (string, error), args *dockerengine.BuildArguments, forceBuild bool) (string, error) {
+	if !forceBuild {
+		hash, err := contentHash(args)
+		if err != nil {
+			return "", fmt.Errorf("compute content hash of image: %w", err)
+		}
+		hashTag := contentHashTag(hash)
+		digest, err := pusher.Digest(hashTag)
+		if err != nil {
+			return "", err
+		}
+		if digest != "" {
+			if err := pusher.Tag(digest, args); err != nil {
+				return "", err
+			}
+			log.Infof("Skipping the image build: image %s was already built from the same Dockerfile, build args and context.\n",
+				color.HighlightResource(digest))
+			return digest, nil
+		}
+		args.Tags = append(args.Tags, hashTag)
+	}
+	digest, err := pusher.BuildAndPush(dockerengine.New(exec.NewCmd()), args)
+	if err != nil {
+		return "", fmt.Errorf("build and push image: %w", err)
+	}
+	return digest, nil
+}
+
+// newThirdPartyImageBuilderPusher returns a function that creates the image builder pusher of a third-party registry.
+// The credentials of the registry are read from Secrets Manager in the region of the session.
+func newThirdPartyImageBuilderPusher(repoName string, sess *session.Session) func(*manifest.ImageRegistry) (imageBuilderPusher, error) {
+	return func(registry *manifest.ImageRegistry) (imageBuilderPusher, error) {
+		return repository.New(repoName, repository.NewThirdPartyRegistry(aws.StringValue(registry.URI),
+			aws.StringValue(registry.Credentials), secretsmanager.FromSession(sess)))
+	}
+}
+
+// isRelativeKeyPath returns true if the signature key is a relative path to a key file
+// rather than a KMS key ARN or a cosign key reference such as "awskms://" or "k8s://".
+func isRelativeKeyPath(key string) bool {
+	return key != "" && !strings.HasPrefix(key, "arn:") && !strings.Contains(key, "://") && !filepath.IsAbs(key)
+}
+
+// prodSignatureKey returns the signature key of the application if the environment is a production environment.
+// Every image deployed to a production environment of an application with a signature key must be signed with that key,
+// regardless of the manifest.
+func prodSignatureKey(app *config.Application, env *config.Environment) string {
+	if app == nil || !env.Prod {
+		return ""
+	}
+	return app.SignatureKey
+}
+
+// verifyImageLocation verifies the signature of the image location with the production key if there is one,
+// or with the key of the manifest otherwise.
+// It returns the image pinned to the verified digest, or nil if there is nothing to pin.
+func verifyImageLocation(signer imageSigner, location, manifestKey, prodKey string, env *config.Environment) (*stack.ECRImage, error) {
+	key := manifestKey
+	if prodKey != "" {
+		key = prodKey
+	}
+	if key == "" {
+		return nil, nil
+	}
+	digest, err := verifyImageSignature(signer, location, key, env)
+	if err != nil || digest == "" {
+		return nil, err
+	}
+	return &stack.ECRImage{
+		RepoURL: imageRepository(location),
+		Digest:  digest,
+	}, nil
+}
+
+// verifyPushedImages verifies the signatures of the images pushed to the repository, keyed by image name, with the key.
+func verifyPushedImages(signer imageSigner, repoURI, key string, digests map[string]string, env *config.Environment) error {
+	names := make([]string, 0, len(digests))
+	for name := range digests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := verifyImageSignature(signer, fmt.Sprintf("%s@%s", repoURI, digests[name]), key, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyImageSignature verifies the signature of the image with the key, and returns the digest that the signature attests to.
+// Images without a valid signature are refused in production environments and deployed with a warning otherwise.
+func verifyImageSignature(signer imageSigner, image, key string, env *config.Environment) (string, error) {
+	digest, err := signer.Verify(image, key)
+	if err == nil {
+		log.Successf("Verified the signature of image %s.\n", color.HighlightResource(image))
+		return digest, nil
+	}
+	if env.Prod {
+		return "", fmt.Errorf("refuse to deploy image %s without a valid signature to production environment %s: %w", image, env.Name, err)
+	}
+	log.Warningf("Deploying image %s without a valid signature to environment %s: %v\n", color.HighlightResource(image), env.Name, err)
+	return "", nil
+}
+
+// imageRepository returns the image location without its tag or digest.
+func imageRepository(location string) string {
+	if i := strings.Index(location, "@"); i != -1 {
+		location = location[:i]
+	}
+	if i := strings.LastIndex(location, ":"); i > strings.LastIndex(location, "/") {
+		location = location[:i]
+	}
+	return location
+}
+
+// signImages signs the images pushed to the repository, keyed by image name, with the key.
+func signImages(signer imageSigner, repoURI, key string, digests map[string]string) error {
+	names := make([]string, 0, len(digests))
+	for name := range digests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		image := fmt.Sprintf("%s@%s", repoURI, digests[name])
+		if err := signer.Sign(image, key); err != nil {
+			return err
+		}
+		log.Successf("Signed image %s.\n", color.HighlightResource(image))
+	}
+	return nil
+}
+
+// enableImageScan turns on scan on push for the ECR repo if the manifest scans the image on push.
+// The app stack doesn't configure the scans of its repos, so only the repos of workloads that scan their images are scanned.
+// It returns the scan configuration if the deployment must wait for the scan findings, or nil otherwise.
+func enableImageScan(scanner imageScanner, repoName string, scan *manifest.ImageScan, skipScan bool, env *config.Environment) (*manifest.ImageScan, error) {
+	if scan == nil {
+		return nil, nil
+	}
+	if aws.BoolValue(scan.OnPush) {
+		if err := scanner.EnableScanOnPush(repoName); err != nil {
+			return nil, err
+		}
+	}
+	if scan.FailOn == nil {
+		return nil, nil
+	}
+	if !skipScan {
+		return scan, nil
+	}
+	if env.Prod {
+		return nil, fmt.Errorf("flag --%s is not allowed for production environment %s", skipScanFlag, env.Name)
+	}
+	log.Warningf("Skipping the vulnerability scan of the images pushed to %s.\n", color.HighlightResource(repoName))
+	return nil, nil
+}
+
+// checkImageScanFindings waits for the vulnerability scans of the images pushed to the ECR repo, keyed by image name,
+// and returns an error if the findings of any image are at or above the input severity.
+func checkImageScanFindings(scanner imageScanner, repoName, failOn string, digests map[string]string) error {
+	severity := strings.ToUpper(failOn)
+	names := make([]string, 0, len(digests))
+	for name := range digests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var vulnerable []string
+	for _, name := range names {
+		log.Infof("Waiting for the vulnerability scan of image %s to complete.\n", color.HighlightUserInput(name))
+		findings, err := scanner.ImageScanFindings(repoName, digests[name])
+		if err != nil {
+			return fmt.Errorf("get scan findings of image %s: %w", name, err)
+		}
+		count := findings.CountAtOrAbove(severity)
+		if count == 0 {
+			continue
+		}
+		log.Errorf("The scan of image %s found %d %s at or above %s severity:\n",
+			color.HighlightUserInput(name), count, english.PluralWord(int(count), "vulnerability", "vulnerabilities"), severity)
+		fmt.Fprintln(log.DiagnosticWriter, imageScanFindingsTable(findings, severity))
+		vulnerable = append(vulnerable, name)
+	}
+	if len(vulnerable) == 0 {
+		return nil
+	}
+	return fmt.Errorf("abort the deployment: the scan of %s %s found vulnerabilities at or above %s severity",
+		english.PluralWord(len(vulnerable), "image", "images"), english.WordSeries(vulnerable, "and"), severity)
+}
+
+// imageScanFindingsTable returns a table with the number of findings per severity and the most severe findings
+// at or above the input severity.
+func imageScanFindingsTable(findings *ecr.ImageScanFindings, severity string) string {
+	rank := make(map[string]int)
+	for i, s := range ecr.FindingSeverities {
+		rank[s] = i
+	}
+	var blocking []ecr.ImageScanFinding
+	for _, f := range findings.Findings {
+		if rank[f.Severity] <= rank[severity] {
+			blocking = append(blocking, f)
+		}
+	}
+	sort.SliceStable(blocking, func(i, j int) bool {
+		return rank[blocking[i].Severity] < rank[blocking[j].Severity]
+	})
+
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, imageScanMinCellWidth, imageScanTabWidth, imageScanCellPaddingWidth, ' ', 0)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Severity", "Count")
+	for _, s := range ecr.FindingSeverities {
+		if count := findings.SeverityCounts[s]; count > 0 {
+			fmt.Fprintf(writer, "  %s\t%d\n", s, count)
+		}
+		if s == severity {
+			break
+		}
+	}
+	fmt.Fprintf(writer, "\n  %s\t%s\t%s\n", "Name", "Severity", "Package")
+	for i, f := range blocking {
+		if i == maxImageScanFindingsShown {
+			fmt.Fprintf(writer, "  ...and %d more\n", len(blocking)-maxImageScanFindingsShown)
+			break
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\n", f.Name, f.Severity, f.Package)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// pushedImages are the images that a deployment of a service built and pushed, so that its deployments
+// to other environments can copy them by digest instead of building them again.
+type pushedImages struct {
+	repo           imageBuilderPusher
+	digest         string
+	sidecarDigests map[string]string
+	inputs         map[string]dockerengine.BuildArguments // Build arguments of the images keyed by image name, without repository URIs.
+	signatureKey   string                                 // Key that signed the images, if they're signed.
+	scanned        bool                                   // True if the scan findings of the images were checked.
+}
+
+// reusableFor returns true if the images are built from the same inputs and signed with the same key.
+func (p *pushedImages) reusableFor(inputs map[string]dockerengine.BuildArguments, signatureKey string) bool {
+	return p != nil && p.signatureKey == signatureKey && reflect.DeepEqual(p.inputs, inputs)
+}
+
+// imageBuildInputs returns the build arguments of the main image, keyed by workload name, and of the sidecar images
+// without the repository URIs, which differ between the regions of environments.
+func imageBuildInputs(name string, mainArgs *dockerengine.BuildArguments, sidecarArgs map[string]*dockerengine.BuildArguments) map[string]dockerengine.BuildArguments {
+	inputs := make(map[string]dockerengine.BuildArguments)
+	if mainArgs != nil {
+		in := *mainArgs
+		in.URI = ""
+		inputs[name] = in
+	}
+	for sidecar, args := range sidecarArgs {
+		in := *args
+		in.URI = ""
+		inputs[sidecar] = in
+	}
+	return inputs
+}
+
+// copyImages adds the tags of the build arguments to the images pushed for another environment. Images in the
+// repository of the pusher are tagged in place, other images are copied by digest, along with their signatures.
+func copyImages(pusher imageBuilderPusher, signer imageSigner, src *pushedImages, svcName string,
+	mainArgs *dockerengine.BuildArguments, sidecarArgs map[string]*dockerengine.BuildArguments) error {
+	args := make(map[string]*dockerengine.BuildArguments)
+	if mainArgs != nil {
+		args[svcName] = mainArgs
+	}
+	for sidecar, sidecarArg := range sidecarArgs {
+		args[sidecar] = sidecarArg
+	}
+	digests := imageDigestsByName(svcName, src.digest, src.sidecarDigests)
+	names := make([]string, 0, len(digests))
+	for name := range digests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if src.repo.URI() == pusher.URI() {
+		for _, name := range names {
+			if err := pusher.Tag(digests[name], args[name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	docker := dockerengine.New(exec.NewCmd())
+	if err := src.repo.Login(docker); err != nil {
+		return err
+	}
+	for _, name := range names {
+		image := fmt.Sprintf("%s@%s", src.repo.URI(), digests[name])
+		if err := pusher.Copy(docker, image, args[name]); err != nil {
+			return err
+		}
+		if src.signatureKey != "" {
+			if err := signer.CopySignature(image, pusher.URI()); err != nil {
+				return err
+			}
+		}
+		log.Successf("Copied image %s to %s.\n", color.HighlightResource(image), color.HighlightResource(pusher.URI()))
+	}
+	return nil
+}
+
+// imageDigestsByName returns the digests of the main image, keyed by workload name, and of the sidecar images.
+func imageDigestsByName(name, digest string, sidecarDigests map[string]string) map[string]string {
+	digests := make(map[string]string)
+	if digest != "" {
+		digests[name] = digest
+	}
+	for sidecar, digest := range sidecarDigests {
+		digests[sidecar] = digest
+	}
+	return digests
+}
+
+// buildAndPushImages builds and pushes the image of the main container, if mainArgs isn't nil, and the images
+// of the sidecars concurrently. It returns the digest of the main image and the digests of the sidecar images keyed by sidecar name.
+func buildAndPushImages(pusher imageBuilderPusher, contentHash func(*dockerengine.BuildArguments) (string, error),
+	mainArgs *dockerengine.BuildArguments, sidecarArgs map[string]*dockerengine.BuildArguments, forceBuild bool) (string, map[string]string, error) {
+	var mainDigest string
+	var mu sync.Mutex
+	sidecarDigests := make(map[string]string)
+	var g errgroup.Group
+	if mainArgs != nil {
+		g.Go(func() error {
+			digest, err := buildAndPushImage(pusher, contentHash, mainArgs, forceBuild)
+			if err != nil {
+				return err
+			}
+			mainDigest = digest
+			return nil
+		})
+	}
+	for name, args := range sidecarArgs {
+		name, args := name, args
+		g.Go(func() error {
+			digest, err := buildAndPushImage(pusher, contentHash, args, forceBuild)
+			if err != nil {
+				return fmt.Errorf("sidecar %s: %w", name, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			sidecarDigests[name] = digest
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return "", nil, err
+	}
+	if len(sidecarDigests) == 0 {
+		sidecarDigests = nil
+	}
+	return mainDigest, sidecarDigests, nil
+}
+
+// contentHashTag returns the image tag that identifies an image by the content hash of its build inputs.
+func contentHashTag(hash string) string {
+	return fmt.Sprintf("%s%s", contentHashTagPrefix, hash)
+}
+
 func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interface{}) (*dockerengine.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
@@ -787,6 +1377,10 @@ func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interfa
 	if imageTag != "" {
 		tags = append(tags, imageTag)
 	}
+	var platforms []string
+	if mp, ok := unmarshaledManifest.(interface{ ContainerPlatforms() []string }); ok {
+		platforms = mp.ContainerPlatforms()
+	}
 	args := mf.BuildArgs(workspacePath)
 	return &dockerengine.BuildArguments{
 		Dockerfile: *args.Dockerfile,
@@ -795,10 +1389,81 @@ func buildArgs(name, imageTag, workspacePath string, unmarshaledManifest interfa
 		CacheFrom:  args.CacheFrom,
 		Target:     aws.StringValue(args.Target),
 		Platform:   mf.ContainerPlatform(),
+		Platforms:  platforms,
 		Tags:       tags,
 	}, nil
 }
 
+// sidecarBuildArgs returns the build arguments of the sidecars built from local Dockerfiles keyed by sidecar name.
+// Sidecar images are pushed to the repository of the workload with tags prefixed by the sidecar name.
+func sidecarBuildArgs(name, repoURI, imageTag, workspacePath string, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
+	type dfArgs interface {
+		SidecarBuildArgs(rootDirectory string) map[string]*manifest.DockerBuildArgs
+		ContainerPlatform() string
+	}
+	mf, ok := unmarshaledManifest.(dfArgs)
+	if !ok {
+		return nil, fmt.Errorf("%s does not have required methods SidecarBuildArgs() and ContainerPlatform()", name)
+	}
+	var platforms []string
+	if mp, ok := unmarshaledManifest.(interface{ ContainerPlatforms() []string }); ok {
+		platforms = mp.ContainerPlatforms()
+	}
+	buildArgs := make(map[string]*dockerengine.BuildArguments)
+	for sidecar, args := range mf.SidecarBuildArgs(workspacePath) {
+		var tags []string
+		if imageTag != "" {
+			tags = append(tags, sidecarImageTag(sidecar, imageTag))
+		}
+		buildArgs[sidecar] = &dockerengine.BuildArguments{
+			URI:        fmt.Sprintf("%s:%s", repoURI, sidecarImageTag(sidecar, "latest")),
+			Dockerfile: *args.Dockerfile,
+			Context:    *args.Context,
+			Args:       args.Args,
+			CacheFrom:  args.CacheFrom,
+			Target:     aws.StringValue(args.Target),
+			Platform:   mf.ContainerPlatform(),
+			Platforms:  platforms,
+			Tags:       tags,
+		}
+	}
+	return buildArgs, nil
+}
+
+// sidecarImageTag returns the tag of a sidecar image in the repository of its workload.
+func sidecarImageTag(sidecar, tag string) string {
+	return fmt.Sprintf("%s-%s", sidecar, tag)
+}
+
+// deployedEnvAddonsOutputs returns the outputs of the environment addons stack as they're exported by the deployed
+// environment stack, so that workloads only import values that exist regardless of the local environment addons.
+func deployedEnvAddonsOutputs(envStack envStackDescriber) (map[string]string, error) {
+	outputs, err := envStack.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of the environment stack: %w", err)
+	}
+	return stack.EnvAddonsOutputs(outputs), nil
+}
+
+// sidecarECRImages returns the locations of the pushed sidecar images keyed by sidecar name.
+func sidecarECRImages(repoURL, imageTag string, digests map[string]string) map[string]stack.ECRImage {
+	if len(digests) == 0 {
+		return nil
+	}
+	images := make(map[string]stack.ECRImage, len(digests))
+	for name, digest := range digests {
+		image := stack.ECRImage{
+			RepoURL: repoURL,
+			Digest:  digest,
+		}
+		if imageTag != "" {
+			image.ImageTag = sidecarImageTag(name, imageTag)
+		}
+		images[name] = image
+	}
+	return images
+}
+
 func envFile(unmarshaledManifest interface{}) string {
 	type envFile interface {
 		EnvFile() string
@@ -811,6 +1476,55 @@ func envFile(unmarshaledManifest interface{}) string {
 	return ""
 }
 
+// logInternetEgressWarning warns users when the workload needs to reach the internet from private subnets
+// but the environment only provides VPC endpoints to AWS services.
+func logInternetEgressWarning(name string, env *config.Environment, unmarshaledManifest interface{}) {
+	if env.CustomConfig == nil || !env.CustomConfig.VPCEndpoints {
+		return
+	}
+	type internetEgress interface {
+		InternetEgress() []string
+	}
+	mft, ok := unmarshaledManifest.(internetEgress)
+	if !ok {
+		return
+	}
+	reasons := mft.InternetEgress()
+	if len(reasons) == 0 {
+		return
+	}
+	log.Warningf(`Environment %s reaches AWS services through VPC endpoints instead of NAT gateways.
+%s is placed in private subnets but needs to reach the internet for:
+- %s
+`, env.Name, name, strings.Join(reasons, "\n- "))
+}
+
+// logWildcardIAMWarning warns users when the manifest grants wildcard actions, or actions on every resource,
+// to a workload deployed to a production environment.
+func logWildcardIAMWarning(name string, env *config.Environment, unmarshaledManifest interface{}) {
+	if !env.Prod {
+		return
+	}
+	type wildcardIAM interface {
+		WildcardIAMActions() []string
+		HasWildcardIAMResources() bool
+	}
+	mft, ok := unmarshaledManifest.(wildcardIAM)
+	if !ok {
+		return
+	}
+	if actions := mft.WildcardIAMActions(); len(actions) > 0 {
+		log.Warningf(`The manifest of %s allows the wildcard actions %s in production environment %s.
+Consider granting only the actions that %s needs.
+`, name, strings.Join(actions, ", "), env.Name, name)
+	}
+	if mft.HasWildcardIAMResources() {
+		log.Warningf(`The manifest of %s allows actions on every resource ("*") in production environment %s.
+Consider granting access only to the resources that %s needs.
+`, name, env.Name, name)
+	}
+}
+
 func validateLBSvcAliasAndAppVersion(svcName string, aliases manifest.Alias, app *config.Application, envName string, appVersionGetter versionGetter) error {
 	if aliases.IsEmpty() {
 		return nil
@@ -932,6 +1646,27 @@ func validateAppVersion(appName string, appVersionGetter versionGetter) error {
 	return nil
 }
 
+// validateEnvEventBusVersion returns an error if the workload publishes or subscribes to events on the environment's
+// event bus, but the environment stack is on a version that doesn't create the event bus yet.
+func validateEnvEventBusVersion(mft interface{}, envName string, envVersionGetter versionGetter) error {
+	type envEventBusUser interface {
+		UsesEnvEventBus() bool
+	}
+	wkld, ok := mft.(envEventBusUser)
+	if !ok || !wkld.UsesEnvEventBus() {
+		return nil
+	}
+	version, err := envVersionGetter.Version()
+	if err != nil {
+		return fmt.Errorf("get version of environment %s: %w", envName, err)
+	}
+	if semver.Compare(version, deploy.EventBusLeastEnvTemplateVersion) < 0 {
+		return fmt.Errorf("environment %s on version %s does not have an event bus: run `copilot env upgrade --name %s` to upgrade it to %s or later",
+			envName, version, envName, deploy.EventBusLeastEnvTemplateVersion)
+	}
+	return nil
+}
+
 func logAppVersionOutdatedError(name string) {
 	log.Errorf(`Cannot deploy service %s because the application version is incompatible.
 To upgrade the application, please run %s first (see https://aws.github.io/copilot-cli/docs/credentials/#application-credentials).
@@ -1083,7 +1818,7 @@ func (o *deploySvcOpts) buildWorkerQueueNames() string {
 
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deploySvcVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploys a service to an environment.",
@@ -1092,9 +1827,26 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Builds the images of a service named "api" once and deploys it to the "test", "staging" and "prod" environments in order.
+  /code $ copilot svc deploy -n api -e test,staging,prod --confirm-prod
+  Deploys a service named "api" to every environment, deploying to environments in different regions at the same time.
+  /code $ copilot svc deploy -n api --all-envs --parallel`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			opts, err := newSvcDeployOpts(vars)
+			if vars.isMultiEnv() {
+				opts, err := newDeploySvcEnvsOpts(vars)
+				if err != nil {
+					return err
+				}
+				return run(opts)
+			}
+			if vars.parallel {
+				return fmt.Errorf("--%s requires a list of environments or --%s", parallelFlag, allEnvsFlag)
+			}
+			if vars.confirmProd {
+				return fmt.Errorf("--%s requires a list of environments or --%s", confirmProdFlag, allEnvsFlag)
+			}
+			opts, err := newSvcDeployOpts(vars.deployWkldVars)
 			if err != nil {
 				return err
 			}
@@ -1103,10 +1855,16 @@ func buildSvcDeployCmd() *cobra.Command {
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
-	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", svcDeployEnvFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
+	cmd.Flags().BoolVar(&vars.forceBuild, forceBuildFlag, false, forceBuildFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipScan, skipScanFlag, false, skipScanFlagDescription)
+	cmd.Flags().BoolVar(&vars.allEnvs, allEnvsFlag, false, allEnvsFlagDescription)
+	cmd.Flags().BoolVar(&vars.parallel, parallelFlag, false, parallelFlagDescription)
+	cmd.Flags().BoolVar(&vars.confirmProd, confirmProdFlag, false, confirmProdFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFormat, outputFlag, textOutputFormat, outputFlagDescription)
 
 	return cmd
 }
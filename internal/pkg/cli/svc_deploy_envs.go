@@ -0,0 +1,275 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	fmtSvcDeployConfirmProdPrompt = "Are you sure you want to deploy service %s to production environment %s?"
+)
+
+type deploySvcVars struct {
+	deployWkldVars
+
+	allEnvs     bool
+	parallel    bool
+	confirmProd bool
+}
+
+// isMultiEnv returns true if the service is deployed to more than one environment.
+func (v deploySvcVars) isMultiEnv() bool {
+	return v.allEnvs || strings.Contains(v.envName, ",")
+}
+
+// svcEnvDeployer deploys a service to an environment and shares the images it pushed with the deployments
+// of the service to other environments.
+type svcEnvDeployer interface {
+	workloadDeployer
+	pushedImages() *pushedImages
+	reuseImages(images *pushedImages)
+}
+
+// deploySvcEnvsOpts deploys a service to several environments. The images of the service are built, scanned and signed once
+// for the first environment: environments in the same region re-tag them by digest, and other regions copy them by digest.
+type deploySvcEnvsOpts struct {
+	deploySvcVars
+
+	store          store
+	sel            wsSelector
+	prompt         prompter
+	newSvcDeployer func(vars deployWkldVars) (svcEnvDeployer, error)
+
+	// Cached variables.
+	envs []*config.Environment // Environments to deploy to, in order.
+}
+
+func newDeploySvcEnvsOpts(vars deploySvcVars) (*deploySvcEnvsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	prompter := prompt.New()
+	return &deploySvcEnvsOpts{
+		deploySvcVars: vars,
+		store:         store,
+		sel:           selector.NewWorkspaceSelect(prompter, store, ws),
+		prompt:        prompter,
+		newSvcDeployer: func(vars deployWkldVars) (svcEnvDeployer, error) {
+			return newSvcDeployOpts(vars)
+		},
+	}, nil
+}
+
+// Validate returns an error if the user inputs are invalid.
+func (o *deploySvcEnvsOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.allEnvs && o.envName != "" {
+		return fmt.Errorf("cannot specify both --%s and --%s flags", allEnvsFlag, envFlag)
+	}
+	if o.outputFormat != "" {
+		if err := validateOutputFormat(o.outputFormat); err != nil {
+			return err
+		}
+	}
+	if o.allEnvs {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(o.envName, ",") {
+		if name == "" {
+			return fmt.Errorf("environment names in --%s must not be empty", envFlag)
+		}
+		if seen[name] {
+			return fmt.Errorf("environment %s is specified more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *deploySvcEnvsOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Service("Select a service in your workspace", "")
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute deploys the service to each environment. Environments are deployed one after another, or one after another
+// within each region with --parallel. A failed deployment skips the environments that come after it.
+func (o *deploySvcEnvsOpts) Execute() error {
+	if err := o.loadEnvs(); err != nil {
+		return err
+	}
+	deployers := make(map[string]svcEnvDeployer, len(o.envs))
+	names := make([]string, len(o.envs))
+	for i, env := range o.envs {
+		vars := o.deployWkldVars
+		vars.envName = env.Name
+		deployer, err := o.newSvcDeployer(vars)
+		if err != nil {
+			return err
+		}
+		if err := deployer.Validate(); err != nil {
+			return fmt.Errorf("validate deployment to environment %s: %w", env.Name, err)
+		}
+		deployers[env.Name] = deployer
+		names[i] = env.Name
+	}
+
+	first := names[0]
+	// images are the first images pushed, which the uploads to the other environments copy. With --parallel,
+	// only the upload to the first environment sets them, before the environments are deployed concurrently.
+	var images *pushedImages
+	upload := func(name string) error {
+		if images != nil {
+			deployers[name].reuseImages(images)
+		}
+		if err := deployers[name].uploadArtifacts(); err != nil {
+			return err
+		}
+		if images == nil && (!o.parallel || name == first) {
+			images = deployers[name].pushedImages()
+		}
+		return nil
+	}
+	out := progressWriter(o.outputFormat, o.name)
+	envOut := progressWriters(out, o.parallel)
+	if o.parallel {
+		// Confirm up front as the prompts can't be answered while other environments are deploying.
+		for _, env := range o.envs {
+			if err := o.confirmDeploy(env); err != nil {
+				return err
+			}
+		}
+		// Build the images before deploying to environments concurrently so that they're only built once.
+		if err := upload(first); err != nil {
+			return fmt.Errorf("upload artifacts for environment %s: %w", first, err)
+		}
+	}
+	envsByName := make(map[string]*config.Environment, len(o.envs))
+	for _, env := range o.envs {
+		envsByName[env.Name] = env
+	}
+	errs := o.deploymentOrder().Traverse(0, func(name string) error {
+		if !o.parallel {
+			if err := o.confirmDeploy(envsByName[name]); err != nil {
+				return err
+			}
+		}
+		if !o.parallel || name != first {
+			if err := upload(name); err != nil {
+				log.Errorf("Failed to upload the artifacts of service %s for environment %s: %v\n", color.HighlightUserInput(o.name), color.HighlightUserInput(name), err)
+				return fmt.Errorf("upload artifacts: %w", err)
+			}
+		}
+		log.Infof("Deploying service %s to environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(name))
+		if err := deployers[name].deployWorkload(envOut(name)); err != nil {
+			log.Errorf("Failed to deploy service %s to environment %s: %v\n", color.HighlightUserInput(o.name), color.HighlightUserInput(name), err)
+			return err
+		}
+		return nil
+	})
+	logDeploySummary(names, errs)
+	for _, name := range names {
+		writeResultEvent(out, deployResultEvent(o.appName, name, o.name, errs[name]))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("service %s was not deployed to %d of %d environments", o.name, len(errs), len(names))
+	}
+	return nil
+}
+
+// RecommendActions is a no-op: the summary of Execute lists the outcome of each environment.
+func (o *deploySvcEnvsOpts) RecommendActions() error {
+	return nil
+}
+
+// loadEnvs retrieves the environments to deploy to. With --all-envs, the production environments are deployed last.
+func (o *deploySvcEnvsOpts) loadEnvs() error {
+	if !o.allEnvs {
+		for _, name := range strings.Split(o.envName, ",") {
+			env, err := targetEnv(o.store, o.appName, name)
+			if err != nil {
+				return err
+			}
+			o.envs = append(o.envs, env)
+		}
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	if len(envs) == 0 {
+		return fmt.Errorf("no environments found in application %s", o.appName)
+	}
+	var prodEnvs []*config.Environment
+	for _, env := range envs {
+		if env.Prod {
+			prodEnvs = append(prodEnvs, env)
+			continue
+		}
+		o.envs = append(o.envs, env)
+	}
+	o.envs = append(o.envs, prodEnvs...)
+	return nil
+}
+
+// deploymentOrder returns the graph with an edge from every environment to the next one to deploy to.
+// With --parallel, only environments in the same region are chained.
+func (o *deploySvcEnvsOpts) deploymentOrder() *graph.Graph {
+	names := make([]string, len(o.envs))
+	for i, env := range o.envs {
+		names[i] = env.Name
+	}
+	order := graph.New(names...)
+	for i, env := range o.envs {
+		for j := i - 1; j >= 0; j-- {
+			if o.parallel && o.envs[j].Region != env.Region {
+				continue
+			}
+			order.Add(graph.Edge{From: o.envs[j].Name, To: env.Name})
+			break
+		}
+	}
+	return order
+}
+
+// confirmDeploy prompts the user to confirm the deployment to a production environment if --confirm-prod is set.
+func (o *deploySvcEnvsOpts) confirmDeploy(env *config.Environment) error {
+	if !o.confirmProd || !env.Prod {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcDeployConfirmProdPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name)), "")
+	if err != nil {
+		return fmt.Errorf("confirm deployment to environment %s: %w", env.Name, err)
+	}
+	if !confirmed {
+		return fmt.Errorf("deployment to production environment %s was not confirmed", env.Name)
+	}
+	return nil
+}
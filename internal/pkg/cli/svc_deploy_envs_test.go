@@ -0,0 +1,325 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploySvcEnvsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+		inAllEnvs bool
+
+		wantedErr string
+	}{
+		"error if no app in the workspace": {
+			inEnvName: "test,prod",
+
+			wantedErr: errNoAppInWorkspace.Error(),
+		},
+		"error if both a list of environments and --all-envs are specified": {
+			inAppName: "phonetool",
+			inEnvName: "test,prod",
+			inAllEnvs: true,
+
+			wantedErr: "cannot specify both --all-envs and --env flags",
+		},
+		"error if an environment name is empty": {
+			inAppName: "phonetool",
+			inEnvName: "test,,prod",
+
+			wantedErr: "environment names in --env must not be empty",
+		},
+		"error if an environment is specified twice": {
+			inAppName: "phonetool",
+			inEnvName: "test,prod,test",
+
+			wantedErr: "environment test is specified more than once",
+		},
+		"success with a list of environments": {
+			inAppName: "phonetool",
+			inEnvName: "test,prod",
+		},
+		"success with --all-envs": {
+			inAppName: "phonetool",
+			inAllEnvs: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &deploySvcEnvsOpts{
+				deploySvcVars: deploySvcVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						envName: tc.inEnvName,
+					},
+					allEnvs: tc.inAllEnvs,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeploySvcEnvsOpts_Execute(t *testing.T) {
+	mockEnvs := []*config.Environment{
+		{Name: "prod", Region: "us-east-1", Prod: true},
+		{Name: "staging", Region: "us-west-2"},
+		{Name: "test", Region: "us-east-1"},
+	}
+	testCases := map[string]struct {
+		inEnvName     string
+		inAllEnvs     bool
+		inParallel    bool
+		inConfirmProd bool
+		inDeployErrs  map[string]error
+
+		setupMocks func(s *mocks.Mockstore, p *mocks.Mockprompter)
+
+		wantedUploaded []string
+		wantedDeployed []string
+		wantedReused   map[string]string // Environment to the environment whose images it copies.
+		wantedErr      string
+	}{
+		"deploys to each environment in order": {
+			inEnvName: "test,staging",
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "staging").Return(mockEnvs[1], nil)
+			},
+
+			wantedUploaded: []string{"test", "staging"},
+			wantedDeployed: []string{"test", "staging"},
+			wantedReused: map[string]string{
+				"staging": "test",
+			},
+		},
+		"deploys to production environments last with --all-envs": {
+			inAllEnvs: true,
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().ListEnvironments("phonetool").Return(mockEnvs, nil)
+			},
+
+			wantedUploaded: []string{"staging", "test", "prod"},
+			wantedDeployed: []string{"staging", "test", "prod"},
+			wantedReused: map[string]string{
+				"test": "staging",
+				"prod": "staging",
+			},
+		},
+		"builds the images for the first environment only with --parallel": {
+			inEnvName:  "test,staging",
+			inParallel: true,
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "staging").Return(mockEnvs[1], nil)
+			},
+
+			wantedUploaded: []string{"test", "staging"},
+			wantedDeployed: []string{"test", "staging"},
+			wantedReused: map[string]string{
+				"staging": "test",
+			},
+		},
+		"error if there are no environments with --all-envs": {
+			inAllEnvs: true,
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+
+			wantedErr: "no environments found in application phonetool",
+		},
+		"error if an environment doesn't exist": {
+			inEnvName: "test,qa",
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "qa").Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: "get environment qa configuration: some error",
+		},
+		"skips the environments after a failed deployment": {
+			inEnvName: "test,staging,prod",
+			inDeployErrs: map[string]error{
+				"test": errors.New("some error"),
+			},
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "staging").Return(mockEnvs[1], nil)
+				s.EXPECT().GetEnvironment("phonetool", "prod").Return(mockEnvs[0], nil)
+			},
+
+			wantedUploaded: []string{"test"},
+			wantedDeployed: []string{"test"},
+			wantedErr:      "service api was not deployed to 3 of 3 environments",
+		},
+		"stops before a production environment that isn't confirmed": {
+			inEnvName:     "test,prod",
+			inConfirmProd: true,
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "prod").Return(mockEnvs[0], nil)
+				p.EXPECT().Confirm(gomock.Any(), "").Return(false, nil)
+			},
+
+			wantedUploaded: []string{"test"},
+			wantedDeployed: []string{"test"},
+			wantedErr:      "service api was not deployed to 1 of 2 environments",
+		},
+		"confirms production environments up front with --parallel": {
+			inEnvName:     "test,staging,prod",
+			inParallel:    true,
+			inConfirmProd: true,
+			setupMocks: func(s *mocks.Mockstore, p *mocks.Mockprompter) {
+				s.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnvs[2], nil)
+				s.EXPECT().GetEnvironment("phonetool", "staging").Return(mockEnvs[1], nil)
+				s.EXPECT().GetEnvironment("phonetool", "prod").Return(mockEnvs[0], nil)
+				p.EXPECT().Confirm(gomock.Any(), "").Return(false, nil)
+			},
+
+			wantedErr: "deployment to production environment prod was not confirmed",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompter := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockStore, mockPrompter)
+			calls := &deployAllCalls{}
+			reused := &reusedImages{}
+			opts := &deploySvcEnvsOpts{
+				deploySvcVars: deploySvcVars{
+					deployWkldVars: deployWkldVars{
+						appName: "phonetool",
+						name:    "api",
+						envName: tc.inEnvName,
+					},
+					allEnvs:     tc.inAllEnvs,
+					parallel:    tc.inParallel,
+					confirmProd: tc.inConfirmProd,
+				},
+				store:  mockStore,
+				prompt: mockPrompter,
+				newSvcDeployer: func(vars deployWkldVars) (svcEnvDeployer, error) {
+					return &fakeSvcEnvDeployer{
+						fakeWorkloadDeployer: &fakeWorkloadDeployer{
+							name:      vars.envName,
+							deployErr: tc.inDeployErrs[vars.envName],
+							calls:     calls,
+						},
+						reused: reused,
+					}, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedUploaded, calls.uploaded)
+			require.Equal(t, tc.wantedDeployed, calls.deployed)
+			require.Equal(t, tc.wantedReused, reused.from)
+		})
+	}
+}
+
+// fakeSvcEnvDeployer pushes images identified by the name of its environment.
+type fakeSvcEnvDeployer struct {
+	*fakeWorkloadDeployer
+	reused *reusedImages
+}
+
+type reusedImages struct {
+	mu   sync.Mutex
+	from map[string]string
+}
+
+func (d *fakeSvcEnvDeployer) pushedImages() *pushedImages {
+	return &pushedImages{digest: d.name}
+}
+
+func (d *fakeSvcEnvDeployer) reuseImages(images *pushedImages) {
+	d.reused.mu.Lock()
+	defer d.reused.mu.Unlock()
+	if d.reused.from == nil {
+		d.reused.from = make(map[string]string)
+	}
+	d.reused.from[d.name] = images.digest
+}
+
+func TestDeploySvcEnvsOpts_deploymentOrder(t *testing.T) {
+	envs := []*config.Environment{
+		{Name: "test", Region: "us-east-1"},
+		{Name: "staging", Region: "us-west-2"},
+		{Name: "prod-east", Region: "us-east-1"},
+		{Name: "prod-west", Region: "us-west-2"},
+	}
+	testCases := map[string]struct {
+		inParallel bool
+
+		wantedSkipped []string
+	}{
+		"chains every environment": {
+			wantedSkipped: []string{"prod-east", "prod-west", "staging"},
+		},
+		"chains environments in the same region with --parallel": {
+			inParallel:    true,
+			wantedSkipped: []string{"prod-east"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &deploySvcEnvsOpts{
+				deploySvcVars: deploySvcVars{parallel: tc.inParallel},
+				envs:          envs,
+			}
+
+			// WHEN
+			errs := opts.deploymentOrder().Traverse(1, func(name string) error {
+				if name == "test" {
+					return errors.New("some error")
+				}
+				return nil
+			})
+
+			// THEN
+			var skipped []string
+			for name := range errs {
+				if name != "test" {
+					skipped = append(skipped, name)
+				}
+			}
+			require.ElementsMatch(t, tc.wantedSkipped, skipped)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -37,7 +39,7 @@ type deploySvcMocks struct {
 	mockimageBuilderPusher *mocks.MockimageBuilderPusher
 	mockAppResourcesGetter *mocks.MockappResourcesGetter
 	mockAppVersionGetter   *mocks.MockversionGetter
-	mockEndpointGetter     *mocks.MockendpointGetter
+	mockEnvStack           *mocks.MockenvStackDescriber
 	mockServiceDeployer    *mocks.MockserviceDeployer
 	mockSpinner            *mocks.Mockprogress
 	mockServiceUpdater     *mocks.MocksvcForceUpdater
@@ -48,6 +50,9 @@ type deploySvcMocks struct {
 	mockS3Svc              *mocks.Mockuploader
 	mockAddons             *mocks.Mocktemplater
 	mockIdentity           *mocks.MockidentityService
+	mockImageScanner       *mocks.MockimageScanner
+	mockImageSigner        *mocks.MockimageSigner
+	mockSrcImagePusher     *mocks.MockimageBuilderPusher
 }
 
 type mockWorkloadMft struct {
@@ -60,9 +65,10 @@ func (m *mockWorkloadMft) EnvFile() string {
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName string
-		inEnvName string
-		inSvcName string
+		inAppName      string
+		inEnvName      string
+		inSvcName      string
+		inOutputFormat string
 
 		mockWs    func(m *mocks.MockwsSvcDirReader)
 		mockStore func(m *mocks.Mockstore)
@@ -106,10 +112,19 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("get environment test configuration: unknown env"),
 		},
+		"with unknown output format": {
+			inAppName:      "phonetool",
+			inOutputFormat: "yaml",
+			mockWs:         func(m *mocks.MockwsSvcDirReader) {},
+			mockStore:      func(m *mocks.Mockstore) {},
+
+			wantedError: errors.New("invalid --output yaml: must be one of text or json"),
+		},
 		"successful validation": {
-			inAppName: "phonetool",
-			inSvcName: "frontend",
-			inEnvName: "test",
+			inAppName:      "phonetool",
+			inSvcName:      "frontend",
+			inEnvName:      "test",
+			inOutputFormat: "json",
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ListServices().Return([]string{"frontend"}, nil)
 			},
@@ -132,9 +147,10 @@ func TestSvcDeployOpts_Validate(t *testing.T) {
 			tc.mockStore(mockStore)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inSvcName,
-					envName: tc.inEnvName,
+					appName:      tc.inAppName,
+					name:         tc.inSvcName,
+					envName:      tc.inEnvName,
+					outputFormat: tc.inOutputFormat,
 				},
 				ws:    mockWs,
 				store: mockStore,
@@ -256,6 +272,29 @@ image:
     dockerfile: path/to/Dockerfile
     context: path
   port: 80
+`)
+	mockManifestWithPlatformList := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+platform: [linux/arm64, linux/amd64]
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    context: path
+  port: 80
+`)
+	mockMftWithSidecars := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: nginx
+  port: 80
+sidecars:
+  logshipper:
+    build: sidecars/logshipper/Dockerfile
+  authproxy:
+    build:
+      context: sidecars/authproxy
+  xray:
+    image: public.ecr.aws/xray/aws-xray-daemon
 `)
 	mockMftNoBuild := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
@@ -275,13 +314,75 @@ image:
   build:
     dockerfile: path/to/Dockerfile
   port: 80`)
+	mockMftWithRegistry := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  registry:
+    uri: ghcr.io/org/repo
+    credentials: arn:aws:secretsmanager:us-west-2:123456789012:secret:ghcr-creds
+  port: 80
+`)
+	mockMftWithScan := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  scan:
+    on_push: true
+    fail_on: high
+  port: 80
+`)
+	mockMftWithScanNotOnPush := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  scan:
+    on_push: false
+  port: 80
+`)
+	mockMftWithSignedLocation := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49
+  signature:
+    key: keys/cosign.pub
+  port: 80
+`)
+	mockMftWithSignedBuild := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  signature:
+    key: arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab
+  port: 80
+`)
+	mockBuildStringArgs := dockerengine.BuildArguments{
+		Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+		Context:    filepath.Join("/ws", "root", "path", "to"),
+	}
+	mockBuildAndPush := func(m deploySvcMocks) *gomock.Call {
+		return m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+			Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+			Context:    filepath.Join("/ws", "root", "path", "to"),
+			Tags:       []string{"content-mockHash"},
+		}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil)
+	}
 
 	tests := map[string]struct {
-		inputSvc   string
-		setupMocks func(mocks deploySvcMocks)
-
-		wantErr      error
-		wantedDigest string
+		inputSvc          string
+		inForceBuild      bool
+		inSkipScan        bool
+		inProdEnv         bool
+		inAppSignatureKey string
+		inSrcImages       func(mocks deploySvcMocks) *pushedImages
+		setupMocks        func(mocks deploySvcMocks)
+
+		wantErr              error
+		wantedDigest         string
+		wantedSidecarDigests map[string]string
+		wantedRegistryURI    string
+		wantedVerifiedImage  *stack.ECRImage
+		wantedPinDigests     bool
 	}{
 		"should return error if ws ReadFile returns error": {
 			inputSvc: "serviceA",
@@ -330,15 +431,36 @@ image:
 					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifestWithGoodPlatform, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockManifestWithGoodPlatform)).Return(string(mockManifestWithGoodPlatform), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
 						Platform:   "linux/amd64",
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"success with a multi-architecture image": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifestWithPlatformList, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifestWithPlatformList)).Return(string(mockManifestWithPlatformList), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+						Platform:   "linux/arm64",
+						Platforms:  []string{"linux/arm64", "linux/amd64"},
+						Tags:       []string{"content-mockHash"},
+					}).Return("sha256:a7f5d2b8e9c14f8e4a3c5b6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f", nil),
+				)
+			},
+			wantedDigest: "sha256:a7f5d2b8e9c14f8e4a3c5b6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f",
+		},
 		"success without building and pushing": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -357,11 +479,449 @@ image:
 					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifest, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("", mockError),
 				)
 			},
 			wantErr: fmt.Errorf("build and push image: mockError"),
 		},
+		"should return error if fail to get the image with the same content hash": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", mockError),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: mockError,
+		},
+		"should reuse the image with the same content hash": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().Tag("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+					}).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should build and push without checking the content hash if forced": {
+			inputSvc:     "serviceA",
+			inForceBuild: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifest, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest(gomock.Any()).Times(0),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"success with sidecars built from local Dockerfiles": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSidecars, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSidecars)).Return(string(mockMftWithSidecars), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+				)
+				m.mockimageBuilderPusher.EXPECT().URI().Return("mockRepoURI")
+				m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil).Times(2)
+				m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        "mockRepoURI:logshipper-latest",
+					Dockerfile: filepath.Join("/ws", "root", "sidecars", "logshipper", "Dockerfile"),
+					Context:    filepath.Join("/ws", "root", "sidecars", "logshipper"),
+					Tags:       []string{"content-mockHash"},
+				}).Return("sha256:logshipper", nil)
+				m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        "mockRepoURI:authproxy-latest",
+					Dockerfile: filepath.Join("/ws", "root", "sidecars", "authproxy", "Dockerfile"),
+					Context:    filepath.Join("/ws", "root", "sidecars", "authproxy"),
+					Tags:       []string{"content-mockHash"},
+				}).Return("sha256:authproxy", nil)
+			},
+			wantedSidecarDigests: map[string]string{
+				"logshipper": "sha256:logshipper",
+				"authproxy":  "sha256:authproxy",
+			},
+		},
+		"should return error if fail to build and push a sidecar image": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSidecars, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSidecars)).Return(string(mockMftWithSidecars), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+				)
+				m.mockimageBuilderPusher.EXPECT().URI().Return("mockRepoURI")
+				m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil).Times(2)
+				m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, args *dockerengine.BuildArguments) (string, error) {
+						if args.URI == "mockRepoURI:authproxy-latest" {
+							return "", mockError
+						}
+						return "sha256:logshipper", nil
+					}).Times(2)
+			},
+			wantErr: errors.New("sidecar authproxy: build and push image: mockError"),
+		},
+		"success with a third-party registry": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithRegistry, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithRegistry)).Return(string(mockMftWithRegistry), nil),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("ghcr.io/org/repo"),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Tags:       []string{"content-mockHash"},
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest:      "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wantedRegistryURI: "ghcr.io/org/repo",
+		},
+		"should refuse an image location without a valid signature in a prod environment": {
+			inputSvc:  "serviceA",
+			inProdEnv: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedLocation, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedLocation)).Return(string(mockMftWithSignedLocation), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageSigner.EXPECT().Verify("public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+						filepath.Join("/ws", "root", "keys", "cosign.pub")).Return("", mockError),
+				)
+			},
+			wantErr: errors.New("refuse to deploy image public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49 without a valid signature to production environment test: mockError"),
+		},
+		"should deploy an image location without a valid signature in a non-prod environment": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedLocation, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedLocation)).Return(string(mockMftWithSignedLocation), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageSigner.EXPECT().Verify(gomock.Any(), gomock.Any()).Return("", mockError),
+				)
+			},
+		},
+		"success verifying a signed image location in a prod environment": {
+			inputSvc:  "serviceA",
+			inProdEnv: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedLocation, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedLocation)).Return(string(mockMftWithSignedLocation), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageSigner.EXPECT().Verify(gomock.Any(), gomock.Any()).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedVerifiedImage: &stack.ECRImage{
+				RepoURL: "public.ecr.aws/nginx/nginx",
+				Digest:  "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			},
+		},
+		"should verify an image location with the application key instead of the manifest key in a prod environment": {
+			inputSvc:          "serviceA",
+			inProdEnv:         true,
+			inAppSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/app",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedLocation, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedLocation)).Return(string(mockMftWithSignedLocation), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageSigner.EXPECT().Verify("public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+						"arn:aws:kms:us-west-2:123456789012:key/app").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedVerifiedImage: &stack.ECRImage{
+				RepoURL: "public.ecr.aws/nginx/nginx",
+				Digest:  "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			},
+		},
+		"should ignore the application key in a non-prod environment": {
+			inputSvc:          "serviceA",
+			inAppSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/app",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should refuse a built image that isn't signed with the application key in a prod environment": {
+			inputSvc:          "serviceA",
+			inProdEnv:         true,
+			inAppSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/app",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Verify("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+						"arn:aws:kms:us-west-2:123456789012:key/app").Return("", mockError),
+				)
+			},
+			wantErr: errors.New("refuse to deploy image 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49 without a valid signature to production environment test: mockError"),
+		},
+		"success deploying a built image verified with the application key by digest in a prod environment": {
+			inputSvc:          "serviceA",
+			inProdEnv:         true,
+			inAppSignatureKey: "arn:aws:kms:us-west-2:123456789012:key/app",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Verify(gomock.Any(), "arn:aws:kms:us-west-2:123456789012:key/app").
+						Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest:     "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wantedPinDigests: true,
+		},
+		"should return error if fail to sign the pushed image": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(mockError),
+				)
+			},
+			wantErr: mockError,
+		},
+		"success signing the pushed image with the KMS key": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockimageBuilderPusher.EXPECT().URI().Return("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea"),
+					m.mockImageSigner.EXPECT().Sign("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/servicea@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+						"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab").Return(nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should return error if fail to enable scan on push": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(mockError),
+				)
+			},
+			wantErr: mockError,
+		},
+		"should return error if skipping the scan in a prod environment": {
+			inputSvc:   "serviceA",
+			inSkipScan: true,
+			inProdEnv:  true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(nil),
+				)
+			},
+			wantErr: errors.New("flag --skip-scan is not allowed for production environment test"),
+		},
+		"should return error if the scan finds vulnerabilities at or above the threshold": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockImageScanner.EXPECT().ImageScanFindings("phonetool/serviceA", "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49").Return(&ecr.ImageScanFindings{
+						SeverityCounts: map[string]int64{
+							"CRITICAL": 1,
+							"LOW":      3,
+						},
+						Findings: []ecr.ImageScanFinding{
+							{
+								Name:     "CVE-2021-3711",
+								Severity: "CRITICAL",
+								Package:  "openssl 1.1.1k-1",
+							},
+						},
+					}, nil),
+				)
+			},
+			wantErr: errors.New("abort the deployment: the scan of image serviceA found vulnerabilities at or above HIGH severity"),
+		},
+		"success if the scan finds vulnerabilities below the threshold": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+					m.mockImageScanner.EXPECT().ImageScanFindings("phonetool/serviceA", "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49").Return(&ecr.ImageScanFindings{
+						SeverityCounts: map[string]int64{
+							"MEDIUM": 2,
+						},
+					}, nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"don't turn on scan on push if the manifest doesn't scan on push": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScanNotOnPush, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScanNotOnPush)).Return(string(mockMftWithScanNotOnPush), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+				)
+				m.mockImageScanner.EXPECT().EnableScanOnPush(gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"success without waiting for the scan if it's skipped": {
+			inputSvc:   "serviceA",
+			inSkipScan: true,
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"tag the images pushed for another environment in the same repository without scanning them again": {
+			inputSvc: "serviceA",
+			inSrcImages: func(m deploySvcMocks) *pushedImages {
+				return &pushedImages{
+					repo:    m.mockimageBuilderPusher,
+					digest:  "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+					inputs:  map[string]dockerengine.BuildArguments{"serviceA": mockBuildStringArgs},
+					scanned: true,
+				}
+			},
+			setupMocks: func(m deploySvcMocks) {
+				m.mockimageBuilderPusher.EXPECT().URI().Return("mockRepoURI").AnyTimes()
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithScan, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithScan)).Return(string(mockMftWithScan), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockImageScanner.EXPECT().EnableScanOnPush("phonetool/serviceA").Return(nil),
+					m.mockimageBuilderPusher.EXPECT().Tag("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", &mockBuildStringArgs).Return(nil),
+				)
+				m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+				m.mockImageScanner.EXPECT().ImageScanFindings(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"copy the images pushed for another region with their signatures without signing them again": {
+			inputSvc: "serviceA",
+			inSrcImages: func(m deploySvcMocks) *pushedImages {
+				return &pushedImages{
+					repo:         m.mockSrcImagePusher,
+					digest:       "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+					inputs:       map[string]dockerengine.BuildArguments{"serviceA": mockBuildStringArgs},
+					signatureKey: "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				}
+			},
+			setupMocks: func(m deploySvcMocks) {
+				m.mockimageBuilderPusher.EXPECT().URI().Return("mockRepoURI").AnyTimes()
+				m.mockSrcImagePusher.EXPECT().URI().Return("mockSrcRepoURI").AnyTimes()
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftWithSignedBuild, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftWithSignedBuild)).Return(string(mockMftWithSignedBuild), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockSrcImagePusher.EXPECT().Login(gomock.Any()).Return(nil),
+					m.mockimageBuilderPusher.EXPECT().Copy(gomock.Any(), "mockSrcRepoURI@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", &mockBuildStringArgs).Return(nil),
+					m.mockImageSigner.EXPECT().CopySignature("mockSrcRepoURI@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", "mockRepoURI").Return(nil),
+				)
+				m.mockImageSigner.EXPECT().Sign(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"build the images if the images pushed for another environment are built from different inputs": {
+			inputSvc: "serviceA",
+			inSrcImages: func(m deploySvcMocks) *pushedImages {
+				return &pushedImages{
+					repo:   m.mockimageBuilderPusher,
+					digest: "sha256:1234",
+					inputs: map[string]dockerengine.BuildArguments{
+						"serviceA": {
+							Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+							Context:    filepath.Join("/ws", "root", "path", "to"),
+							Target:     "debug",
+						},
+					},
+				}
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftBuildString, nil),
+					m.mockInterpolator.EXPECT().Interpolate(string(mockMftBuildString)).Return(string(mockMftBuildString), nil),
+					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
+					mockBuildAndPush(m),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"success": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -369,9 +929,11 @@ image:
 					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockManifest, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockManifest)).Return(string(mockManifest), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -384,9 +946,11 @@ image:
 					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftBuildString, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockMftBuildString)).Return(string(mockMftBuildString), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -399,9 +963,11 @@ image:
 					m.mockWs.EXPECT().ReadWorkloadManifest("serviceA").Return(mockMftNoContext, nil),
 					m.mockInterpolator.EXPECT().Interpolate(string(mockMftNoContext)).Return(string(mockMftNoContext), nil),
 					m.mockWs.EXPECT().Path().Return("/ws/root", nil),
+					m.mockimageBuilderPusher.EXPECT().Digest("content-mockHash").Return("", nil),
 					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
 						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
 						Context:    filepath.Join("/ws", "root", "path", "to"),
+						Tags:       []string{"content-mockHash"},
 					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
 				)
 			},
@@ -417,19 +983,48 @@ image:
 			mockWorkspace := mocks.NewMockwsSvcDirReader(ctrl)
 			mockimageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
 			mockInterpolator := mocks.NewMockinterpolator(ctrl)
+			mockImageScanner := mocks.NewMockimageScanner(ctrl)
+			mockImageSigner := mocks.NewMockimageSigner(ctrl)
 			mocks := deploySvcMocks{
 				mockWs:                 mockWorkspace,
 				mockimageBuilderPusher: mockimageBuilderPusher,
 				mockInterpolator:       mockInterpolator,
+				mockImageScanner:       mockImageScanner,
+				mockImageSigner:        mockImageSigner,
+				mockSrcImagePusher:     mocks.NewMockimageBuilderPusher(ctrl),
 			}
 			test.setupMocks(mocks)
+			var srcImages *pushedImages
+			if test.inSrcImages != nil {
+				srcImages = test.inSrcImages(mocks)
+			}
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					appName:    "phonetool",
+					name:       test.inputSvc,
+					forceBuild: test.inForceBuild,
+					skipScan:   test.inSkipScan,
+				},
+				targetApp: &config.Application{
+					Name:         "phonetool",
+					SignatureKey: test.inAppSignatureKey,
+				},
+				targetEnvironment: &config.Environment{
+					Name: "test",
+					Prod: test.inProdEnv,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
-				ws:                 mockWorkspace,
+				imageScanner:       mockImageScanner,
+				imageSigner:        mockImageSigner,
+				srcImages:          srcImages,
+				newRegistryPusher: func(registry *manifest.ImageRegistry) (imageBuilderPusher, error) {
+					return mockimageBuilderPusher, nil
+				},
+				contentHash: func(*dockerengine.BuildArguments) (string, error) {
+					return "mockHash", nil
+				},
+				ws: mockWorkspace,
 				newInterpolator: func(app, env string) interpolator {
 					return mockInterpolator
 				},
@@ -442,6 +1037,10 @@ image:
 			} else {
 				require.NoError(t, gotErr)
 				require.Equal(t, test.wantedDigest, opts.imageDigest)
+				require.Equal(t, test.wantedSidecarDigests, opts.sidecarImageDigests)
+				require.Equal(t, test.wantedRegistryURI, opts.registryURI)
+				require.Equal(t, test.wantedVerifiedImage, opts.verifiedImage)
+				require.Equal(t, test.wantedPinDigests, opts.pinImageDigests)
 			}
 		})
 	}
@@ -643,7 +1242,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockEnvDescriber.EXPECT().Describe().Return(nil, errors.New("some error"))
 			},
 			wantErr: fmt.Errorf("describe environment mockEnv: some error"),
@@ -663,7 +1263,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockEnvDescriber.EXPECT().Describe().Return(&describe.EnvDescription{
 					EnvironmentVPC: describe.EnvironmentVPC{
 						ID: "mockVPCID",
@@ -688,7 +1289,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockAppResourcesGetter.EXPECT().GetAppResourcesByRegion(&config.Application{
 					Name: mockAppName,
 				}, "us-west-2").Return(nil, mockError)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf("get application %s resources from region us-west-2: %w", mockAppName, mockError),
 		},
@@ -704,7 +1306,31 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
+			},
+			wantErr: errors.New("alias specified when application is not associated with a domain"),
+		},
+		"nlb alias used while app is not associated with a domain even if env has imported certificates": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:    aws.String("443/tls"),
+				Aliases: manifest.Alias{String: aws.String("mockAlias")},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: errors.New("alias specified when application is not associated with a domain"),
 		},
@@ -727,7 +1353,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				}, "us-west-2").Return(&stack.AppRegionalResources{
 					RepositoryURLs: map[string]string{},
 				}, nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf("ECR repository not found for service mockSvc in region us-west-2 and account 1234567890"),
 		},
@@ -745,7 +1372,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("", mockError)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf("get version for app %s: %w", mockAppName, mockError),
 		},
@@ -763,7 +1391,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v0.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf("alias is not compatible with application versions below %s", deploy.AliasLeastAppTemplateVersion),
 		},
@@ -784,7 +1413,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v0.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf("alias is not compatible with application versions below %s", deploy.AliasLeastAppTemplateVersion),
 		},
@@ -802,7 +1432,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
@@ -823,7 +1454,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
@@ -839,7 +1471,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -859,7 +1492,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -880,7 +1514,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -904,7 +1539,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -927,7 +1563,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(cloudformation.NewMockErrChangeSetEmpty())
 				m.mockServiceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockSvcName).
@@ -954,7 +1591,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -991,13 +1629,34 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with alias served by imported certificates": {
+			inAliases: manifest.Alias{String: aws.String("api.example.com")},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
 		"success with force update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -1011,7 +1670,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1035,7 +1695,7 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				mockWs:                 mocks.NewMockwsSvcDirReader(ctrl),
 				mockAppResourcesGetter: mocks.NewMockappResourcesGetter(ctrl),
 				mockAppVersionGetter:   mocks.NewMockversionGetter(ctrl),
-				mockEndpointGetter:     mocks.NewMockendpointGetter(ctrl),
+				mockEnvStack:           mocks.NewMockenvStackDescriber(ctrl),
 				mockServiceDeployer:    mocks.NewMockserviceDeployer(ctrl),
 				mockServiceUpdater:     mocks.NewMocksvcForceUpdater(ctrl),
 				mockSpinner:            mocks.NewMockprogress(ctrl),
@@ -1059,7 +1719,7 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				newAppVersionGetter: func(s string) (versionGetter, error) {
 					return m.mockAppVersionGetter, nil
 				},
-				endpointGetter:    m.mockEndpointGetter,
+				envStack:          m.mockEnvStack,
 				identity:          m.mockIdentity,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
@@ -1100,7 +1760,7 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				},
 			}
 
-			gotErr := opts.deploySvc()
+			gotErr := opts.deploySvc(os.Stderr)
 
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
@@ -1115,7 +1775,7 @@ type deployRDSvcMocks struct {
 	mockWorkspace          *mocks.MockwsSvcDirReader
 	mockAppResourcesGetter *mocks.MockappResourcesGetter
 	mockAppVersionGetter   *mocks.MockversionGetter
-	mockEndpointGetter     *mocks.MockendpointGetter
+	mockEnvStack           *mocks.MockenvStackDescriber
 	mockIdentity           *mocks.MockidentityService
 	mockUploader           *mocks.MockcustomResourcesUploader
 	mockInterpolator       *mocks.Mockinterpolator
@@ -1151,7 +1811,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 			mock: func(m *deployRDSvcMocks) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 
 			},
 
@@ -1170,7 +1831,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 			mock: func(m *deployRDSvcMocks) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{}, errors.New("some error"))
 			},
 
@@ -1190,7 +1852,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1216,7 +1879,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1238,7 +1902,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1260,7 +1925,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1282,7 +1948,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1304,7 +1971,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1333,7 +2001,8 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockWorkspace.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockIdentity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "1234",
 				}, nil)
@@ -1363,7 +2032,7 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				mockWorkspace:          mocks.NewMockwsSvcDirReader(ctrl),
 				mockAppResourcesGetter: mocks.NewMockappResourcesGetter(ctrl),
 				mockAppVersionGetter:   mocks.NewMockversionGetter(ctrl),
-				mockEndpointGetter:     mocks.NewMockendpointGetter(ctrl),
+				mockEnvStack:           mocks.NewMockenvStackDescriber(ctrl),
 				mockIdentity:           mocks.NewMockidentityService(ctrl),
 				mockUploader:           mocks.NewMockcustomResourcesUploader(ctrl),
 				mockInterpolator:       mocks.NewMockinterpolator(ctrl),
@@ -1385,7 +2054,7 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 					return m.mockInterpolator
 				},
 				newSvcUpdater:     func(f func(*session.Session) svcForceUpdater) {},
-				endpointGetter:    m.mockEndpointGetter,
+				envStack:          m.mockEnvStack,
 				identity:          m.mockIdentity,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
@@ -1441,6 +2110,7 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 		inApp          *config.Application
 		inEnvironment  *config.Environment
 		inBuildRequire bool
+		inSubscribe    manifest.SubscribeConfig
 
 		mock func(m *deploySvcMocks)
 
@@ -1464,11 +2134,42 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployStore.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return(nil, mockError)
 			},
 			wantErr: fmt.Errorf("get SNS topics for app mockApp and environment mockEnv: %w", mockError),
 		},
+		"fail to get the environment version when subscribing to events on the environment's event bus": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inSubscribe: manifest.SubscribeConfig{
+				Events: []manifest.EventSubscription{{Name: aws.String("orders"), Service: aws.String("api"), Pattern: map[string]interface{}{"detail-type": []interface{}{"OrderPlaced"}}}},
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockEnvStack.EXPECT().Version().Return("", mockError)
+			},
+			wantErr: fmt.Errorf("get version of environment mockEnv: %w", mockError),
+		},
+		"fail if the environment does not have an event bus yet": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inSubscribe: manifest.SubscribeConfig{
+				Events: []manifest.EventSubscription{{Name: aws.String("orders"), Service: aws.String("api"), Pattern: map[string]interface{}{"detail-type": []interface{}{"OrderPlaced"}}}},
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockEnvStack.EXPECT().Version().Return("v1.7.0", nil)
+			},
+			wantErr: errors.New("environment mockEnv on version v1.7.0 does not have an event bus: run `copilot env upgrade --name mockEnv` to upgrade it to v1.8.0 or later"),
+		},
 		"success": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -1481,7 +2182,8 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			mock: func(m *deploySvcMocks) {
 				m.mockWs.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte{}, nil)
 				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
-				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockEnv.mockApp.local", nil)
+				m.mockEnvStack.EXPECT().ServiceDiscoveryEndpoint().Return("mockEnv.mockApp.local", nil)
+				m.mockEnvStack.EXPECT().Outputs().Return(nil, nil)
 				m.mockDeployStore.EXPECT().ListSNSTopics(mockAppName, mockEnvName).Return([]deploy.Topic{
 					*topic,
 				}, nil)
@@ -1494,10 +2196,10 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			m := &deploySvcMocks{
-				mockWs:             mocks.NewMockwsSvcDirReader(ctrl),
-				mockEndpointGetter: mocks.NewMockendpointGetter(ctrl),
-				mockDeployStore:    mocks.NewMockdeployedEnvironmentLister(ctrl),
-				mockInterpolator:   mocks.NewMockinterpolator(ctrl),
+				mockWs:           mocks.NewMockwsSvcDirReader(ctrl),
+				mockEnvStack:     mocks.NewMockenvStackDescriber(ctrl),
+				mockDeployStore:  mocks.NewMockdeployedEnvironmentLister(ctrl),
+				mockInterpolator: mocks.NewMockinterpolator(ctrl),
 			}
 			tc.mock(m)
 
@@ -1510,7 +2212,7 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 				ws:                m.mockWs,
 				buildRequired:     tc.inBuildRequire,
 				newSvcUpdater:     func(f func(*session.Session) svcForceUpdater) {},
-				endpointGetter:    m.mockEndpointGetter,
+				envStack:          m.mockEnvStack,
 				snsTopicGetter:    m.mockDeployStore,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
@@ -1528,6 +2230,7 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 									Build: manifest.BuildArgsOrString{BuildString: aws.String("/Dockerfile")},
 								},
 							},
+							Subscribe: tc.inSubscribe,
 						},
 					}, nil
 				},
@@ -1544,3 +2247,36 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 		})
 	}
 }
+
+func TestImageRepository(t *testing.T) {
+	testCases := map[string]struct {
+		inLocation string
+		wanted     string
+	}{
+		"image without tag": {
+			inLocation: "nginx",
+			wanted:     "nginx",
+		},
+		"image with tag": {
+			inLocation: "public.ecr.aws/nginx/nginx:1.21",
+			wanted:     "public.ecr.aws/nginx/nginx",
+		},
+		"image with digest": {
+			inLocation: "public.ecr.aws/nginx/nginx@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wanted:     "public.ecr.aws/nginx/nginx",
+		},
+		"image with tag and digest": {
+			inLocation: "public.ecr.aws/nginx/nginx:1.21@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			wanted:     "public.ecr.aws/nginx/nginx",
+		},
+		"registry with port": {
+			inLocation: "localhost:5000/nginx",
+			wanted:     "localhost:5000/nginx",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, imageRepository(tc.inLocation))
+		})
+	}
+}
@@ -188,23 +188,29 @@ func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
 	if len(tasks) == 0 {
 		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
-	if o.taskID != "" {
-		for _, task := range tasks {
-			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
-			if err != nil {
-				return "", err
-			}
-			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
-			}
-		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
-	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
+	task, err := selectTaskByIDPrefix(tasks, o.taskID, o.randInt)
 	if err != nil {
 		return "", err
 	}
-	return taskID, nil
+	return awsecs.TaskID(aws.StringValue(task.TaskArn))
+}
+
+// selectTaskByIDPrefix returns the task whose ID is prefixed with prefix.
+// If prefix is empty, a task is chosen at random.
+func selectTaskByIDPrefix(tasks []*awsecs.Task, prefix string, randInt func(int) int) (*awsecs.Task, error) {
+	if prefix == "" {
+		return tasks[randInt(len(tasks))], nil
+	}
+	for _, task := range tasks {
+		taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(taskID, prefix) {
+			return task, nil
+		}
+	}
+	return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", prefix)
 }
 
 func (o *svcExecOpts) selectContainer() string {
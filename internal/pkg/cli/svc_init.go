@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -91,6 +92,7 @@ type initWkldVars struct {
 	wkldType       string
 	name           string
 	dockerfilePath string
+	buildTarget    string // Dockerfile stage to build, empty to build the final stage.
 	image          string
 	subscriptions  []string
 	noSubscribe    bool
@@ -200,6 +202,9 @@ func (o *initSvcOpts) Validate() error {
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
+	if o.buildTarget != "" && o.image != "" {
+		return fmt.Errorf("--%s and --%s cannot be specified together", buildTargetFlag, imageFlag)
+	}
 	if o.dockerfilePath != "" {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -268,16 +273,25 @@ func (o *initSvcOpts) Ask() error {
 func (o *initSvcOpts) Execute() error {
 	// Check for a valid healthcheck and add it to the opts.
 	var hc manifest.ContainerHealthCheck
+	var settings dockerfileSettings
 	var err error
 	if o.dockerfilePath != "" {
 		hc, err = parseHealthCheck(o.dockerfile(o.dockerfilePath))
 		if err != nil {
 			log.Warningf("Cannot parse the HEALTHCHECK instruction from the Dockerfile: %v\n", err)
 		}
+		settings, err = parseDockerfileSettings(o.dockerfile(o.dockerfilePath), o.buildTarget)
+		var noStageErr *errNoBuildStage
+		if errors.As(err, &noStageErr) {
+			return err
+		}
+		if err != nil {
+			log.Warningf("Cannot parse the build and runtime settings from the Dockerfile: %v\n", err)
+		}
 	}
 	// If the user passes in an image, their docker engine isn't necessarily running, and we can't do anything with the platform because we're not building the Docker image.
 	if o.image == "" {
-		platform, err := legitimizePlatform(o.dockerEngine, o.wkldType)
+		platform, err := buildPlatform(o.dockerEngine, o.wkldType, settings.platform)
 		if err != nil {
 			return err
 		}
@@ -295,7 +309,9 @@ func (o *initSvcOpts) Execute() error {
 			Platform: manifest.PlatformArgsOrString{
 				PlatformString: o.platform,
 			},
-			Topics: o.topics,
+			Topics:            o.topics,
+			BuildTarget:       settings.target,
+			DockerfileRuntime: settings.runtime,
 		},
 		Port:        o.port,
 		HealthCheck: hc,
@@ -512,6 +528,16 @@ func legitimizePlatform(engine dockerEngine, wkldType string) (manifest.Platform
 	return manifest.PlatformString(redirectedPlatform), nil
 }
 
+// buildPlatform returns the platform to set in the manifest of a workload built from a Dockerfile.
+// The platform pinned by the final stage of the Dockerfile takes precedence over the one of the docker engine.
+func buildPlatform(engine dockerEngine, wkldType string, dockerfilePlatform string) (manifest.PlatformString, error) {
+	// App Runner only runs linux/amd64 images, so the platform is left to be redirected.
+	if dockerfilePlatform == "" || wkldType == manifest.RequestDrivenWebServiceType {
+		return legitimizePlatform(engine, wkldType)
+	}
+	return manifest.PlatformString(dockerfilePlatform), nil
+}
+
 func (o *initSvcOpts) askSvcPublishers() (err error) {
 	if o.wkldType != manifest.WorkerServiceType {
 		return nil
@@ -542,10 +568,14 @@ func (o *initSvcOpts) askSvcPublishers() (err error) {
 
 	subscriptions := make([]manifest.TopicSubscription, 0, len(topics))
 	for _, t := range topics {
-		subscriptions = append(subscriptions, manifest.TopicSubscription{
+		subscription := manifest.TopicSubscription{
 			Name:    aws.String(t.Name()),
 			Service: aws.String(t.Workload()),
-		})
+		}
+		if t.FIFO() {
+			subscription.FIFO = aws.Bool(true)
+		}
+		subscriptions = append(subscriptions, subscription)
 	}
 	o.topics = subscriptions
 
@@ -581,6 +611,90 @@ func parseHealthCheck(df dockerfileParser) (manifest.ContainerHealthCheck, error
 	}, nil
 }
 
+// dockerfileSettings holds the settings detected in a Dockerfile that pre-fill the manifest of a new workload.
+type dockerfileSettings struct {
+	target   string // Name of the stage to build, empty to build the final stage.
+	platform string // Platform pinned by the stage to build.
+	runtime  manifest.DockerfileRuntime
+}
+
+// errNoBuildStage means that the Dockerfile has no stage named after the build target.
+type errNoBuildStage struct {
+	target string
+}
+
+func (e *errNoBuildStage) Error() string {
+	return fmt.Sprintf("Dockerfile has no build stage named %s", e.target)
+}
+
+// parseDockerfileSettings returns the settings of the Dockerfile that apply when building the target stage,
+// or the final stage if target is empty.
+func parseDockerfileSettings(df dockerfileParser, target string) (dockerfileSettings, error) {
+	settings := dockerfileSettings{
+		target: target,
+	}
+	stages, err := df.GetStages()
+	if err != nil {
+		return settings, fmt.Errorf("get stages: %w", err)
+	}
+	buildsFinalStage := true
+	if len(stages) > 0 {
+		stage := stages[len(stages)-1]
+		if target != "" {
+			i := stageIndex(stages, target)
+			if i == -1 {
+				return settings, &errNoBuildStage{target: target}
+			}
+			stage, buildsFinalStage = stages[i], i == len(stages)-1
+		}
+		// Platforms that are set with a build arg such as "$BUILDPLATFORM" can't be resolved until build time.
+		if !strings.Contains(stage.Platform, "$") && manifest.PlatformString(stage.Platform).Validate() == nil {
+			settings.platform = strings.ToLower(stage.Platform)
+		}
+	}
+	args, err := df.GetBuildArgs()
+	if err != nil {
+		return settings, fmt.Errorf("get build args: %w", err)
+	}
+	for _, arg := range args {
+		// Build args without a default are suggested in comments, since an empty value would override the ARG's intent.
+		if arg.Default == nil {
+			settings.runtime.UnsetBuildArgs = append(settings.runtime.UnsetBuildArgs, arg.Name)
+		}
+	}
+	if !buildsFinalStage {
+		// The runtime settings are read from the final stage, which isn't built.
+		return settings, nil
+	}
+	if settings.runtime.Variables, err = df.GetEnvVars(); err != nil {
+		return settings, fmt.Errorf("get environment variables: %w", err)
+	}
+	if settings.runtime.EntryPoint, err = df.GetEntrypoint(); err != nil {
+		return settings, fmt.Errorf("get entrypoint: %w", err)
+	}
+	if settings.runtime.Command, err = df.GetCommand(); err != nil {
+		return settings, fmt.Errorf("get command: %w", err)
+	}
+	user, err := df.GetUser()
+	if err != nil {
+		return settings, fmt.Errorf("get user: %w", err)
+	}
+	if dockerfile.IsRootUser(user) {
+		log.Warningln("The container runs as the root user. Consider adding a USER instruction with a non-root user to the Dockerfile.")
+	}
+	return settings, nil
+}
+
+// stageIndex returns the index of the stage with the name, or -1 if there is none.
+func stageIndex(stages []dockerfile.Stage, name string) int {
+	for i, stage := range stages {
+		if stage.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func svcTypePromptOpts() []prompt.Option {
 	var options []prompt.Option
 	for _, svcType := range manifest.ServiceTypes {
@@ -631,6 +745,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.wkldType, svcTypeFlag, typeFlagShort, "", svcTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
+	cmd.Flags().StringVar(&vars.buildTarget, buildTargetFlag, "", buildTargetFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.subscriptions, subscribeTopicsFlag, []string{}, subscribeTopicsFlagDescription)
@@ -41,6 +41,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inSvcType        string
 		inSvcName        string
 		inDockerfilePath string
+		inBuildTarget    string
 		inImage          string
 		inAppName        string
 		inSvcPort        uint16
@@ -67,6 +68,12 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			inImage:          "mockImage",
 			wantedErr:        fmt.Errorf("--dockerfile and --image cannot be specified together"),
 		},
+		"fail if both image and build target are set": {
+			inAppName:     "phonetool",
+			inBuildTarget: "runtime",
+			inImage:       "mockImage",
+			wantedErr:     fmt.Errorf("--target and --image cannot be specified together"),
+		},
 		"fail if image not supported by App Runner": {
 			inAppName: "phonetool",
 			inImage:   "amazon/amazon-ecs-sample",
@@ -127,6 +134,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						wkldType:       tc.inSvcType,
 						name:           tc.inSvcName,
 						dockerfilePath: tc.inDockerfilePath,
+						buildTarget:    tc.inBuildTarget,
 						image:          tc.inImage,
 						appName:        tc.inAppName,
 						subscriptions:  tc.inSubscribeTags,
@@ -601,6 +609,15 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 	}
 }
 
+func mockNoDockerfileSettings(m *mocks.MockdockerfileParser) {
+	m.EXPECT().GetStages().Return([]dockerfile.Stage{{Image: "nginx"}}, nil)
+	m.EXPECT().GetBuildArgs().Return(nil, nil)
+	m.EXPECT().GetEnvVars().Return(nil, nil)
+	m.EXPECT().GetEntrypoint().Return(nil, nil)
+	m.EXPECT().GetCommand().Return(nil, nil)
+	m.EXPECT().GetUser().Return("", nil)
+}
+
 func TestSvcInitOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		mockSvcInit      func(m *mocks.MocksvcInitializer)
@@ -611,6 +628,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 		inSvcType        string
 		inSvcName        string
 		inDockerfilePath string
+		inBuildTarget    string
 		inImage          string
 		inAppName        string
 
@@ -639,6 +657,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
@@ -665,6 +684,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
@@ -696,6 +716,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("windows", "amd64", nil)
@@ -727,6 +748,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "arm", nil)
@@ -753,6 +775,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 			},
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("linux", "amd64", nil)
@@ -772,6 +795,109 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			wantedManifestPath: "manifest/path",
 		},
+		"pre-fills the settings detected in the Dockerfile": {
+			inAppName:        "sample",
+			inSvcName:        "frontend",
+			inDockerfilePath: "./Dockerfile",
+			inSvcType:        manifest.BackendServiceType,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:            "sample",
+						Name:           "frontend",
+						Type:           "Backend Service",
+						DockerfilePath: "./Dockerfile",
+						Platform: manifest.PlatformArgsOrString{
+							PlatformString: (*manifest.PlatformString)(aws.String("linux/arm64")),
+						},
+						DockerfileRuntime: manifest.DockerfileRuntime{
+							Variables:      map[string]string{"NODE_ENV": "production"},
+							EntryPoint:     []string{"/usr/bin/tini", "--"},
+							Command:        []string{"node", "index.js"},
+							UnsetBuildArgs: []string{"GIT_SHA"},
+						},
+					},
+				}).Return("manifest/path", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				m.EXPECT().GetStages().Return([]dockerfile.Stage{
+					{Name: "build", Image: "node:18", Platform: "$BUILDPLATFORM"},
+					{Name: "runtime", Image: "node:18-slim", Platform: "linux/arm64"},
+				}, nil)
+				m.EXPECT().GetBuildArgs().Return([]dockerfile.BuildArg{
+					{Name: "GIT_SHA"},
+					{Name: "NODE_VERSION", Default: aws.String("18")},
+				}, nil)
+				m.EXPECT().GetEnvVars().Return(map[string]string{"NODE_ENV": "production"}, nil)
+				m.EXPECT().GetEntrypoint().Return([]string{"/usr/bin/tini", "--"}, nil)
+				m.EXPECT().GetCommand().Return([]string{"node", "index.js"}, nil)
+				m.EXPECT().GetUser().Return("node", nil)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {}, // The platform of the final stage takes precedence.
+
+			wantedManifestPath: "manifest/path",
+		},
+		"pre-fills the build settings of the target stage": {
+			inAppName:        "sample",
+			inSvcName:        "frontend",
+			inDockerfilePath: "./Dockerfile",
+			inBuildTarget:    "debug",
+			inSvcType:        manifest.BackendServiceType,
+
+			mockSvcInit: func(m *mocks.MocksvcInitializer) {
+				m.EXPECT().Service(&initialize.ServiceProps{
+					WorkloadProps: initialize.WorkloadProps{
+						App:            "sample",
+						Name:           "frontend",
+						Type:           "Backend Service",
+						DockerfilePath: "./Dockerfile",
+						Platform: manifest.PlatformArgsOrString{
+							PlatformString: (*manifest.PlatformString)(aws.String("linux/amd64")),
+						},
+						BuildTarget: "debug",
+						DockerfileRuntime: manifest.DockerfileRuntime{
+							UnsetBuildArgs: []string{"GIT_SHA"},
+						},
+					},
+				}).Return("manifest/path", nil)
+			},
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				m.EXPECT().GetStages().Return([]dockerfile.Stage{
+					{Name: "debug", Image: "node:18", Platform: "linux/amd64"},
+					{Name: "runtime", Image: "node:18-slim", Platform: "linux/arm64"},
+				}, nil)
+				m.EXPECT().GetBuildArgs().Return([]dockerfile.BuildArg{
+					{Name: "GIT_SHA"},
+				}, nil)
+				// The runtime settings of the final stage don't apply to the target stage.
+				m.EXPECT().GetEnvVars().Times(0)
+				m.EXPECT().GetEntrypoint().Times(0)
+				m.EXPECT().GetCommand().Times(0)
+			},
+			mockDockerEngine: func(m *mocks.MockdockerEngine) {},
+
+			wantedManifestPath: "manifest/path",
+		},
+		"return error if the Dockerfile has no stage named after the build target": {
+			inAppName:        "sample",
+			inSvcName:        "frontend",
+			inDockerfilePath: "./Dockerfile",
+			inBuildTarget:    "debug",
+			inSvcType:        manifest.BackendServiceType,
+
+			mockDockerfile: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				m.EXPECT().GetStages().Return([]dockerfile.Stage{
+					{Name: "build", Image: "node:18"},
+					{Name: "runtime", Image: "node:18-slim"},
+				}, nil)
+			},
+
+			wantedErr: errors.New("Dockerfile has no build stage named debug"),
+		},
 		"doesn't parse dockerfile if image specified (backend)": {
 			inAppName:        "sample",
 			inSvcName:        "backend",
@@ -832,6 +958,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 
 			mockDockerfile: func(m *mocks.MockdockerfileParser) {
 				m.EXPECT().GetHealthCheck().Return(nil, nil)
+				mockNoDockerfileSettings(m)
 			},
 			mockDockerEngine: func(m *mocks.MockdockerEngine) {
 				m.EXPECT().GetPlatform().Return("windows", "amd64", nil)
@@ -877,6 +1004,7 @@ func TestSvcInitOpts_Execute(t *testing.T) {
 						name:           tc.inSvcName,
 						wkldType:       tc.inSvcType,
 						dockerfilePath: tc.inDockerfilePath,
+						buildTarget:    tc.inBuildTarget,
 						image:          tc.inImage,
 					},
 					port: tc.inSvcPort,
@@ -60,23 +60,23 @@ type packageSvcOpts struct {
 	packageSvcVars
 
 	// Interfaces to interact with dependencies.
-	addonsClient      templater
-	initAddonsClient  func(*packageSvcOpts) error // Overridden in tests.
-	ws                wsSvcReader
-	store             store
-	appCFN            appResourcesGetter
-	stackWriter       io.Writer
-	paramsWriter      io.Writer
-	addonsWriter      io.Writer
-	fs                afero.Fs
-	runner            runner
-	sel               wsSelector
-	prompt            prompter
-	identity          identityService
-	newInterpolator   func(app, env string) interpolator
-	stackSerializer   func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
-	newEndpointGetter func(app, env string) (endpointGetter, error)
-	snsTopicGetter    deployedEnvironmentLister
+	addonsClient         templater
+	initAddonsClient     func(*packageSvcOpts) error // Overridden in tests.
+	ws                   wsSvcReader
+	store                store
+	appCFN               appResourcesGetter
+	stackWriter          io.Writer
+	paramsWriter         io.Writer
+	addonsWriter         io.Writer
+	fs                   afero.Fs
+	runner               runner
+	sel                  wsSelector
+	prompt               prompter
+	identity             identityService
+	newInterpolator      func(app, env string) interpolator
+	stackSerializer      func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error)
+	newEnvStackDescriber func(app, env string) (envStackDescriber, error)
+	snsTopicGetter       deployedEnvironmentLister
 }
 
 func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
@@ -216,7 +216,7 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		}
 		return serializer, nil
 	}
-	opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
+	opts.newEnvStackDescriber = func(app, env string) (envStackDescriber, error) {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 			App:         app,
 			Env:         env,
@@ -376,38 +376,40 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
-	endpointGetter, err := o.newEndpointGetter(o.appName, o.envName)
+	envStack, err := o.newEnvStackDescriber(o.appName, o.envName)
 	if err != nil {
 		return nil, err
 	}
-	endpoint, err := endpointGetter.ServiceDiscoveryEndpoint()
+	endpoint, err := envStack.ServiceDiscoveryEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	envAddonsOutputs, err := deployedEnvAddonsOutputs(envStack)
 	if err != nil {
 		return nil, err
 	}
 	rc := stack.RuntimeConfig{
 		AdditionalTags:           app.Tags,
 		ServiceDiscoveryEndpoint: endpoint,
+		EnvAddonsOutputs:         envAddonsOutputs,
+		PullThroughCachePrefixes: env.CustomConfig.PullThroughCachePrefixes(),
 		AccountID:                env.AccountID,
 		Region:                   env.Region,
 	}
 
-	if imgNeedsBuild {
-		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	sidecarsNeedBuild := manifest.DockerfileBuildSidecars(envMft)
+	if imgNeedsBuild || len(sidecarsNeedBuild) > 0 {
+		repoURL, err := o.imageRepoURL(envMft, app, env)
 		if err != nil {
 			return nil, err
 		}
-		repoURL, ok := resources.RepositoryURLs[o.name]
-		if !ok {
-			return nil, &errRepoNotFound{
-				wlName:       o.name,
-				envRegion:    env.Region,
-				appAccountID: app.AccountID,
+		if imgNeedsBuild {
+			rc.Image = &stack.ECRImage{
+				RepoURL:  repoURL,
+				ImageTag: o.tag,
 			}
 		}
-		rc.Image = &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.tag,
-		}
+		rc.SidecarImages = packagedSidecarImages(repoURL, o.tag, sidecarsNeedBuild)
 	}
 	serializer, err := o.stackSerializer(envMft, env, app, rc)
 	if err != nil {
@@ -424,6 +426,46 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
 }
 
+// imageRepoURL returns the URL of the repository that the images built from local Dockerfiles are pushed to:
+// the third-party registry of the manifest if there is one, otherwise the ECR repository of the service.
+func (o *packageSvcOpts) imageRepoURL(mft interface{}, app *config.Application, env *config.Environment) (string, error) {
+	if registry := manifest.ThirdPartyImageRegistry(mft); registry != nil {
+		return aws.StringValue(registry.URI), nil
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return "", err
+	}
+	repoURL, ok := resources.RepositoryURLs[o.name]
+	if !ok {
+		return "", &errRepoNotFound{
+			wlName:       o.name,
+			envRegion:    env.Region,
+			appAccountID: app.AccountID,
+		}
+	}
+	return repoURL, nil
+}
+
+// packagedSidecarImages returns the locations of the sidecar images built from local Dockerfiles keyed by sidecar name.
+// Without a tag, the images are referred to by the tag that replaces "latest" for each sidecar.
+func packagedSidecarImages(repoURL, tag string, sidecars []string) map[string]stack.ECRImage {
+	if len(sidecars) == 0 {
+		return nil
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	images := make(map[string]stack.ECRImage, len(sidecars))
+	for _, name := range sidecars {
+		images[name] = stack.ECRImage{
+			RepoURL:  repoURL,
+			ImageTag: sidecarImageTag(name, tag),
+		}
+	}
+	return images
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
@@ -308,9 +308,10 @@ count: 1`
 					require.Equal(t, rc.Region, "us-west-2")
 					return mockStackSerializer, nil
 				}
-				opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
-					mockendpointGetter := mocks.NewMockendpointGetter(ctrl)
+				opts.newEnvStackDescriber = func(app, env string) (envStackDescriber, error) {
+					mockendpointGetter := mocks.NewMockenvStackDescriber(ctrl)
 					mockendpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(fmt.Sprintf("%s.%s.local", env, app), nil)
+					mockendpointGetter.EXPECT().Outputs().Return(nil, nil)
 					return mockendpointGetter, nil
 				}
 			},
@@ -383,9 +384,10 @@ count: 1`
 					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
 					return mockStackSerializer, nil
 				}
-				opts.newEndpointGetter = func(app, env string) (endpointGetter, error) {
-					mockendpointGetter := mocks.NewMockendpointGetter(ctrl)
+				opts.newEnvStackDescriber = func(app, env string) (envStackDescriber, error) {
+					mockendpointGetter := mocks.NewMockenvStackDescriber(ctrl)
 					mockendpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(fmt.Sprintf("%s.%s.local", env, app), nil)
+					mockendpointGetter.EXPECT().Outputs().Return(nil, nil)
 					return mockendpointGetter, nil
 				}
 			},
@@ -0,0 +1,255 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPortForwardNamePrompt     = "Which service would you like to forward a port to?"
+	svcPortForwardNameHelpPrompt = `Copilot forwards the port to one of your chosen service's tasks.
+The task is chosen at random, and the first essential container is used.`
+)
+
+type svcPortForwardOpts struct {
+	portForwardVars
+	store              store
+	sel                deploySelector
+	newSvcDescriber    func(*session.Session) serviceDescriber
+	newPortForwarder   func(*session.Session) ecsPortForwarder
+	newAddonsDescriber func(app, env, svc string) (addonsOutputsGetter, error)
+	ssmPluginManager   ssmPluginManager
+	prompter           prompter
+	// Override in unit test
+	randInt func(int) int
+
+	localPort  string
+	remotePort string
+}
+
+func newSvcPortForwardOpts(vars portForwardVars) (*svcPortForwardOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcPortForwardOpts{
+		portForwardVars: vars,
+		store:           ssmStore,
+		sel:             selector.NewDeploySelect(prompt.New(), ssmStore, deployStore),
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newPortForwarder: func(s *session.Session) ecsPortForwarder {
+			return ssm.New(s)
+		},
+		newAddonsDescriber: func(app, env, svc string) (addonsOutputsGetter, error) {
+			return describe.NewECSServiceDescriber(describe.NewServiceConfig{
+				App:         app,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: ssmStore,
+			})
+		},
+		randInt: func(x int) int {
+			rand.Seed(time.Now().Unix())
+			return rand.Intn(x)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		prompter:         prompt.New(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetService(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	local, remote, err := parsePortMapping(o.ports)
+	if err != nil {
+		return err
+	}
+	o.localPort, o.remotePort = local, remote
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPortForwardOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute forwards a local port to a port in a running container of the service,
+// or to a remote host reachable from the container.
+func (o *svcPortForwardOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("port forwarding to a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	task, err := selectTaskByIDPrefix(tasks, o.taskID, o.randInt)
+	if err != nil {
+		return err
+	}
+	container := o.containerName
+	if container == "" {
+		// The first essential container is named with the workload name.
+		container = o.name
+	}
+	target, err := task.SSMTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session manager target: %w", err)
+	}
+	remoteHost, err := o.resolveRemoteHost()
+	if err != nil {
+		return err
+	}
+	return forwardPort(o.newPortForwarder(sess), ssm.ForwardPortInput{
+		Target:     target,
+		LocalPort:  o.localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: remoteHost,
+	}, container, task)
+}
+
+// resolveRemoteHost returns the value of the service's addons output named after the remote host flag if there is one,
+// otherwise the remote host is returned as is.
+func (o *svcPortForwardOpts) resolveRemoteHost() (string, error) {
+	if o.remoteHost == "" {
+		return "", nil
+	}
+	describer, err := o.newAddonsDescriber(o.appName, o.envName, o.name)
+	if err != nil {
+		return "", fmt.Errorf("create describer for service %s: %w", o.name, err)
+	}
+	outputs, err := describer.AddonsOutputs()
+	if err != nil {
+		return "", fmt.Errorf("get addons outputs of service %s: %w", o.name, err)
+	}
+	if host, ok := outputs[o.remoteHost]; ok {
+		return host, nil
+	}
+	return o.remoteHost, nil
+}
+
+func forwardPort(forwarder ecsPortForwarder, in ssm.ForwardPortInput, container string, task *awsecs.Task) error {
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(task.TaskArn), err)
+	}
+	destination := fmt.Sprintf("port %s of container %s", in.RemotePort, color.HighlightUserInput(container))
+	if in.RemoteHost != "" {
+		destination = fmt.Sprintf("%s:%s through container %s", color.HighlightUserInput(in.RemoteHost), in.RemotePort, color.HighlightUserInput(container))
+	}
+	log.Infof("Forward local port %s to %s in task %s.\n", color.HighlightCode(in.LocalPort), destination, color.HighlightResource(taskID))
+	if err := forwarder.ForwardPort(in); err != nil {
+		return fmt.Errorf("forward local port %s to port %s: %w", in.LocalPort, in.RemotePort, err)
+	}
+	return nil
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a running container in a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := portForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running container part of a service.",
+		Example: `
+  Forward local port 8080 to port 80 of a task part of the "frontend" service.
+  /code $ copilot svc port-forward -a my-app -e test -n frontend --port 8080:80
+  Forward local port 5432 to the database whose endpoint is the "dbClusterEndpoint" output of the "api" service's addons.
+  /code $ copilot svc port-forward -e test -n api --port 5432 --remote-host dbClusterEndpoint`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.ports, svcPortFlag, "", portForwardPortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", svcRemoteHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", portForwardContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,256 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcPortForwardMocks struct {
+	store         *mocks.Mockstore
+	svcDescriber  *mocks.MockserviceDescriber
+	portForwarder *mocks.MockecsPortForwarder
+	addonsGetter  *mocks.MockaddonsOutputsGetter
+}
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPorts string
+
+		wantedLocal  string
+		wantedRemote string
+		wantedError  error
+	}{
+		"should bubble error if the port mapping is invalid": {
+			inPorts:     "8080:",
+			wantedError: errors.New("port  in mapping 8080: must be a number"),
+		},
+		"should parse the port mapping": {
+			inPorts:      "8080:80",
+			wantedLocal:  "8080",
+			wantedRemote: "80",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMPluginManager := mocks.NewMockssmPluginManager(ctrl)
+			mockSSMPluginManager.EXPECT().ValidateBinary().Return(nil).AnyTimes()
+			opts := &svcPortForwardOpts{
+				portForwardVars: portForwardVars{
+					ports: tc.inPorts,
+				},
+				ssmPluginManager: mockSSMPluginManager,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLocal, opts.localPort)
+			require.Equal(t, tc.wantedRemote, opts.remotePort)
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	const (
+		mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+		mockTarget  = "ecs:mockCluster_mockTaskID_mockRuntimeID"
+	)
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Load Balanced Web Service",
+	}
+	mockRDWSWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Request-Driven Web Service",
+	}
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"),
+				LastStatus: aws.String("RUNNING"),
+				Containers: []*ecsapi.Container{
+					{
+						Name:      aws.String("mockSvc"),
+						RuntimeId: aws.String("mockRuntimeID"),
+					},
+				},
+			},
+		},
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		containerName string
+		remoteHost    string
+		setupMocks    func(mocks svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"return error if service type is Request-Driven Web Service": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockRDWSWl, nil)
+			},
+			wantedError: fmt.Errorf("port forwarding to a service is not supported for services with type: 'Request-Driven Web Service'"),
+		},
+		"return error if no running task found": {
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("found no running task for service mockSvc in environment mockEnv"),
+		},
+		"return error if the container does not exist": {
+			containerName: "sidecar",
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+				)
+			},
+			wantedError: fmt.Errorf("get session manager target: container sidecar not found in task mockTaskID"),
+		},
+		"return error if fail to get addons outputs": {
+			remoteHost: "dbEndpoint",
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.addonsGetter.EXPECT().AddonsOutputs().Return(nil, mockError),
+				)
+			},
+			wantedError: fmt.Errorf("get addons outputs of service mockSvc: some error"),
+		},
+		"return error if fail to forward port": {
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.portForwarder.EXPECT().ForwardPort(ssm.ForwardPortInput{
+						Target:     mockTarget,
+						LocalPort:  "8080",
+						RemotePort: "80",
+					}).Return(mockError),
+				)
+			},
+			wantedError: fmt.Errorf("forward local port 8080 to port 80: some error"),
+		},
+		"resolve remote host from addons outputs": {
+			remoteHost: "dbEndpoint",
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.addonsGetter.EXPECT().AddonsOutputs().Return(map[string]string{
+						"dbEndpoint": "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+					}, nil),
+					m.portForwarder.EXPECT().ForwardPort(ssm.ForwardPortInput{
+						Target:     mockTarget,
+						LocalPort:  "8080",
+						RemotePort: "80",
+						RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+					}).Return(nil),
+				)
+			},
+		},
+		"use remote host as is if it is not an addons output": {
+			remoteHost: "10.0.0.12",
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil),
+					m.store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil),
+					m.svcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil),
+					m.addonsGetter.EXPECT().AddonsOutputs().Return(map[string]string{}, nil),
+					m.portForwarder.EXPECT().ForwardPort(ssm.ForwardPortInput{
+						Target:     mockTarget,
+						LocalPort:  "8080",
+						RemotePort: "80",
+						RemoteHost: "10.0.0.12",
+					}).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcPortForwardMocks{
+				store:         mocks.NewMockstore(ctrl),
+				svcDescriber:  mocks.NewMockserviceDescriber(ctrl),
+				portForwarder: mocks.NewMockecsPortForwarder(ctrl),
+				addonsGetter:  mocks.NewMockaddonsOutputsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &svcPortForwardOpts{
+				portForwardVars: portForwardVars{
+					appName:       "mockApp",
+					envName:       "mockEnv",
+					name:          "mockSvc",
+					containerName: tc.containerName,
+					remoteHost:    tc.remoteHost,
+				},
+				store: m.store,
+				newSvcDescriber: func(_ *session.Session) serviceDescriber {
+					return m.svcDescriber
+				},
+				newPortForwarder: func(_ *session.Session) ecsPortForwarder {
+					return m.portForwarder
+				},
+				newAddonsDescriber: func(_, _, _ string) (addonsOutputsGetter, error) {
+					return m.addonsGetter, nil
+				},
+				randInt:    func(i int) int { return 0 },
+				localPort:  "8080",
+				remotePort: "80",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
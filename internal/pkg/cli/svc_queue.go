@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcQueueAppNamePrompt  = "Which application is the service in?"
+	svcQueueNamePrompt     = "Which worker service of %s would you like to inspect?"
+	svcQueueNameHelpPrompt = "The queues of the selected worker service will be used."
+
+	defaultWorkerQueueName = "EventsQueue"
+)
+
+type svcQueueVars struct {
+	appName   string
+	envName   string
+	svcName   string
+	queueName string
+}
+
+// svcQueueOpts holds the fields shared by the "svc queue" subcommands.
+type svcQueueOpts struct {
+	svcQueueVars
+
+	w      io.Writer
+	store  store
+	sel    deploySelector
+	prompt prompter
+
+	queueDescriber   workerQueueDescriber
+	messenger        queueMessenger
+	initQueueClients func() error
+}
+
+func newSvcQueueOpts(vars svcQueueVars) (*svcQueueOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &svcQueueOpts{
+		svcQueueVars: vars,
+		w:            log.OutputWriter,
+		store:        configStore,
+		sel:          selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:       prompter,
+	}
+	opts.initQueueClients = func() error {
+		wkld, err := configStore.GetWorkload(opts.appName, opts.svcName)
+		if err != nil {
+			return fmt.Errorf("get workload %s: %w", opts.svcName, err)
+		}
+		if wkld.Type != manifest.WorkerServiceType {
+			return fmt.Errorf("queues are only supported for services with type: %s", manifest.WorkerServiceType)
+		}
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		d, err := describe.NewQueueDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create queue describer for service %s: %w", opts.svcName, err)
+		}
+		opts.queueDescriber = d
+		opts.messenger = sqs.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcQueueOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcQueueOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+func (o *svcQueueOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcQueueAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcQueueOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcQueueNamePrompt, color.HighlightUserInput(o.appName)),
+		svcQueueNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithSvc(o.svcName),
+		selector.WithServiceTypesFilter([]string{manifest.WorkerServiceType}),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// targetQueueName returns the name of the queue the user wants to inspect.
+func (o *svcQueueOpts) targetQueueName() string {
+	if o.queueName == "" {
+		return defaultWorkerQueueName
+	}
+	return o.queueName
+}
+
+// targetQueue returns the queue of the service that the user wants to inspect.
+func (o *svcQueueOpts) targetQueue() (*describe.WorkerQueue, error) {
+	queues, err := o.queueDescriber.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("list queues of service %s: %w", o.svcName, err)
+	}
+	name := o.targetQueueName()
+	var names []string
+	for _, q := range queues {
+		if q.Name == name {
+			return q, nil
+		}
+		names = append(names, q.Name)
+	}
+	return nil, fmt.Errorf("queue %s not found in service %s, the available queues are: %s", name, o.svcName, strings.Join(names, ", "))
+}
+
+// buildSvcQueueCmd builds the command for inspecting the queues of a worker service.
+func buildSvcQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Commands for the queues of a worker service.",
+		Long: `Commands for the queues of a worker service.
+Inspect queue depths, peek at messages, and move messages from dead-letter queues back to their queue.`,
+	}
+	cmd.AddCommand(buildSvcQueueStatsCmd())
+	cmd.AddCommand(buildSvcQueuePeekCmd())
+	cmd.AddCommand(buildSvcQueueRedriveCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
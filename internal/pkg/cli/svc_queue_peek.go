@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultPeekMessages = 10
+	maxPeekMessages     = 100
+
+	sqsAttrSentTimestamp           = "SentTimestamp"
+	sqsAttrApproximateReceiveCount = "ApproximateReceiveCount"
+)
+
+type svcQueuePeekVars struct {
+	svcQueueVars
+	deadLetter       bool
+	live             bool
+	maxMessages      int
+	shouldOutputJSON bool
+}
+
+type svcQueuePeekOpts struct {
+	*svcQueueOpts
+	deadLetter       bool
+	live             bool
+	maxMessages      int
+	shouldOutputJSON bool
+}
+
+func newSvcQueuePeekOpts(vars svcQueuePeekVars) (*svcQueuePeekOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueuePeekOpts{
+		svcQueueOpts:     opts,
+		deadLetter:       vars.deadLetter,
+		live:             vars.live,
+		maxMessages:      vars.maxMessages,
+		shouldOutputJSON: vars.shouldOutputJSON,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcQueuePeekOpts) Validate() error {
+	if o.maxMessages < 1 || o.maxMessages > maxPeekMessages {
+		return fmt.Errorf("--%s must be between 1 and %d", maxMessagesFlag, maxPeekMessages)
+	}
+	if o.deadLetter && o.live {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", deadLetterFlag, liveQueueFlag)
+	}
+	if !o.deadLetter && !o.live {
+		return fmt.Errorf("peeking at a queue hides its messages from the service and increments their receive count: specify `--%s` to peek at the dead-letter queue, or `--%s` to peek at the queue anyway", deadLetterFlag, liveQueueFlag)
+	}
+	return o.svcQueueOpts.Validate()
+}
+
+// Execute prints sample messages from the queue without deleting them.
+func (o *svcQueuePeekOpts) Execute() error {
+	if err := o.initQueueClients(); err != nil {
+		return err
+	}
+	q, err := o.targetQueue()
+	if err != nil {
+		return err
+	}
+	url := q.URL
+	if o.deadLetter {
+		if q.DeadLetterURL == "" {
+			return fmt.Errorf("queue %s does not have a dead-letter queue", q.Name)
+		}
+		url = q.DeadLetterURL
+	} else {
+		log.Warningf("The messages of queue %s are hidden from the service while they're peeked, and their receive count counts towards the `dead_letter.tries` of the queue.\n", q.Name)
+	}
+	msgs, err := o.messenger.Peek(url, o.maxMessages)
+	if err != nil {
+		return fmt.Errorf("peek messages of queue %s: %w", q.Name, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Messages []*sqs.Message `json:"messages"`
+		}{
+			Messages: msgs,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal messages: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(msgs) == 0 {
+		log.Infof("No messages available in %s.\n", o.queueDescription(q.Name))
+		return nil
+	}
+	for _, msg := range msgs {
+		fmt.Fprint(o.w, humanizeQueueMessage(msg))
+	}
+	return nil
+}
+
+func (o *svcQueuePeekOpts) queueDescription(name string) string {
+	if o.deadLetter {
+		return fmt.Sprintf("the dead-letter queue of %s", name)
+	}
+	return fmt.Sprintf("queue %s", name)
+}
+
+func humanizeQueueMessage(msg *sqs.Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", color.Bold.Sprintf("Message %s", msg.ID))
+	if sent, err := strconv.ParseInt(msg.Attributes[sqsAttrSentTimestamp], 10, 64); err == nil {
+		fmt.Fprintf(&b, "  Sent At        %s\n", time.Unix(0, sent*int64(time.Millisecond)).UTC().Format(time.RFC3339))
+	}
+	if count, ok := msg.Attributes[sqsAttrApproximateReceiveCount]; ok {
+		fmt.Fprintf(&b, "  Receive Count  %s\n", count)
+	}
+	if len(msg.MessageAttributes) > 0 {
+		var attrs []string
+		for name, val := range msg.MessageAttributes {
+			attrs = append(attrs, fmt.Sprintf("%s=%s", name, val))
+		}
+		sort.Strings(attrs)
+		fmt.Fprintf(&b, "  Attributes     %s\n", strings.Join(attrs, ", "))
+	}
+	fmt.Fprintf(&b, "  Body\n    %s\n\n", strings.ReplaceAll(msg.Body, "\n", "\n    "))
+	return b.String()
+}
+
+// buildSvcQueuePeekCmd builds the command for printing sample messages of a worker service's queue.
+func buildSvcQueuePeekCmd() *cobra.Command {
+	vars := svcQueuePeekVars{}
+	cmd := &cobra.Command{
+		Use:   "peek",
+		Short: "Prints sample messages from a worker service's queue without deleting them.",
+		Long: `Prints sample messages from a worker service's queue without deleting them.
+Specify --dlq to peek at the messages that the service failed to process.
+Specify --live to peek at the queue that the service consumes. The messages are hidden from
+the service until they're printed, and their receive count is incremented.`,
+
+		Example: `
+  Prints up to 10 messages from the dead-letter queue of the worker service "my-worker".
+  /code $ copilot svc queue peek -n my-worker -e test --dlq
+  Prints up to 50 messages from the dead-letter queue of the queue subscribed to the "orders" topic of the "api" service.
+  /code $ copilot svc queue peek -n my-worker -e test --queue apiordersEventsQueue --dlq --max 50
+  Prints up to 10 messages that the worker service "my-worker" hasn't processed yet.
+  /code $ copilot svc queue peek -n my-worker -e test --live`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueuePeekOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueFlagDescription)
+	cmd.Flags().BoolVar(&vars.deadLetter, deadLetterFlag, false, deadLetterFlagDescription)
+	cmd.Flags().BoolVar(&vars.live, liveQueueFlag, false, liveQueueFlagDescription)
+	cmd.Flags().IntVar(&vars.maxMessages, maxMessagesFlag, defaultPeekMessages, peekMaxFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,186 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcQueuePeekOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inMax        int
+		inDeadLetter bool
+		inLive       bool
+
+		wantedError error
+	}{
+		"errors if max is too small": {
+			inMax:        0,
+			inDeadLetter: true,
+			wantedError:  errors.New("--max must be between 1 and 100"),
+		},
+		"errors if max is too large": {
+			inMax:        101,
+			inDeadLetter: true,
+			wantedError:  errors.New("--max must be between 1 and 100"),
+		},
+		"errors if neither the dead-letter queue nor the live queue is selected": {
+			inMax:       10,
+			wantedError: errors.New("peeking at a queue hides its messages from the service and increments their receive count: specify `--dlq` to peek at the dead-letter queue, or `--live` to peek at the queue anyway"),
+		},
+		"errors if both the dead-letter queue and the live queue are selected": {
+			inMax:        10,
+			inDeadLetter: true,
+			inLive:       true,
+			wantedError:  errors.New("cannot specify both `--dlq` and `--live`"),
+		},
+		"success with the dead-letter queue": {
+			inMax:        100,
+			inDeadLetter: true,
+		},
+		"success with the live queue": {
+			inMax:  100,
+			inLive: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &svcQueuePeekOpts{
+				svcQueueOpts: &svcQueueOpts{},
+				deadLetter:   tc.inDeadLetter,
+				live:         tc.inLive,
+				maxMessages:  tc.inMax,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueuePeekOpts_Execute(t *testing.T) {
+	mockMsgs := []*sqs.Message{
+		{
+			ID:   "1a2b",
+			Body: `{"orderId": 1}`,
+			Attributes: map[string]string{
+				"SentTimestamp":           "1635768000000",
+				"ApproximateReceiveCount": "3",
+			},
+			MessageAttributes: map[string]string{
+				"type":   "order",
+				"source": "api",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inQueue          string
+		inDeadLetter     bool
+		inLive           bool
+		shouldOutputJSON bool
+		setupMocks       func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if the queue does not have a dead-letter queue": {
+			inQueue:      "apiordersEventsQueue",
+			inDeadLetter: true,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedError: errors.New("queue apiordersEventsQueue does not have a dead-letter queue"),
+		},
+		"errors if failed to peek messages": {
+			inLive: true,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().Peek(mockWorkerQueueURL, 10).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("peek messages of queue EventsQueue: some error"),
+		},
+		"prints the messages of the dead-letter queue": {
+			inDeadLetter: true,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().Peek(mockWorkerDLQURL, 10).Return(mockMsgs, nil)
+			},
+			wantedContent: `Message 1a2b
+  Sent At        2021-11-01T12:00:00Z
+  Receive Count  3
+  Attributes     source=api, type=order
+  Body
+    {"orderId": 1}
+
+`,
+		},
+		"prints the messages in JSON": {
+			inLive:           true,
+			shouldOutputJSON: true,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().Peek(mockWorkerQueueURL, 10).Return(mockMsgs, nil)
+			},
+			wantedContent: `{"messages":[{"id":"1a2b","body":"{\"orderId\": 1}","attributes":{"ApproximateReceiveCount":"3","SentTimestamp":"1635768000000"},"messageAttributes":{"source":"api","type":"order"}}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockworkerQueueDescriber(ctrl)
+			mockMessenger := mocks.NewMockqueueMessenger(ctrl)
+			tc.setupMocks(mockDescriber, mockMessenger)
+			b := &bytes.Buffer{}
+			opts := &svcQueuePeekOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{
+						appName:   "my-app",
+						envName:   "test",
+						svcName:   "worker",
+						queueName: tc.inQueue,
+					},
+					w:                b,
+					queueDescriber:   mockDescriber,
+					messenger:        mockMessenger,
+					initQueueClients: func() error { return nil },
+				},
+				deadLetter:       tc.inDeadLetter,
+				live:             tc.inLive,
+				maxMessages:      10,
+				shouldOutputJSON: tc.shouldOutputJSON,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
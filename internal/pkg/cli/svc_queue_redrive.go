@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultRedriveRate = 10
+
+	fmtSvcQueueRedriveConfirmPrompt = "Are you sure you want to move messages from the dead-letter queue of %s back to the queue?"
+	fmtSvcQueueRedriveStart         = "Moving messages from the dead-letter queue of %s back to the queue."
+	fmtSvcQueueRedriveFailed        = "Moved %d messages from the dead-letter queue of %s before failing.\n"
+	fmtSvcQueueRedriveSucceed       = "Moved %d messages from the dead-letter queue of %s back to the queue.\n"
+)
+
+type svcQueueRedriveVars struct {
+	svcQueueVars
+	maxMessages      int
+	rate             int
+	skipConfirmation bool
+}
+
+type svcQueueRedriveOpts struct {
+	*svcQueueOpts
+	maxMessages      int
+	rate             int
+	skipConfirmation bool
+
+	prog  progress
+	sleep func(time.Duration)
+}
+
+func newSvcQueueRedriveOpts(vars svcQueueRedriveVars) (*svcQueueRedriveOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueueRedriveOpts{
+		svcQueueOpts:     opts,
+		maxMessages:      vars.maxMessages,
+		rate:             vars.rate,
+		skipConfirmation: vars.skipConfirmation,
+		prog:             termprogress.NewSpinner(log.DiagnosticWriter),
+		sleep:            time.Sleep,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcQueueRedriveOpts) Validate() error {
+	if o.maxMessages < 0 {
+		return fmt.Errorf("--%s cannot be negative", maxMessagesFlag)
+	}
+	if o.rate < 1 {
+		return fmt.Errorf("--%s must be at least 1", rateFlag)
+	}
+	return o.svcQueueOpts.Validate()
+}
+
+// Ask asks for fields that are required but not passed in, and confirms the redrive.
+func (o *svcQueueRedriveOpts) Ask() error {
+	if err := o.svcQueueOpts.Ask(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcQueueRedriveConfirmPrompt, color.HighlightUserInput(o.targetQueueName())), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc queue redrive confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("svc queue redrive cancelled - no messages moved")
+	}
+	return nil
+}
+
+// Execute moves messages from the dead-letter queue back to the source queue.
+func (o *svcQueueRedriveOpts) Execute() error {
+	if err := o.initQueueClients(); err != nil {
+		return err
+	}
+	q, err := o.targetQueue()
+	if err != nil {
+		return err
+	}
+	if q.DeadLetterURL == "" {
+		return fmt.Errorf("queue %s does not have a dead-letter queue", q.Name)
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcQueueRedriveStart, q.Name))
+	moved, err := o.redrive(q)
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcQueueRedriveFailed, moved, q.Name))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcQueueRedriveSucceed, moved, q.Name))
+	return nil
+}
+
+// redrive moves messages in batches until the messages that were in the dead-letter queue when it started are moved,
+// the dead-letter queue is drained, or the maximum is reached, waiting between batches so that no more than rate messages
+// are moved per second. Bounding the moves by the initial count keeps messages that keep failing from being moved forever.
+func (o *svcQueueRedriveOpts) redrive(q *describe.WorkerQueue) (int, error) {
+	attrs, err := o.messenger.QueueAttributes(q.DeadLetterURL)
+	if err != nil {
+		return 0, fmt.Errorf("get the number of messages in the dead-letter queue of %s: %w", q.Name, err)
+	}
+	limit := int(attrs.Visible)
+	if o.maxMessages > 0 && o.maxMessages < limit {
+		limit = o.maxMessages
+	}
+	var moved int
+	for moved < limit {
+		batch := o.rate
+		if limit-moved < batch {
+			batch = limit - moved
+		}
+		n, err := o.messenger.MoveMessages(q.DeadLetterURL, q.URL, batch)
+		moved += n
+		if err != nil {
+			return moved, fmt.Errorf("move messages from the dead-letter queue of %s: %w", q.Name, err)
+		}
+		if n == 0 {
+			break
+		}
+		o.sleep(time.Duration(n) * time.Second / time.Duration(o.rate))
+	}
+	return moved, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcQueueRedriveOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the progress of the service processing the messages.",
+			color.HighlightCode(fmt.Sprintf("copilot svc queue stats -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+// buildSvcQueueRedriveCmd builds the command for moving messages from a dead-letter queue back to the queue.
+func buildSvcQueueRedriveCmd() *cobra.Command {
+	vars := svcQueueRedriveVars{}
+	cmd := &cobra.Command{
+		Use:   "redrive",
+		Short: "Moves messages from a worker service's dead-letter queue back to the queue.",
+		Long: `Moves messages from a worker service's dead-letter queue back to the queue.
+Messages are deleted from the dead-letter queue only once they're sent to the queue.`,
+
+		Example: `
+  Moves all the messages from the dead-letter queue of the worker service "my-worker" back to the queue.
+  /code $ copilot svc queue redrive -n my-worker -e test
+  Moves up to 500 messages, at most 5 per second, without a confirmation prompt.
+  /code $ copilot svc queue redrive -n my-worker -e test --max 500 --rate 5 --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueueRedriveOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueFlagDescription)
+	cmd.Flags().IntVar(&vars.maxMessages, maxMessagesFlag, 0, redriveMaxFlagDescription)
+	cmd.Flags().IntVar(&vars.rate, rateFlag, defaultRedriveRate, redriveRateFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcQueueRedriveOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inMax  int
+		inRate int
+
+		wantedError error
+	}{
+		"errors if max is negative": {
+			inMax:       -1,
+			inRate:      10,
+			wantedError: errors.New("--max cannot be negative"),
+		},
+		"errors if rate is not positive": {
+			inRate:      0,
+			wantedError: errors.New("--rate must be at least 1"),
+		},
+		"success": {
+			inMax:  100,
+			inRate: 10,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &svcQueueRedriveOpts{
+				svcQueueOpts: &svcQueueOpts{},
+				maxMessages:  tc.inMax,
+				rate:         tc.inRate,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueueRedriveOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(p *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"skips confirmation": {
+			skipConfirmation: true,
+			setupMocks:       func(p *mocks.Mockprompter) {},
+		},
+		"errors if failed to confirm": {
+			setupMocks: func(p *mocks.Mockprompter) {
+				p.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("svc queue redrive confirmation prompt: some error"),
+		},
+		"errors if the redrive is cancelled": {
+			setupMocks: func(p *mocks.Mockprompter) {
+				p.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errors.New("svc queue redrive cancelled - no messages moved"),
+		},
+		"success": {
+			setupMocks: func(p *mocks.Mockprompter) {
+				p.EXPECT().Confirm(gomock.Any(), "", gomock.Any()).Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			mockSel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "my-app", gomock.Any()).Return(&selector.DeployedService{
+				Env: "test",
+				Svc: "worker",
+			}, nil)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompt)
+			opts := &svcQueueRedriveOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{
+						appName: "my-app",
+					},
+					sel:    mockSel,
+					prompt: mockPrompt,
+				},
+				skipConfirmation: tc.skipConfirmation,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueueRedriveOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inQueue    string
+		inMax      int
+		inRate     int
+		setupMocks func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress)
+
+		wantedSleeps []time.Duration
+		wantedError  error
+	}{
+		"errors if the queue does not have a dead-letter queue": {
+			inQueue: "apiordersEventsQueue",
+			inRate:  10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedError: errors.New("queue apiordersEventsQueue does not have a dead-letter queue"),
+		},
+		"errors if failed to get the number of messages in the dead-letter queue": {
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(nil, errors.New("some error"))
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("get the number of messages in the dead-letter queue of EventsQueue: some error"),
+		},
+		"errors if failed to move messages": {
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{Visible: 100}, nil)
+				m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 10).Return(10, nil)
+				m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 10).Return(3, errors.New("some error"))
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedSleeps: []time.Duration{time.Second},
+			wantedError:  errors.New("move messages from the dead-letter queue of EventsQueue: some error"),
+		},
+		"moves messages until the dead-letter queue is empty": {
+			inRate: 5,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				gomock.InOrder(
+					m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{Visible: 100}, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 5).Return(5, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 5).Return(2, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 5).Return(0, nil),
+				)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedSleeps: []time.Duration{time.Second, 400 * time.Millisecond},
+		},
+		"stops once the maximum number of messages is moved": {
+			inMax:  12,
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				gomock.InOrder(
+					m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{Visible: 100}, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 10).Return(10, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 2).Return(2, nil),
+				)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedSleeps: []time.Duration{time.Second, 200 * time.Millisecond},
+		},
+		"stops once the messages initially in the dead-letter queue are moved": {
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				gomock.InOrder(
+					m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{Visible: 15}, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 10).Return(10, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 5).Return(5, nil),
+				)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedSleeps: []time.Duration{time.Second, 500 * time.Millisecond},
+		},
+		"stops at the smaller of the maximum and the messages initially in the dead-letter queue": {
+			inMax:  20,
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				gomock.InOrder(
+					m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{Visible: 7}, nil),
+					m.EXPECT().MoveMessages(mockWorkerDLQURL, mockWorkerQueueURL, 7).Return(7, nil),
+				)
+				p.EXPECT().Stop(gomock.Any())
+			},
+			wantedSleeps: []time.Duration{700 * time.Millisecond},
+		},
+		"does not move messages if the dead-letter queue is empty": {
+			inRate: 10,
+			setupMocks: func(d *mocks.MockworkerQueueDescriber, m *mocks.MockqueueMessenger, p *mocks.Mockprogress) {
+				d.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				p.EXPECT().Start(gomock.Any())
+				m.EXPECT().QueueAttributes(mockWorkerDLQURL).Return(&sqs.QueueAttributes{}, nil)
+				p.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockworkerQueueDescriber(ctrl)
+			mockMessenger := mocks.NewMockqueueMessenger(ctrl)
+			mockProg := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockDescriber, mockMessenger, mockProg)
+			var sleeps []time.Duration
+			opts := &svcQueueRedriveOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{
+						appName:   "my-app",
+						envName:   "test",
+						svcName:   "worker",
+						queueName: tc.inQueue,
+					},
+					queueDescriber:   mockDescriber,
+					messenger:        mockMessenger,
+					initQueueClients: func() error { return nil },
+				},
+				maxMessages: tc.inMax,
+				rate:        tc.inRate,
+				prog:        mockProg,
+				sleep: func(d time.Duration) {
+					sleeps = append(sleeps, d)
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedSleeps, sleeps)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/spf13/cobra"
+)
+
+type svcQueueStatsVars struct {
+	svcQueueVars
+	shouldOutputJSON bool
+}
+
+type svcQueueStatsOpts struct {
+	*svcQueueOpts
+	shouldOutputJSON bool
+}
+
+func newSvcQueueStatsOpts(vars svcQueueStatsVars) (*svcQueueStatsOpts, error) {
+	opts, err := newSvcQueueOpts(vars.svcQueueVars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcQueueStatsOpts{
+		svcQueueOpts:     opts,
+		shouldOutputJSON: vars.shouldOutputJSON,
+	}, nil
+}
+
+// Execute displays the message counts of the queues of the service.
+func (o *svcQueueStatsOpts) Execute() error {
+	if err := o.initQueueClients(); err != nil {
+		return err
+	}
+	queues, err := o.queues()
+	if err != nil {
+		return err
+	}
+	stats := &describe.WorkerQueuesStats{
+		Queues: make([]describe.QueueStats, 0, len(queues)),
+	}
+	for _, q := range queues {
+		s, err := o.queueDescriber.QueueStats(q)
+		if err != nil {
+			return err
+		}
+		stats.Queues = append(stats.Queues, *s)
+	}
+	if o.shouldOutputJSON {
+		data, err := stats.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, stats.HumanString())
+	return nil
+}
+
+func (o *svcQueueStatsOpts) queues() ([]*describe.WorkerQueue, error) {
+	if o.queueName != "" {
+		q, err := o.targetQueue()
+		if err != nil {
+			return nil, err
+		}
+		return []*describe.WorkerQueue{q}, nil
+	}
+	queues, err := o.queueDescriber.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("list queues of service %s: %w", o.svcName, err)
+	}
+	return queues, nil
+}
+
+// buildSvcQueueStatsCmd builds the command for showing the message counts of the queues of a worker service.
+func buildSvcQueueStatsCmd() *cobra.Command {
+	vars := svcQueueStatsVars{}
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Shows the message counts of a worker service's queues.",
+		Long: `Shows the message counts of a worker service's queues.
+Displays the number of available, in-flight and delayed messages, the age of the oldest message,
+and the number of messages in the dead-letter queue.`,
+
+		Example: `
+  Shows the stats of all the queues of the worker service "my-worker".
+  /code $ copilot svc queue stats -n my-worker -e test
+  Shows the stats of the queue subscribed to the "orders" topic of the "api" service in JSON.
+  /code $ copilot svc queue stats -n my-worker -e test --queue apiordersEventsQueue --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcQueueStatsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", queueStatsFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcQueueStatsOpts_Execute(t *testing.T) {
+	dlqMessages := int64(4)
+	testCases := map[string]struct {
+		inQueue          string
+		shouldOutputJSON bool
+		setupMocks       func(m *mocks.MockworkerQueueDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to get queue stats": {
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().QueueStats(mockWorkerQueues[0]).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"prints the stats of every queue": {
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().QueueStats(mockWorkerQueues[0]).Return(&describe.QueueStats{
+					Name:               "EventsQueue",
+					Messages:           12,
+					DeadLetterMessages: &dlqMessages,
+				}, nil)
+				m.EXPECT().QueueStats(mockWorkerQueues[1]).Return(&describe.QueueStats{
+					Name: "apiordersEventsQueue",
+				}, nil)
+			},
+			wantedContent: `{"queues":[{"name":"EventsQueue","messages":12,"inFlight":0,"delayed":0,"deadLetterMessages":4},{"name":"apiordersEventsQueue","messages":0,"inFlight":0,"delayed":0}]}
+`,
+		},
+		"prints the stats of the given queue only": {
+			inQueue:          "apiordersEventsQueue",
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+				m.EXPECT().QueueStats(mockWorkerQueues[1]).Return(&describe.QueueStats{
+					Name: "apiordersEventsQueue",
+				}, nil)
+			},
+			wantedContent: `{"queues":[{"name":"apiordersEventsQueue","messages":0,"inFlight":0,"delayed":0}]}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockworkerQueueDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			b := &bytes.Buffer{}
+			opts := &svcQueueStatsOpts{
+				svcQueueOpts: &svcQueueOpts{
+					svcQueueVars: svcQueueVars{
+						appName:   "my-app",
+						envName:   "test",
+						svcName:   "worker",
+						queueName: tc.inQueue,
+					},
+					w:                b,
+					queueDescriber:   mockDescriber,
+					initQueueClients: func() error { return nil },
+				},
+				shouldOutputJSON: tc.shouldOutputJSON,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockWorkerQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-EventsQueue-1A2B"
+	mockWorkerDLQURL   = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-DeadLetterQueue-3C4D"
+	mockOrdersQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-apiordersEventsQueue-5E6F"
+)
+
+var mockWorkerQueues = []*describe.WorkerQueue{
+	{
+		Name:          "EventsQueue",
+		URL:           mockWorkerQueueURL,
+		DeadLetterURL: mockWorkerDLQURL,
+	},
+	{
+		Name: "apiordersEventsQueue",
+		URL:  mockOrdersQueueURL,
+	},
+}
+
+func TestSvcQueueOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		inSvc      string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip validation if app flag is not set": {
+			inSvc:      "worker",
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"invalid app name": {
+			inApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"invalid service name": {
+			inApp: "my-app",
+			inSvc: "worker",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetService("my-app", "worker").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"invalid environment name": {
+			inApp: "my-app",
+			inEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inApp: "my-app",
+			inEnv: "test",
+			inSvc: "worker",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetService("my-app", "worker").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &svcQueueOpts{
+				svcQueueVars: svcQueueVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcQueueOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcQueueAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"errors if failed to select a deployed worker service": {
+			inApp: "my-app",
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(gomock.Any(), svcQueueNameHelpPrompt, "my-app", gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application my-app: some error"),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcQueueAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().DeployedService(gomock.Any(), svcQueueNameHelpPrompt, "my-app", gomock.Any()).Return(&selector.DeployedService{
+					Env: "test",
+					Svc: "worker",
+				}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+			wantedSvc: "worker",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := &svcQueueOpts{
+				svcQueueVars: svcQueueVars{
+					appName: tc.inApp,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcQueueOpts_targetQueue(t *testing.T) {
+	testCases := map[string]struct {
+		inQueue    string
+		setupMocks func(m *mocks.MockworkerQueueDescriber)
+
+		wantedQueue *describe.WorkerQueue
+		wantedError error
+	}{
+		"errors if failed to list queues": {
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list queues of service worker: some error"),
+		},
+		"errors if the queue does not exist": {
+			inQueue: "workerPaymentsEventsQueue",
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedError: errors.New("queue workerPaymentsEventsQueue not found in service worker, the available queues are: EventsQueue, apiordersEventsQueue"),
+		},
+		"defaults to the main queue": {
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedQueue: mockWorkerQueues[0],
+		},
+		"returns the queue with the given name": {
+			inQueue: "apiordersEventsQueue",
+			setupMocks: func(m *mocks.MockworkerQueueDescriber) {
+				m.EXPECT().Queues().Return(mockWorkerQueues, nil)
+			},
+			wantedQueue: mockWorkerQueues[1],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockworkerQueueDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			opts := &svcQueueOpts{
+				svcQueueVars: svcQueueVars{
+					svcName:   "worker",
+					queueName: tc.inQueue,
+				},
+				queueDescriber: mockDescriber,
+			}
+
+			// WHEN
+			q, err := opts.targetQueue()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueue, q)
+		})
+	}
+}
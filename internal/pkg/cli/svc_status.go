@@ -6,6 +6,7 @@ package cli
 import (
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -27,6 +28,7 @@ type svcStatusVars struct {
 	svcName          string
 	envName          string
 	appName          string
+	watch            bool
 }
 
 type svcStatusOpts struct {
@@ -37,6 +39,7 @@ type svcStatusOpts struct {
 	statusDescriber     statusDescriber
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
+	watchStatus         func(in watchStatusInput) error
 }
 
 func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
@@ -83,6 +86,7 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 			}
 			return nil
 		},
+		watchStatus: watchStatus,
 	}, nil
 }
 
@@ -121,6 +125,15 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.watch {
+		return o.watchStatus(watchStatusInput{
+			resource:         fmt.Sprintf("service %s", o.svcName),
+			poller:           describe.NewStatusPoller(o.statusDescriber),
+			shouldOutputJSON: o.shouldOutputJSON,
+			renderOut:        os.Stderr,
+			jsonOut:          o.w,
+		})
+	}
 	svcStatus, err := o.statusDescriber.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
@@ -170,7 +183,9 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Waits until the service "my-svc" is stable and exits with a non-zero code if its rollout failed.
+  /code $ copilot svc status -n my-svc -e test --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -183,5 +198,6 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, svcWatchFlagDescription)
 	return cmd
 }
@@ -191,6 +191,8 @@ func TestSvcStatus_Execute(t *testing.T) {
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON    bool
+		watch               bool
+		watchErr            error
 		mockStatusDescriber func(m *mocks.MockstatusDescriber)
 		wantedError         error
 	}{
@@ -200,6 +202,15 @@ func TestSvcStatus_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("describe status of service mockSvc: some error"),
 		},
+		"returns the error if the watched service failed": {
+			watch: true,
+			watchErr: &errStatusFailed{
+				resource: "service mockSvc",
+				reason:   "rollout of deployment ecs-svc/123 failed",
+			},
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {},
+			wantedError:         fmt.Errorf("service mockSvc settled in a failed state: rollout of deployment ecs-svc/123 failed"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -216,10 +227,15 @@ func TestSvcStatus_Execute(t *testing.T) {
 					envName:          "mockEnv",
 					shouldOutputJSON: tc.shouldOutputJSON,
 					appName:          "mockApp",
+					watch:            tc.watch,
 				},
 				statusDescriber:     mockStatusDescriber,
 				initStatusDescriber: func(*svcStatusOpts) error { return nil },
-				w:                   b,
+				watchStatus: func(in watchStatusInput) error {
+					require.Equal(t, "service mockSvc", in.resource)
+					return tc.watchErr
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+var (
+	taskPortForwardTaskPrompt     = fmt.Sprintf("Which %s would you like to forward a port to?", color.Emphasize("task"))
+	taskPortForwardTaskHelpPrompt = fmt.Sprintf("By default we'll forward the port through the first %s of the task.", color.Emphasize("essential container"))
+)
+
+type taskPortForwardVars struct {
+	portForwardVars
+	useDefault bool
+}
+
+type taskPortForwardOpts struct {
+	taskPortForwardVars
+	store            store
+	ssmPluginManager ssmPluginManager
+	prompter         prompter
+	newTaskSel       func(*session.Session) runningTaskSelector
+	configSel        appEnvSelector
+	newPortForwarder func(*session.Session) ecsPortForwarder
+	provider         sessionProvider
+
+	task       *awsecs.Task
+	localPort  string
+	remotePort string
+}
+
+func newTaskPortForwardOpts(vars taskPortForwardVars) (*taskPortForwardOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	prompter := prompt.New()
+	return &taskPortForwardOpts{
+		taskPortForwardVars: vars,
+		store:               ssmStore,
+		ssmPluginManager:    exec.NewSSMPluginCommand(nil),
+		prompter:            prompter,
+		newTaskSel: func(sess *session.Session) runningTaskSelector {
+			return selector.NewTaskSelect(prompter, ecs.New(sess))
+		},
+		configSel: selector.NewConfigSelect(prompter, ssmStore),
+		newPortForwarder: func(s *session.Session) ecsPortForwarder {
+			return ssm.New(s)
+		},
+		provider: sessions.NewProvider(),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *taskPortForwardOpts) Validate() error {
+	if o.useDefault && (o.appName != tryReadingAppName() || o.envName != "") {
+		return fmt.Errorf("cannot specify both default flag and app or env flags")
+	}
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+	}
+	local, remote, err := parsePortMapping(o.ports)
+	if err != nil {
+		return err
+	}
+	o.localPort, o.remotePort = local, remote
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *taskPortForwardOpts) Ask() error {
+	if o.useDefault {
+		return o.selectTaskInDefaultCluster()
+	}
+	if o.appName == "" {
+		appName, err := o.configSel.Application(taskExecAppNamePrompt, taskExecAppNameHelpPrompt, useDefaultClusterOption)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		if appName == useDefaultClusterOption {
+			o.useDefault = true
+			return o.selectTaskInDefaultCluster()
+		}
+		o.appName = appName
+	}
+	if o.envName == "" {
+		envName, err := o.configSel.Environment(taskExecEnvNamePrompt, taskExecEnvNameHelpPrompt, o.appName, useDefaultClusterOption)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		if envName == useDefaultClusterOption {
+			o.useDefault = true
+			return o.selectTaskInDefaultCluster()
+		}
+		o.envName = envName
+	}
+	return o.selectTaskInAppEnvCluster()
+}
+
+// Execute forwards a local port to a port in a running container of the task,
+// or to a remote host reachable from the container.
+func (o *taskPortForwardOpts) Execute() error {
+	sess, err := o.configSession()
+	if err != nil {
+		return err
+	}
+	container := o.containerName
+	if container == "" {
+		container = aws.StringValue(o.task.Containers[0].Name)
+	}
+	target, err := o.task.SSMTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session manager target: %w", err)
+	}
+	return forwardPort(o.newPortForwarder(sess), ssm.ForwardPortInput{
+		Target:     target,
+		LocalPort:  o.localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: o.remoteHost,
+	}, container, o.task)
+}
+
+func (o *taskPortForwardOpts) selectTaskInDefaultCluster() error {
+	sess, err := o.provider.Default()
+	if err != nil {
+		return fmt.Errorf("create default session: %w", err)
+	}
+	task, err := o.newTaskSel(sess).RunningTask(taskPortForwardTaskPrompt, taskPortForwardTaskHelpPrompt,
+		selector.WithDefault(), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+	if err != nil {
+		return fmt.Errorf("select running task in default cluster: %w", err)
+	}
+	o.task = task
+	return nil
+}
+
+func (o *taskPortForwardOpts) selectTaskInAppEnvCluster() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.provider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	task, err := o.newTaskSel(sess).RunningTask(taskPortForwardTaskPrompt, taskPortForwardTaskHelpPrompt,
+		selector.WithAppEnv(o.appName, o.envName), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+	if err != nil {
+		return fmt.Errorf("select running task in environment %s: %w", o.envName, err)
+	}
+	o.task = task
+	return nil
+}
+
+func (o *taskPortForwardOpts) configSession() (*session.Session, error) {
+	if o.useDefault {
+		return o.provider.Default()
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	return o.provider.FromRole(env.ManagerRoleARN, env.Region)
+}
+
+// buildTaskPortForwardCmd builds the command for forwarding a local port to a running container in a one-off task.
+func buildTaskPortForwardCmd() *cobra.Command {
+	var skipPrompt bool
+	vars := taskPortForwardVars{}
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running container part of a task.",
+		Example: `
+  Forward local port 8080 to port 80 of a task in task group "db-migrate" in the "test" environment.
+  /code $ copilot task port-forward -e test -n db-migrate --port 8080:80
+  Forward local port 5432 to a database endpoint through a task prefixed with ID "38c3818" in the default cluster.
+  /code $ copilot task port-forward --default --task-id 38c3818 --port 5432 --remote-host mydb.cluster-abc.us-west-2.rds.amazonaws.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTaskPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.ports, svcPortFlag, "", portForwardPortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", taskRemoteHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", portForwardContainerFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskPortForwardDefaultFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskPortForward_Execute(t *testing.T) {
+	const (
+		mockApp     = "my-app"
+		mockEnv     = "my-env"
+		mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockTask := &awsecs.Task{
+		TaskArn:    aws.String(mockTaskARN),
+		ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"),
+		Containers: []*ecsapi.Container{
+			{
+				Name:      aws.String("main"),
+				RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1"),
+			},
+			{
+				Name: aws.String("sidecar"),
+			},
+		},
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inUseDefault bool
+		inContainer  string
+		inRemoteHost string
+		setupMocks   func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, forwarder *mocks.MockecsPortForwarder)
+
+		wantedError error
+	}{
+		"should bubble error if fail to get environment": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MocksessionProvider, _ *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetEnvironment(mockApp, mockEnv).Return(nil, mockErr)
+			},
+
+			wantedError: fmt.Errorf("get environment my-env: some error"),
+		},
+		"should bubble error if the container is not running yet": {
+			inUseDefault: true,
+			inContainer:  "sidecar",
+			setupMocks: func(_ *mocks.Mockstore, provider *mocks.MocksessionProvider, _ *mocks.MockecsPortForwarder) {
+				provider.EXPECT().Default()
+			},
+
+			wantedError: fmt.Errorf("get session manager target: container sidecar in task 4082490ee6c245e09d2145010aa1ba8d does not have a runtime ID yet"),
+		},
+		"should bubble error if fail to forward port": {
+			inUseDefault: true,
+			setupMocks: func(_ *mocks.Mockstore, provider *mocks.MocksessionProvider, forwarder *mocks.MockecsPortForwarder) {
+				provider.EXPECT().Default()
+				forwarder.EXPECT().ForwardPort(gomock.Any()).Return(mockErr)
+			},
+
+			wantedError: fmt.Errorf("forward local port 5432 to port 5432: some error"),
+		},
+		"success": {
+			inRemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(store *mocks.Mockstore, provider *mocks.MocksessionProvider, forwarder *mocks.MockecsPortForwarder) {
+				store.EXPECT().GetEnvironment(mockApp, mockEnv).Return(&config.Environment{}, nil)
+				provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				forwarder.EXPECT().ForwardPort(ssm.ForwardPortInput{
+					Target:     "ecs:mockCluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-1",
+					LocalPort:  "5432",
+					RemotePort: "5432",
+					RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockProvider := mocks.NewMocksessionProvider(ctrl)
+			mockForwarder := mocks.NewMockecsPortForwarder(ctrl)
+			tc.setupMocks(mockStore, mockProvider, mockForwarder)
+
+			opts := &taskPortForwardOpts{
+				taskPortForwardVars: taskPortForwardVars{
+					portForwardVars: portForwardVars{
+						appName:       mockApp,
+						envName:       mockEnv,
+						containerName: tc.inContainer,
+						remoteHost:    tc.inRemoteHost,
+					},
+					useDefault: tc.inUseDefault,
+				},
+				task:  mockTask,
+				store: mockStore,
+				newPortForwarder: func(_ *session.Session) ecsPortForwarder {
+					return mockForwarder
+				},
+				provider:   mockProvider,
+				localPort:  "5432",
+				remotePort: "5432",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
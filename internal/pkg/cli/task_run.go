@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -16,6 +17,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -44,6 +46,7 @@ import (
 	"github.com/google/shlex"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -61,6 +64,8 @@ const (
 
 const (
 	fmtImageURI = "%s:%s"
+
+	exitCodeTaskTimeout = 124 // Exit code when tasks are stopped after exceeding the timeout, following timeout(1).
 )
 
 var (
@@ -69,6 +74,36 @@ var (
 	errMemNotPositive = errors.New("memory must be positive")
 )
 
+// errTaskExited is returned when a task's container exited with a non-zero code.
+type errTaskExited struct {
+	groupName string
+	exitCode  int
+}
+
+func (e *errTaskExited) Error() string {
+	return fmt.Sprintf("task %s exited with code %d", e.groupName, e.exitCode)
+}
+
+// ExitCode returns the exit code of the task's container so that scripts can propagate it.
+func (e *errTaskExited) ExitCode() int {
+	return e.exitCode
+}
+
+// errTaskTimeout is returned when tasks did not complete within the timeout.
+type errTaskTimeout struct {
+	groupName string
+	timeout   time.Duration
+}
+
+func (e *errTaskTimeout) Error() string {
+	return fmt.Sprintf("task %s did not complete within %s and was stopped", e.groupName, e.timeout)
+}
+
+// ExitCode returns the exit code of the program when tasks time out.
+func (e *errTaskTimeout) ExitCode() int {
+	return exitCodeTaskTimeout
+}
+
 var (
 	taskRunAppPrompt = fmt.Sprintf("In which %s would you like to run this %s?", color.Emphasize("application"), color.Emphasize("task"))
 	taskRunEnvPrompt = fmt.Sprintf("In which %s would you like to run this %s?", color.Emphasize("environment"), color.Emphasize("task"))
@@ -108,7 +143,10 @@ type runTaskVars struct {
 	resourceTags map[string]string
 
 	follow                bool
+	wait                  bool
+	timeout               time.Duration
 	generateCommandTarget string
+	fromWorkload          string
 
 	os   string
 	arch string
@@ -116,8 +154,11 @@ type runTaskVars struct {
 
 type runTaskOpts struct {
 	runTaskVars
-	isDockerfileSet bool
-	nFlag           int
+	isDockerfileSet  bool
+	isCPUSet         bool
+	isMemorySet      bool
+	nFlag            int
+	fromWorkloadType string
 
 	// Interfaces to interact with dependencies.
 	fs      afero.Fs
@@ -130,6 +171,7 @@ type runTaskOpts struct {
 	repository           repositoryService
 	runner               taskRunner
 	eventsWriter         eventsWriter
+	waiter               taskWaiter
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
 
@@ -177,6 +219,8 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.deployer = cloudformation.New(opts.sess)
 		opts.defaultClusterGetter = awsecs.New(opts.sess)
 		opts.publicIPGetter = ec2.New(opts.sess)
+		ecsClient := awsecs.New(opts.sess)
+		opts.waiter = task.NewWaiter(ecsClient, ecsClient, opts.timeout)
 		return nil
 	}
 
@@ -192,6 +236,10 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 	}
 
 	opts.configureEventsWriter = func(tasks []*task.Task) {
+		if opts.fromWorkload != "" {
+			opts.eventsWriter = logging.NewWorkloadTaskClient(opts.sess, opts.appName, opts.env, opts.fromWorkload, tasks)
+			return
+		}
 		opts.eventsWriter = logging.NewTaskClient(opts.sess, opts.groupName, tasks)
 	}
 
@@ -215,6 +263,26 @@ func (o *runTaskOpts) configureRunner() (taskRunner, error) {
 	vpcGetter := ec2.New(o.sess)
 	ecsService := awsecs.New(o.sess)
 
+	if o.fromWorkload != "" {
+		command, err := shlex.Split(o.command)
+		if err != nil {
+			return nil, fmt.Errorf("split command %s into tokens using shell-style rules: %w", o.command, err)
+		}
+		return &task.WorkloadRunner{
+			Count: o.count,
+
+			App:      o.appName,
+			Env:      o.env,
+			Workload: o.fromWorkload,
+			IsJob:    o.fromWorkloadType == workloadTypeJob,
+
+			Command: command,
+
+			Describer: ecs.New(o.sess),
+			Starter:   ecsService,
+		}, nil
+	}
+
 	if o.env != "" {
 		deployStore, err := deploy.NewStore(o.store)
 		if err != nil {
@@ -293,6 +361,12 @@ func (o *runTaskOpts) configureSessAndEnv() error {
 
 // Validate returns an error if the flag values passed by the user are invalid.
 func (o *runTaskOpts) Validate() error {
+	if o.timeout < 0 {
+		return fmt.Errorf("--%s must be a positive duration", timeoutFlag)
+	}
+	if o.timeout > 0 && !o.wait && !o.follow {
+		return fmt.Errorf("--%s requires --%s or --%s", timeoutFlag, waitFlag, followFlag)
+	}
 	if o.generateCommandTarget != "" {
 		if o.nFlag >= 2 {
 			return errors.New("cannot specify `--generate-cmd` with any other flag")
@@ -344,6 +418,10 @@ func (o *runTaskOpts) Validate() error {
 		return errMemNotPositive
 	}
 
+	if err := o.validateFromWorkloadFlags(); err != nil {
+		return err
+	}
+
 	if err := o.validateFlagsWithCluster(); err != nil {
 		return err
 	}
@@ -376,6 +454,63 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if err := o.validateFromWorkload(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFromWorkloadFlags rejects the flags that --from-workload would otherwise silently ignore,
+// since the task definition, network configuration and cluster all come from the workload's deployment.
+func (o *runTaskOpts) validateFromWorkloadFlags() error {
+	if o.fromWorkload == "" {
+		return nil
+	}
+	if o.env == "" {
+		return errors.New("must specify `--env` with `--from-workload`")
+	}
+	if o.entrypoint != "" {
+		return errors.New("cannot specify both `--entrypoint` and `--from-workload`: Amazon ECS does not support overriding the entrypoint when running a task")
+	}
+	conflicts := []struct {
+		flag  string
+		isSet bool
+	}{
+		{taskGroupNameFlag, o.groupName != ""},
+		{imageFlag, o.image != ""},
+		{dockerFileFlag, o.isDockerfileSet},
+		{dockerFileContextFlag, o.dockerfileContextPath != ""},
+		{imageTagFlag, o.imageTag != ""},
+		{taskRoleFlag, o.taskRole != ""},
+		{executionRoleFlag, o.executionRole != ""},
+		{envVarsFlag, o.envVars != nil},
+		{secretsFlag, o.secrets != nil},
+		{osFlag, o.os != ""},
+		{resourceTagsFlag, o.resourceTags != nil},
+		{cpuFlag, o.isCPUSet},
+		{memoryFlag, o.isMemorySet},
+		{subnetsFlag, o.subnets != nil},
+		{securityGroupsFlag, o.securityGroups != nil},
+		{clusterFlag, o.cluster != ""},
+	}
+	for _, conflict := range conflicts {
+		if conflict.isSet {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", conflict.flag, fromWorkloadFlag)
+		}
+	}
+	return nil
+}
+
+func (o *runTaskOpts) validateFromWorkload() error {
+	if o.fromWorkload == "" {
+		return nil
+	}
+	wkldType, err := o.workloadType(o.appName, o.fromWorkload)
+	if err != nil {
+		return err
+	}
+	o.fromWorkloadType = wkldType
 	return nil
 }
 
@@ -519,6 +654,9 @@ func (o *runTaskOpts) Execute() error {
 		return o.generateCommand()
 	}
 
+	if o.fromWorkload != "" {
+		o.groupName = o.fromWorkload
+	}
 	if o.groupName == "" {
 		dir, err := os.Getwd()
 		if err != nil {
@@ -552,6 +690,43 @@ func (o *runTaskOpts) Execute() error {
 		}
 	}
 
+	// NOTE: tasks run from a workload reuse its task definition, so there is nothing to deploy or build.
+	if o.fromWorkload == "" {
+		if err := o.provisionTaskResources(); err != nil {
+			return err
+		}
+	}
+
+	tasks, err := o.runTask()
+	if err != nil {
+		return err
+	}
+
+	o.showPublicIPs(tasks)
+
+	if !o.follow && !o.wait {
+		return nil
+	}
+	results, err := o.waitForTasks(tasks)
+	if err != nil {
+		var errTimeout *task.ErrTimeout
+		if !errors.As(err, &errTimeout) {
+			return err
+		}
+		o.showResults(results)
+		return &errTaskTimeout{groupName: o.groupName, timeout: o.timeout}
+	}
+	o.showResults(results)
+	for _, result := range results {
+		if code := result.ExitCode(o.groupName); code != 0 {
+			return &errTaskExited{groupName: o.groupName, exitCode: code}
+		}
+	}
+	return nil
+}
+
+// provisionTaskResources deploys the task's resources and, if no image is provided, builds and pushes the image.
+func (o *runTaskOpts) provisionTaskResources() error {
 	if err := o.deployTaskResources(); err != nil {
 		return err
 	}
@@ -562,35 +737,63 @@ func (o *runTaskOpts) Execute() error {
 	}
 
 	// NOTE: if image is not provided, then we build the image and push to ECR repo
-	if o.image == "" {
-		if err := o.buildAndPushImage(); err != nil {
-			return err
-		}
-
-		tag := imageTagLatest
-		if o.imageTag != "" {
-			tag = o.imageTag
-		}
-		o.image = fmt.Sprintf(fmtImageURI, o.repository.URI(), tag)
-		if err := o.updateTaskResources(); err != nil {
-			return err
-		}
+	if o.image != "" {
+		return nil
 	}
-
-	tasks, err := o.runTask()
-	if err != nil {
+	if err := o.buildAndPushImage(); err != nil {
 		return err
 	}
 
-	o.showPublicIPs(tasks)
+	tag := imageTagLatest
+	if o.imageTag != "" {
+		tag = o.imageTag
+	}
+	o.image = fmt.Sprintf(fmtImageURI, o.repository.URI(), tag)
+	return o.updateTaskResources()
+}
 
-	if o.follow {
-		o.configureEventsWriter(tasks)
-		if err := o.displayLogStream(); err != nil {
-			return err
+// waitForTasks waits until all tasks have stopped, while tailing their logs if --follow is specified.
+func (o *runTaskOpts) waitForTasks(tasks []*task.Task) ([]*task.Result, error) {
+	if !o.follow {
+		o.spinner.Start(fmt.Sprintf("Waiting for %s to complete.", english.Plural(o.count, "task", "")))
+		results, err := o.waiter.Wait(tasks)
+		if err != nil {
+			o.spinner.Stop(log.Serrorf("Failed to wait for %s to complete.\n\n", english.Plural(o.count, "task", "")))
+			return results, err
+		}
+		o.spinner.Stop(log.Ssuccessf("%s %s stopped.\n\n",
+			english.PluralWord(o.count, "Task", ""), english.PluralWord(o.count, "has", "have")))
+		return results, nil
+	}
+	o.configureEventsWriter(tasks)
+	var results []*task.Result
+	var g errgroup.Group
+	g.Go(o.displayLogStream)
+	g.Go(func() error {
+		// The waiter enforces the timeout while logs are streamed.
+		var err error
+		results, err = o.waiter.Wait(tasks)
+		return err
+	})
+	return results, g.Wait()
+}
+
+// showResults writes the stop reason and container exit codes of each task.
+func (o *runTaskOpts) showResults(results []*task.Result) {
+	for _, result := range results {
+		taskID := result.TaskARN
+		if len(taskID) >= shortTaskIDLength {
+			taskID = taskID[len(taskID)-shortTaskIDLength:]
+		}
+		log.Infof("Task %s stopped: %s\n", color.HighlightResource(taskID), result.StoppedReason)
+		for _, container := range result.Containers {
+			if container.ExitCode == nil {
+				log.Infof("- Container %s did not run: %s\n", container.Name, container.Reason)
+				continue
+			}
+			log.Infof("- Container %s exited with code %d\n", container.Name, aws.Int64Value(container.ExitCode))
 		}
 	}
-	return nil
 }
 
 func (o *runTaskOpts) generateCommand() error {
@@ -928,7 +1131,11 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Run a database migration in CI and fail the job if it exits with a non-zero code or runs longer than 30 minutes.
+  /code $ copilot task run -n db-migrate --env test --wait --timeout 30m
+  Run a Django migration with the task definition, roles, secrets and network of the "api" service.
+  /code $ copilot task run --app my-app -e test --from-workload api --command "python manage.py migrate" --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -938,6 +1145,8 @@ func BuildTaskRunCmd() *cobra.Command {
 			if cmd.Flags().Changed(dockerFileFlag) {
 				opts.isDockerfileSet = true
 			}
+			opts.isCPUSet = cmd.Flags().Changed(cpuFlag)
+			opts.isMemorySet = cmd.Flags().Changed(memoryFlag)
 			return run(opts)
 		}),
 	}
@@ -951,7 +1160,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", taskImageTagFlagDescription)
 
 	cmd.Flags().StringVar(&vars.appName, appFlag, "", taskAppFlagDescription)
-	cmd.Flags().StringVar(&vars.env, envFlag, "", taskEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", taskEnvFlagDescription)
 	cmd.Flags().StringVar(&vars.cluster, clusterFlag, "", clusterFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.subnets, subnetsFlag, nil, subnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.securityGroups, securityGroupsFlag, nil, securityGroupsFlagDescription)
@@ -971,11 +1180,15 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, taskRunWaitFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, taskRunTimeoutFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.fromWorkload, fromWorkloadFlag, "", fromWorkloadFlagDescription)
 
 	// group flags.
 	nameFlags := pflag.NewFlagSet("Name", pflag.ContinueOnError)
 	nameFlags.AddFlag(cmd.Flags().Lookup(taskGroupNameFlag))
+	nameFlags.AddFlag(cmd.Flags().Lookup(fromWorkloadFlag))
 
 	buildFlags := pflag.NewFlagSet("Build", pflag.ContinueOnError)
 	buildFlags.AddFlag(cmd.Flags().Lookup(dockerFileFlag))
@@ -1007,6 +1220,8 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(waitFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(timeoutFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
 
 	// prettify help menu.
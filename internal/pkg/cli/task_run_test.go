@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -70,9 +72,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inWait                  bool
+		inTimeout               time.Duration
+		inFromWorkload          string
 
 		appName         string
 		isDockerfileSet bool
+		isCPUSet        bool
+		isMemorySet     bool
 
 		mockStore      func(m *mocks.Mockstore)
 		mockFileSystem func(mockFS afero.Fs)
@@ -83,6 +90,109 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			basicOpts:   defaultOpts,
 			wantedError: nil,
 		},
+		"valid with wait and timeout": {
+			basicOpts: defaultOpts,
+			inWait:    true,
+			inTimeout: 30 * time.Minute,
+		},
+		"invalid negative timeout": {
+			basicOpts:   defaultOpts,
+			inWait:      true,
+			inTimeout:   -time.Minute,
+			wantedError: errors.New("--timeout must be a positive duration"),
+		},
+		"invalid timeout without wait or follow": {
+			basicOpts:   defaultOpts,
+			inTimeout:   time.Minute,
+			wantedError: errors.New("--timeout requires --wait or --follow"),
+		},
+		"valid with a workload and a command override": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			inCommand:      "python manage.py migrate",
+			appName:        "my-app",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "dev").Return(&config.Environment{App: "my-app", Name: "dev"}, nil)
+				m.EXPECT().GetJob("my-app", "api").Return(nil, &config.ErrNoSuchJob{})
+				m.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+			},
+		},
+		"invalid workload without env": {
+			basicOpts:      defaultOpts,
+			inFromWorkload: "api",
+			wantedError:    errors.New("must specify `--env` with `--from-workload`"),
+		},
+		"invalid workload with entrypoint": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			inEntryPoint:   "/bin/sh",
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--entrypoint` and `--from-workload`: Amazon ECS does not support overriding the entrypoint when running a task"),
+		},
+		"invalid workload with image": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			inImage:        "nginx",
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--image` and `--from-workload`"),
+		},
+		"invalid workload with cpu": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			isCPUSet:       true,
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--cpu` and `--from-workload`"),
+		},
+		"invalid workload with memory": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			isMemorySet:    true,
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--memory` and `--from-workload`"),
+		},
+		"invalid workload with subnets": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			inSubnets:      []string{"subnet-1"},
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--subnets` and `--from-workload`"),
+		},
+		"invalid workload with security groups": {
+			basicOpts:        defaultOpts,
+			inEnv:            "dev",
+			inFromWorkload:   "api",
+			inSecurityGroups: []string{"sg-1"},
+			appName:          "my-app",
+			wantedError:      errors.New("cannot specify both `--security-groups` and `--from-workload`"),
+		},
+		"invalid workload with cluster": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			inCluster:      "my-cluster",
+			appName:        "my-app",
+			wantedError:    errors.New("cannot specify both `--cluster` and `--from-workload`"),
+		},
+		"invalid workload that does not exist": {
+			basicOpts:      defaultOpts,
+			inEnv:          "dev",
+			inFromWorkload: "api",
+			appName:        "my-app",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "dev").Return(&config.Environment{App: "my-app", Name: "dev"}, nil)
+				m.EXPECT().GetJob("my-app", "api").Return(nil, &config.ErrNoSuchJob{})
+				m.EXPECT().GetService("my-app", "api").Return(nil, &config.ErrNoSuchService{})
+			},
+			wantedError: errors.New("workload api is neither a service nor a job"),
+		},
 		"valid with flags image and env": {
 			basicOpts: defaultOpts,
 
@@ -409,10 +519,15 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					wait:                        tc.inWait,
+					timeout:                     tc.inTimeout,
+					fromWorkload:                tc.inFromWorkload,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
+				isCPUSet:        tc.isCPUSet,
+				isMemorySet:     tc.isMemorySet,
 				nFlag:           2,
 
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -689,6 +804,7 @@ type runTaskMocks struct {
 	runner               *mocks.MocktaskRunner
 	store                *mocks.Mockstore
 	eventsWriter         *mocks.MockeventsWriter
+	waiter               *mocks.MocktaskWaiter
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 	publicIPGetter       *mocks.MockpublicIPGetter
 	provider             *mocks.MocksessionProvider
@@ -720,10 +836,13 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inTag        string
 		inDockerCtx  string
 		inFollow     bool
+		inWait       bool
+		inTimeout    time.Duration
 		inCommand    string
 		inEntryPoint string
 
-		inEnv string
+		inEnv          string
+		inFromWorkload string
 
 		setupMocks func(m runTaskMocks)
 
@@ -754,6 +873,28 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.runner.EXPECT().Run().AnyTimes()
 			},
 		},
+		"run tasks from a workload without deploying resources or building an image": {
+			inEnv:          "test",
+			inFromWorkload: "api",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), "test").Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.defaultClusterGetter.EXPECT().HasDefaultCluster().Times(0)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.repository.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+				m.runner.EXPECT().Run().Return([]*task.Task{{TaskARN: "task-1"}}, nil)
+			},
+		},
+		"error running tasks from a workload": {
+			inEnv:          "test",
+			inFromWorkload: "api",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), "test").Return(&config.Environment{}, nil)
+				m.provider.EXPECT().FromRole(gomock.Any(), gomock.Any())
+				m.runner.EXPECT().Run().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("run task api: some error"),
+		},
 		"error deploying resources": {
 			setupMocks: func(m runTaskMocks) {
 				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
@@ -944,10 +1085,85 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				m.publicIPGetter.EXPECT().PublicIP("eni-1").Return("1.2.3", nil)
 				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(1).
 					Return(errors.New("error writing events"))
+				m.waiter.EXPECT().Wait(gomock.Any()).Return(nil, nil)
 				mockHasDefaultCluster(m)
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"return the exit code of the container while following logs": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.waiter.EXPECT().Wait(gomock.Any()).Return([]*task.Result{
+					{
+						TaskARN:       "task-1",
+						StoppedReason: "Essential container in task exited",
+						Containers: []task.ContainerResult{
+							{
+								Name:     "my-task",
+								ExitCode: aws.Int64(3),
+							},
+						},
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: &errTaskExited{groupName: "my-task", exitCode: 3},
+		},
+		"succeed if the tasks exit with code zero": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.waiter.EXPECT().Wait(gomock.Any()).Return([]*task.Result{
+					{
+						TaskARN: "task-1",
+						Containers: []task.ContainerResult{
+							{
+								Name:     "my-task",
+								ExitCode: aws.Int64(0),
+							},
+						},
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"return a timeout error if the tasks were stopped": {
+			inWait:    true,
+			inTimeout: time.Minute,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.waiter.EXPECT().Wait(gomock.Any()).Return([]*task.Result{
+					{
+						TaskARN: "task-1",
+					},
+				}, &task.ErrTimeout{Timeout: time.Minute})
+				mockHasDefaultCluster(m)
+			},
+			wantedError: &errTaskTimeout{groupName: "my-task", timeout: time.Minute},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -961,6 +1177,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				runner:               mocks.NewMocktaskRunner(ctrl),
 				store:                mocks.NewMockstore(ctrl),
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
+				waiter:               mocks.NewMocktaskWaiter(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 				provider:             mocks.NewMocksessionProvider(ctrl),
@@ -977,9 +1194,13 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
+					wait:       tc.inWait,
+					timeout:    tc.inTimeout,
 					secrets:    tc.inSecrets,
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
+
+					fromWorkload: tc.inFromWorkload,
 				},
 				spinner:  &mockSpinner{},
 				store:    mocks.store,
@@ -990,6 +1211,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
+				opts.waiter = mocks.waiter
 				return nil
 			}
 			opts.configureRepository = func() error {
@@ -35,8 +35,10 @@ var (
 	errValueNotAValidPath   = errors.New("value must be a valid path")
 	errValueNotAnIPNet      = errors.New("value must be a valid IP address range (example: 10.0.0.0/16)")
 	errValueNotIPNetSlice   = errors.New("value must be a valid slice of IP address range (example: 10.0.0.0/16,10.0.1.0/16)")
+	errValueReserved        = fmt.Errorf("value must not be %q: the directory holds the environment addons", addon.EnvAddonsDirName)
 	errPortInvalid          = errors.New("value must be in range 1-65535")
 	errDomainInvalid        = errors.New("value must contain at least one '.' character")
+	errKMSKeyARNInvalid     = errors.New("value must be the ARN of a KMS key")
 	errDurationInvalid      = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits     = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid      = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
@@ -66,6 +68,10 @@ var (
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
+	// ElastiCache Redis and SQS errors.
+	errInvalidRedisNameCharacters   = errors.New("value must start with a letter and contain only alphanumeric characters and -_")
+	errValueBadFormatWithUnderscore = errors.New("value must contain only alphanumeric characters and _-")
+
 	// Topic subscription errors.
 	errMissingPublishTopicField = errors.New("field `publish.topics[].name` cannot be empty")
 	errInvalidPubSubTopicName   = errors.New("topic names can only contain letters, numbers, underscores, and hyphens")
@@ -74,6 +80,8 @@ var (
 	fmtErrTopicSubscriptionNotAllowed = "SNS topic %s does not exist in environment %s"
 )
 
+const fifoTopicSuffix = ".fifo"
+
 const fmtErrValueBadSize = "value must be between %d and %d characters in length"
 
 // App Runner validation errors.
@@ -147,6 +155,12 @@ var (
 	)
 )
 
+// ElastiCache Redis and SQS validation expressions.
+var (
+	redisStorageNameRegExp = regexp.MustCompile(`^[A-Za-z][a-zA-Z0-9\-_]*$`) // Starts with a letter, followed by alphanumeric, -_.
+	sqsQueueNameRegExp     = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`)         // Alphanumeric, -_.
+)
+
 // SSM secret parameter name validation expression.
 // https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_PutParameter.html#systemsmanager-PutParameter-request-Name
 var secretParameterNameRegExp = regexp.MustCompile("^[a-zA-Z0-9_.-]+$")
@@ -175,6 +189,9 @@ func validateSvcName(val interface{}, svcType string) error {
 	default:
 		err = basicNameValidation(val)
 	}
+	if err == nil {
+		err = validateWorkloadNameNotReserved(val)
+	}
 	if err != nil {
 		return fmt.Errorf("service name %v is invalid: %w", val, err)
 	}
@@ -216,12 +233,25 @@ func validateJobType(val interface{}) error {
 }
 
 func validateJobName(val interface{}) error {
-	if err := basicNameValidation(val); err != nil {
+	err := basicNameValidation(val)
+	if err == nil {
+		err = validateWorkloadNameNotReserved(val)
+	}
+	if err != nil {
 		return fmt.Errorf("job name %v is invalid: %w", val, err)
 	}
 	return nil
 }
 
+// validateWorkloadNameNotReserved returns an error if the workload's directory under "copilot/" would collide with
+// a directory that Copilot uses for something else.
+func validateWorkloadNameNotReserved(val interface{}) error {
+	if val == addon.EnvAddonsDirName {
+		return errValueReserved
+	}
+	return nil
+}
+
 func validateSchedule(sched interface{}) error {
 	s, ok := sched.(string)
 	if !ok {
@@ -268,6 +298,18 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+func validateKMSKeyARN(val interface{}) error {
+	key, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	parsed, err := arn.Parse(key)
+	if err != nil || parsed.Service != "kms" {
+		return errKMSKeyARNInvalid
+	}
+	return nil
+}
+
 func validatePath(fs afero.Fs, val interface{}) error {
 	path, ok := val.(string)
 	if !ok {
@@ -297,8 +339,29 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
+	switch storageType {
+	case rdsStorageType:
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	case redisStorageType:
+		return validateRedisStorageType(opts.ws, opts.workloadName)
+	}
+	return nil
+}
+
+func validateRedisStorageType(ws manifestReader, workloadName string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	if mftType == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("invalid storage type %s: not supported for %s", redisStorageType, manifest.RequestDrivenWebServiceType)
 	}
 	return nil
 }
@@ -631,6 +694,43 @@ func rdsNameValidation(val interface{}) error {
 	return nil
 }
 
+func redisNameValidation(val interface{}) error {
+	// The storage name is used as the logical ID prefix of the replication group. Replication group identifiers
+	// are limited to 40 characters and must start with a letter.
+	const minRedisNameLength = 1
+	const maxRedisNameLength = 40
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minRedisNameLength || len(s) > maxRedisNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minRedisNameLength, maxRedisNameLength)
+	}
+	if !redisStorageNameRegExp.MatchString(s) {
+		return errInvalidRedisNameCharacters
+	}
+	return nil
+}
+
+func sqsQueueNameValidation(val interface{}) error {
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/quotas-queues.html
+	const minSQSQueueNameLength = 1
+	const maxSQSQueueNameLength = 80
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minSQSQueueNameLength || len(s) > maxSQSQueueNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minSQSQueueNameLength, maxSQSQueueNameLength)
+	}
+	if !sqsQueueNameRegExp.MatchString(s) {
+		return errValueBadFormatWithUnderscore
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -752,6 +852,9 @@ func validateTopicsExist(subscriptions []manifest.TopicSubscription, topicARNs [
 
 	for _, ts := range subscriptions {
 		topicName := fmt.Sprintf(resourceNameFormat, app, env, aws.StringValue(ts.Service), aws.StringValue(ts.Name))
+		if aws.BoolValue(ts.FIFO) {
+			topicName += fifoTopicSuffix
+		}
 		if !contains(topicName, validTopicResources) {
 			return fmt.Errorf(fmtErrTopicSubscriptionNotAllowed, topicName, env)
 		}
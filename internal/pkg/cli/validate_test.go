@@ -126,6 +126,11 @@ func TestValidateSvcName(t *testing.T) {
 			svcType: manifest.LoadBalancedWebServiceType,
 			wanted:  errValueBadFormat,
 		},
+		"reserved for environment addons": {
+			val:     "environments",
+			svcType: manifest.BackendServiceType,
+			wanted:  errValueReserved,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -263,6 +268,64 @@ func TestValidateRDSName(t *testing.T) {
 	}
 }
 
+func TestValidateRedisName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "my-cache_1",
+			want:  nil,
+		},
+		"too long": {
+			input: "AprilisthecruellestmonthbreedingLilacsout",
+			want:  fmt.Errorf("value must be between 1 and 40 characters in length"),
+		},
+		"starts with a number": {
+			input: "1cache",
+			want:  errInvalidRedisNameCharacters,
+		},
+		"bad character": {
+			input: "my.cache",
+			want:  errInvalidRedisNameCharacters,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := redisNameValidation(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateSQSQueueName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "1-my_queue",
+			want:  nil,
+		},
+		"too long": {
+			input: strings.Repeat("a", 81),
+			want:  fmt.Errorf("value must be between 1 and 80 characters in length"),
+		},
+		"bad character": {
+			input: "my.queue",
+			want:  errValueBadFormatWithUnderscore,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := sqsQueueNameValidation(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidatePath(t *testing.T) {
 	testCases := map[string]struct {
 		input interface{}
@@ -379,6 +442,35 @@ type: Request-Driven Web Service
 			},
 			want: errors.New("invalid storage type Aurora: Request-Driven Web Service requires a VPC connection"),
 		},
+		"should allow SQS addons": {
+			input: "SQS",
+			want:  nil,
+		},
+		"should allow Redis if the workload type is not a RDWS": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Backend Service
+`),
+				},
+				workloadName: "api",
+			},
+		},
+		"should return an error if Redis is selected for a RDWS": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type Redis: not supported for Request-Driven Web Service"),
+		},
 		"should succeed if Aurora is selected and RDWS is connected to a VPC": {
 			input: "Aurora",
 			optionals: validateStorageTypeOpts{
@@ -931,6 +1023,26 @@ func Test_validateTopicsExist(t *testing.T) {
 			inTopicARNs: []string{},
 			wantErr:     "SNS topic app-env-database-events does not exist in environment env",
 		},
+		"FIFO topic is valid": {
+			inTopics: []manifest.TopicSubscription{
+				{
+					Name:    aws.String("payments"),
+					Service: aws.String("api"),
+					FIFO:    aws.Bool(true),
+				},
+			},
+			inTopicARNs: []string{"arn:aws:sns:us-west-2:123456789012:app-env-api-payments.fifo"},
+		},
+		"subscription to a standard topic that is published as FIFO is invalid": {
+			inTopics: []manifest.TopicSubscription{
+				{
+					Name:    aws.String("payments"),
+					Service: aws.String("api"),
+				},
+			},
+			inTopicARNs: []string{"arn:aws:sns:us-west-2:123456789012:app-env-api-payments.fifo"},
+			wantErr:     "SNS topic app-env-api-payments does not exist in environment env",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
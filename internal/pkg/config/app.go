@@ -14,12 +14,13 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name               string            `json:"name"`               // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID          string            `json:"account"`            // AccountID this app is mastered in.
-	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+	Name               string            `json:"name"`                   // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID          string            `json:"account"`                // AccountID this app is mastered in.
+	Domain             string            `json:"domain"`                 // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID string            `json:"domainHostedZoneID"`     // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	Version            string            `json:"version"`                // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags               map[string]string `json:"tags,omitempty"`         // Labels to apply to resources created within the app.
+	SignatureKey       string            `json:"signatureKey,omitempty"` // ARN of the KMS key that verifies the signatures of images deployed to production environments.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
@@ -28,19 +28,68 @@ type Environment struct {
 
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC      *ImportVPC `json:"importVPC,omitempty"`
+	VPCConfig      *AdjustVPC `json:"adjustVPC,omitempty"`
+	VPCEndpoints   bool       `json:"vpcEndpoints,omitempty"`   // True means private subnets reach AWS services through VPC endpoints instead of NAT gateways.
+	ImportCertARNs []string   `json:"importCertARNs,omitempty"` // ACM certificates used by the HTTPS listener of the public load balancer.
+
+	PublicLoadBalancer    *PublicLoadBalancer    `json:"publicLoadBalancer,omitempty"`
+	PullThroughCacheRules []PullThroughCacheRule `json:"pullThroughCacheRules,omitempty"` // ECR pull through cache rules for upstream registries.
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
-func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC) *CustomizeEnv {
-	if importVPC == nil && adjustVPC == nil {
+func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC, vpcEndpoints bool, importCertARNs []string, publicLB *PublicLoadBalancer,
+	cacheRules []PullThroughCacheRule) *CustomizeEnv {
+	if importVPC == nil && adjustVPC == nil && !vpcEndpoints && len(importCertARNs) == 0 && publicLB == nil && len(cacheRules) == 0 {
 		return nil
 	}
 	return &CustomizeEnv{
-		ImportVPC: importVPC,
-		VPCConfig: adjustVPC,
+		ImportVPC:             importVPC,
+		VPCConfig:             adjustVPC,
+		VPCEndpoints:          vpcEndpoints,
+		ImportCertARNs:        importCertARNs,
+		PublicLoadBalancer:    publicLB,
+		PullThroughCacheRules: cacheRules,
+	}
+}
+
+// PullThroughCacheRule holds the fields of an ECR pull through cache rule, which caches the images of an upstream registry
+// in ECR repositories whose names start with the prefix.
+type PullThroughCacheRule struct {
+	RepositoryPrefix    string `json:"repositoryPrefix"`
+	UpstreamRegistryURL string `json:"upstreamRegistryURL"`
+	CredentialARN       string `json:"credentialARN,omitempty"` // Secrets Manager secret for upstream registries that require authentication.
+	Existing            bool   `json:"existing,omitempty"`      // True means the rule was created outside the environment, which only grants access to it.
+}
+
+// PublicLoadBalancer holds the fields to protect and audit the public Application Load Balancer of an environment.
+type PublicLoadBalancer struct {
+	WebACLARN    string         `json:"webACLARN,omitempty"`    // Existing AWS WAF web ACL to associate with the load balancer.
+	ManagedRules bool           `json:"managedRules,omitempty"` // True means creating a web ACL with AWS managed rule groups.
+	AccessLogs   *ALBAccessLogs `json:"accessLogs,omitempty"`
+}
+
+// ALBAccessLogs holds the fields to store the access logs of a load balancer in an S3 bucket owned by the environment.
+type ALBAccessLogs struct {
+	Prefix        string `json:"prefix,omitempty"`
+	RetentionDays int    `json:"retentionDays,omitempty"` // Zero means the logs never expire.
+}
+
+// HasImportedCerts returns true if the environment's HTTPS listener uses certificates imported by the user.
+func (c *CustomizeEnv) HasImportedCerts() bool {
+	return c != nil && len(c.ImportCertARNs) > 0
+}
+
+// PullThroughCachePrefixes returns the repository prefixes of the environment's pull through cache rules.
+func (c *CustomizeEnv) PullThroughCachePrefixes() []string {
+	if c == nil {
+		return nil
+	}
+	var prefixes []string
+	for _, rule := range c.PullThroughCacheRules {
+		prefixes = append(prefixes, rule.RepositoryPrefix)
 	}
+	return prefixes
 }
 
 // ImportVPC holds the fields to import VPC resources.
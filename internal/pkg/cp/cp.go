@@ -0,0 +1,452 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cp copies files and directories between the local file system and running containers.
+// Files are archived with tar and streamed as base64 over an ECS Exec session,
+// since the session allocates a terminal that would otherwise mangle binary data.
+package cp
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	beginMarker  = "COPILOT-CP-BEGIN"
+	endMarker    = "COPILOT-CP-END"
+	failedMarker = "COPILOT-CP-FAILED"
+	statusMarker = "COPILOT-CP-STATUS:"
+
+	base64LineLen = 76     // Keep lines short so that the terminal of the session does not truncate them.
+	endOfText     = "\x04" // Signals the end of input to the terminal of the session.
+)
+
+var errSessionClosed = errors.New("session closed")
+
+type commandExecutor interface {
+	ExecuteCommand(in awsecs.ExecuteCommandInput) error
+}
+
+// Container identifies a container in a running task.
+type Container struct {
+	Cluster string
+	Task    string
+	Name    string
+}
+
+// Copier copies files between the local file system and running containers.
+type Copier struct {
+	exec commandExecutor
+
+	// Progress receives the number of transferred bytes as the copy proceeds. Optional.
+	Progress io.Writer
+}
+
+// New returns a Copier that runs commands in containers with exec.
+func New(exec commandExecutor) *Copier {
+	return &Copier{
+		exec: exec,
+	}
+}
+
+// Upload copies the local file or directory src into the directory dstDir of the container.
+// The directory is created if it does not exist.
+func (c *Copier) Upload(src string, dst Container, dstDir string) error {
+	if err := validateRemotePath(dstDir); err != nil {
+		return err
+	}
+	// Stream the archive into the session instead of buffering it, as files like heap dumps can be large.
+	pr, pw := io.Pipe()
+	archiveErr := make(chan error, 1)
+	go func() {
+		err := writeBase64Archive(src, pw)
+		pw.CloseWithError(err)
+		archiveErr <- err
+	}()
+	// The session doesn't return the exit status of the command, so it's printed after the extraction along with tar's stderr.
+	cmd := fmt.Sprintf(`/bin/sh -c 'errfile=$(mktemp) && { mkdir -p "%s" && base64 -d | tar xf - -C "%s"; } 2>"$errfile"; `+
+		`echo %s$?; cat "$errfile"; rm -f "$errfile"'`, dstDir, dstDir, statusMarker)
+	status := &statusWriter{}
+	err := c.exec.ExecuteCommand(awsecs.ExecuteCommandInput{
+		Cluster:   dst.Cluster,
+		Command:   cmd,
+		Task:      dst.Task,
+		Container: dst.Name,
+		Stdin:     c.progressReader(pr),
+		Stdout:    status,
+	})
+	// Unblock the archive writer if the session stopped reading early.
+	pr.CloseWithError(errSessionClosed)
+	if aErr := <-archiveErr; aErr != nil && aErr != errSessionClosed {
+		return fmt.Errorf("archive %s: %w", src, aErr)
+	}
+	if err != nil {
+		return fmt.Errorf("copy %s to %s in container %s: %w", src, dstDir, dst.Name, err)
+	}
+	c.done()
+	if err := status.close(); err != nil {
+		return fmt.Errorf("extract %s to %s in container %s: %w", src, dstDir, dst.Name, err)
+	}
+	return nil
+}
+
+// Download copies the file or directory srcPath of the container into the local directory dstDir.
+// The directory is created if it does not exist.
+func (c *Copier) Download(src Container, srcPath, dstDir string) error {
+	if err := validateRemotePath(srcPath); err != nil {
+		return err
+	}
+	srcPath = path.Clean(srcPath)
+	// The exit status of a pipeline is the one of its last command, and tar's stderr shares the session's terminal
+	// with the payload. So tar's stderr and failure are recorded in files, and printed after the payload instead.
+	cmd := fmt.Sprintf(`/bin/sh -c 'errfile=$(mktemp) && echo %s && { tar cf - -C "%s" "%s" 2>"$errfile" || echo failed >"$errfile.status"; } | base64 && `+
+		`if [ -e "$errfile.status" ]; then echo %s && cat "$errfile"; else echo %s; fi; rm -f "$errfile" "$errfile.status"'`,
+		beginMarker, path.Dir(srcPath), path.Base(srcPath), failedMarker, endMarker)
+
+	pr, pw := io.Pipe()
+	extractErr := make(chan error, 1)
+	go func() {
+		err := extractArchive(base64.NewDecoder(base64.StdEncoding, pr), dstDir)
+		// Drain the rest of the payload so that the session never blocks on a failed extraction.
+		_, _ = io.Copy(io.Discard, pr)
+		extractErr <- err
+	}()
+	payload := &payloadWriter{payload: pw}
+	err := c.exec.ExecuteCommand(awsecs.ExecuteCommandInput{
+		Cluster:   src.Cluster,
+		Command:   cmd,
+		Task:      src.Task,
+		Container: src.Name,
+		Stdout:    c.progressWriter(payload),
+	})
+	payloadErr := payload.close()
+	pw.CloseWithError(payloadErr)
+	xErr := <-extractErr
+	if err != nil {
+		return fmt.Errorf("copy %s from container %s: %w", srcPath, src.Name, err)
+	}
+	c.done()
+	if payloadErr != nil {
+		return fmt.Errorf("read %s from container %s: %w", srcPath, src.Name, payloadErr)
+	}
+	if xErr != nil {
+		return fmt.Errorf("extract %s to %s: %w", srcPath, dstDir, xErr)
+	}
+	return nil
+}
+
+func (c *Copier) progressReader(r io.Reader) io.Reader {
+	if c.Progress == nil {
+		return r
+	}
+	return io.TeeReader(r, &progressWriter{out: c.Progress})
+}
+
+func (c *Copier) progressWriter(w io.Writer) io.Writer {
+	if c.Progress == nil {
+		return w
+	}
+	return io.MultiWriter(w, &progressWriter{out: c.Progress})
+}
+
+func (c *Copier) done() {
+	if c.Progress == nil {
+		return
+	}
+	fmt.Fprintln(c.Progress)
+}
+
+// progressWriter reports the number of bytes written to it on a single line.
+type progressWriter struct {
+	out   io.Writer
+	total uint64
+}
+
+// Write reports the running total of transferred bytes.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.total += uint64(len(p))
+	fmt.Fprintf(w.out, "\rTransferred %s", humanize.Bytes(w.total))
+	return len(p), nil
+}
+
+// archiveLocal writes a tar archive of the file or directory src to w.
+// Entries are named relative to the parent of src so that src itself is recreated on extraction.
+func archiveLocal(src string, w io.Writer) error {
+	src = filepath.Clean(src)
+	parent := filepath.Dir(src)
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			// Skip symlinks, sockets and other special files.
+			return nil
+		}
+		rel, err := filepath.Rel(parent, file)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extractArchive writes the entries of the tar archive r under dstDir.
+func extractArchive(r io.Reader, dstDir string) error {
+	if err := os.MkdirAll(dstDir, 0755); err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dstDir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dstDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("archive entry %s is outside of %s", header.Name, dstDir)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.FileMode(header.Mode)|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := writeFile(target, tr, os.FileMode(header.Mode)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeFile(name string, r io.Reader, mode os.FileMode) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// payloadWriter forwards the base64 lines printed by the session between the begin and end markers to payload,
+// discarding anything the session prints around them.
+type payloadWriter struct {
+	payload io.Writer
+
+	line    []byte        // Partial line not terminated by a new line character yet.
+	state   payloadState  // Position in the session output.
+	failure *bytes.Buffer // Output of the container after the failed marker.
+	err     error         // Error writing to payload.
+}
+
+type payloadState int
+
+const (
+	beforePayload payloadState = iota
+	inPayload
+	afterPayload
+	failedPayload
+)
+
+// Write processes the complete lines of p.
+func (w *payloadWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b != '\n' {
+			w.line = append(w.line, b)
+			continue
+		}
+		w.processLine(strings.TrimSpace(string(w.line)))
+		w.line = w.line[:0]
+	}
+	return len(p), nil
+}
+
+func (w *payloadWriter) processLine(line string) {
+	switch w.state {
+	case beforePayload:
+		if line == beginMarker {
+			w.state = inPayload
+		}
+	case inPayload:
+		switch line {
+		case endMarker:
+			w.state = afterPayload
+		case failedMarker:
+			w.state = failedPayload
+			w.failure = &bytes.Buffer{}
+		default:
+			if w.err == nil {
+				_, w.err = io.WriteString(w.payload, strings.Join(strings.Fields(line), ""))
+			}
+		}
+	case failedPayload:
+		if strings.HasPrefix(line, "Exiting session") {
+			return
+		}
+		w.failure.WriteString(line + "\n")
+	}
+}
+
+// close processes the last line and returns an error if the payload is missing, incomplete, or the archive command failed.
+func (w *payloadWriter) close() error {
+	if len(w.line) > 0 {
+		w.processLine(strings.TrimSpace(string(w.line)))
+	}
+	switch w.state {
+	case beforePayload:
+		return errors.New("the archive command did not start")
+	case inPayload:
+		return errors.New("archive incomplete")
+	case failedPayload:
+		return fmt.Errorf("archive failed: %s", strings.TrimSpace(w.failure.String()))
+	}
+	return w.err
+}
+
+// statusWriter records the exit status that the session prints after the status marker, and the output that follows it.
+// Anything the session prints before the marker, such as the echo of the input, is discarded.
+type statusWriter struct {
+	line   []byte       // Partial line not terminated by a new line character yet.
+	status string       // Exit status printed after the marker, empty until the marker is printed.
+	output bytes.Buffer // Output of the container after the status.
+}
+
+// Write processes the complete lines of p.
+func (w *statusWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		if b != '\n' {
+			w.line = append(w.line, b)
+			continue
+		}
+		w.processLine(strings.TrimSpace(string(w.line)))
+		w.line = w.line[:0]
+	}
+	return len(p), nil
+}
+
+func (w *statusWriter) processLine(line string) {
+	if w.status == "" {
+		if i := strings.Index(line, statusMarker); i != -1 {
+			w.status = line[i+len(statusMarker):]
+		}
+		return
+	}
+	if line == "" || strings.HasPrefix(line, "Exiting session") {
+		return
+	}
+	w.output.WriteString(line + "\n")
+}
+
+// close processes the last line and returns an error if the status is missing or the command failed.
+func (w *statusWriter) close() error {
+	if len(w.line) > 0 {
+		w.processLine(strings.TrimSpace(string(w.line)))
+	}
+	switch w.status {
+	case "":
+		return errors.New("the command did not report its exit status")
+	case "0":
+		return nil
+	}
+	if out := strings.TrimSpace(w.output.String()); out != "" {
+		return fmt.Errorf("command exited with status %s: %s", w.status, out)
+	}
+	return fmt.Errorf("command exited with status %s", w.status)
+}
+
+// writeBase64Archive writes a tar archive of src to w in base64 lines, followed by the end of text signal.
+func writeBase64Archive(src string, w io.Writer) error {
+	enc := base64.NewEncoder(base64.StdEncoding, &lineWrapper{w: w, max: base64LineLen})
+	if err := archiveLocal(src, enc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n"+endOfText)
+	return err
+}
+
+// lineWrapper inserts a new line character every max bytes written to w.
+type lineWrapper struct {
+	w   io.Writer
+	max int
+	col int
+}
+
+// Write writes p to the underlying writer, wrapping lines at max bytes.
+func (l *lineWrapper) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		if l.col == l.max {
+			if _, err := l.w.Write([]byte{'\n'}); err != nil {
+				return n, err
+			}
+			l.col = 0
+		}
+		chunk := p
+		if len(chunk) > l.max-l.col {
+			chunk = chunk[:l.max-l.col]
+		}
+		written, err := l.w.Write(chunk)
+		n += written
+		l.col += written
+		if err != nil {
+			return n, err
+		}
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// validateRemotePath returns an error if p can't be safely quoted in the command run in the container.
+func validateRemotePath(p string) error {
+	if p == "" {
+		return fmt.Errorf("path in container must not be empty")
+	}
+	if strings.ContainsAny(p, "'\"`$\\") {
+		return fmt.Errorf("path %s in container must not contain quotes, backticks, dollar signs or backslashes", p)
+	}
+	return nil
+}
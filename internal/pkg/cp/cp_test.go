@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cp
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExecutor struct {
+	output string
+	err    error
+
+	gotIn    awsecs.ExecuteCommandInput
+	gotStdin string
+}
+
+func (e *fakeExecutor) ExecuteCommand(in awsecs.ExecuteCommandInput) error {
+	e.gotIn = in
+	if in.Stdin != nil {
+		b, _ := io.ReadAll(in.Stdin)
+		e.gotStdin = string(b)
+	}
+	if in.Stdout != nil {
+		_, _ = in.Stdout.Write([]byte(e.output))
+	}
+	return e.err
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+	}
+}
+
+func readFiles(t *testing.T, root string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		require.NoError(t, err)
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestCopier_Upload(t *testing.T) {
+	container := Container{Cluster: "cluster", Task: "task", Name: "api"}
+
+	t.Run("returns an error if the remote path can't be quoted", func(t *testing.T) {
+		// GIVEN
+		copier := New(&fakeExecutor{})
+
+		// WHEN
+		err := copier.Upload(t.TempDir(), container, "/tmp/'dir'")
+
+		// THEN
+		require.EqualError(t, err, "path /tmp/'dir' in container must not contain quotes, backticks, dollar signs or backslashes")
+	})
+	t.Run("wraps the error from the session", func(t *testing.T) {
+		// GIVEN
+		src := filepath.Join(t.TempDir(), "profile.out")
+		writeFiles(t, filepath.Dir(src), map[string]string{"profile.out": "data"})
+		copier := New(&fakeExecutor{err: errors.New("some error")})
+
+		// WHEN
+		err := copier.Upload(src, container, "/tmp")
+
+		// THEN
+		require.EqualError(t, err, "copy "+src+" to /tmp in container api: some error")
+	})
+	t.Run("returns the error of tar printed after its exit status", func(t *testing.T) {
+		// GIVEN
+		src := filepath.Join(t.TempDir(), "profile.out")
+		writeFiles(t, filepath.Dir(src), map[string]string{"profile.out": "data"})
+		copier := New(&fakeExecutor{
+			output: "\r\nStarting session with SessionId: ecs-execute-command-123\r\n" + statusMarker + "2\r\n" +
+				"tar: profile.out: Cannot open: No space left on device\r\n\r\nExiting session with sessionId: ecs-execute-command-123.\r\n",
+		})
+
+		// WHEN
+		err := copier.Upload(src, container, "/tmp")
+
+		// THEN
+		require.EqualError(t, err, "extract "+src+" to /tmp in container api: command exited with status 2: tar: profile.out: Cannot open: No space left on device")
+	})
+	t.Run("returns an error if the session ends before the exit status", func(t *testing.T) {
+		// GIVEN
+		src := filepath.Join(t.TempDir(), "profile.out")
+		writeFiles(t, filepath.Dir(src), map[string]string{"profile.out": "data"})
+		copier := New(&fakeExecutor{})
+
+		// WHEN
+		err := copier.Upload(src, container, "/tmp")
+
+		// THEN
+		require.EqualError(t, err, "extract "+src+" to /tmp in container api: the command did not report its exit status")
+	})
+	t.Run("streams a base64 tar archive of the directory into the container", func(t *testing.T) {
+		// GIVEN
+		root := t.TempDir()
+		writeFiles(t, root, map[string]string{
+			"config/app.yml":       "port: 80",
+			"config/nested/db.yml": "host: db",
+		})
+		exec := &fakeExecutor{
+			output: "\r\nStarting session with SessionId: ecs-execute-command-123\r\n" + statusMarker + "0\r\n\r\n" +
+				"Exiting session with sessionId: ecs-execute-command-123.\r\n",
+		}
+		progress := &bytes.Buffer{}
+		copier := New(exec)
+		copier.Progress = progress
+
+		// WHEN
+		err := copier.Upload(filepath.Join(root, "config"), container, "/etc/app")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, `/bin/sh -c 'errfile=$(mktemp) && { mkdir -p "/etc/app" && base64 -d | tar xf - -C "/etc/app"; } 2>"$errfile"; `+
+			`echo COPILOT-CP-STATUS:$?; cat "$errfile"; rm -f "$errfile"'`, exec.gotIn.Command)
+		require.Equal(t, "cluster", exec.gotIn.Cluster)
+		require.Equal(t, "task", exec.gotIn.Task)
+		require.Equal(t, "api", exec.gotIn.Container)
+		require.True(t, strings.HasSuffix(exec.gotStdin, "\n"+endOfText))
+		for _, line := range strings.Split(strings.TrimSuffix(exec.gotStdin, "\n"+endOfText), "\n") {
+			require.LessOrEqual(t, len(line), base64LineLen)
+		}
+		require.Contains(t, progress.String(), "Transferred")
+
+		// The archive recreates the directory under the destination.
+		payload := strings.Join(strings.Fields(strings.TrimSuffix(exec.gotStdin, endOfText)), "")
+		dst := t.TempDir()
+		require.NoError(t, extractBase64(payload, dst))
+		require.Equal(t, map[string]string{
+			"config/app.yml":       "port: 80",
+			"config/nested/db.yml": "host: db",
+		}, readFiles(t, dst))
+	})
+}
+
+func TestCopier_Download(t *testing.T) {
+	container := Container{Cluster: "cluster", Task: "task", Name: "api"}
+
+	t.Run("returns the error of tar printed after the payload", func(t *testing.T) {
+		// GIVEN
+		copier := New(&fakeExecutor{
+			output: "\r\nStarting session with SessionId: ecs-execute-command-123\r\n" + beginMarker + "\r\n" + failedMarker + "\r\n" +
+				"tar: heap.hprof: No such file or directory\r\n\r\nExiting session with sessionId: ecs-execute-command-123.\r\n",
+		})
+
+		// WHEN
+		err := copier.Download(container, "/tmp/heap.hprof", t.TempDir())
+
+		// THEN
+		require.EqualError(t, err, "read /tmp/heap.hprof from container api: archive failed: tar: heap.hprof: No such file or directory")
+	})
+	t.Run("returns an error if the session ends before the end marker", func(t *testing.T) {
+		// GIVEN
+		copier := New(&fakeExecutor{
+			output: beginMarker + "\r\n" + base64.StdEncoding.EncodeToString([]byte("partial")) + "\r\n",
+		})
+
+		// WHEN
+		err := copier.Download(container, "/tmp/heap.hprof", t.TempDir())
+
+		// THEN
+		require.EqualError(t, err, "read /tmp/heap.hprof from container api: archive incomplete")
+	})
+	t.Run("extracts the archive between the markers", func(t *testing.T) {
+		// GIVEN
+		root := t.TempDir()
+		writeFiles(t, root, map[string]string{
+			"logs/app.log":     "started",
+			"logs/old/app.log": "stopped",
+		})
+		encoded := &bytes.Buffer{}
+		require.NoError(t, writeBase64Archive(filepath.Join(root, "logs"), encoded))
+		exec := &fakeExecutor{
+			output: "\r\nStarting session with SessionId: ecs-execute-command-123\r\n" +
+				beginMarker + "\r\n" + strings.ReplaceAll(strings.TrimSuffix(encoded.String(), endOfText), "\n", "\r\n") + endMarker + "\r\n\r\n" +
+				"Exiting session with sessionId: ecs-execute-command-123.\r\n",
+		}
+		dst := filepath.Join(t.TempDir(), "downloads")
+		copier := New(exec)
+
+		// WHEN
+		err := copier.Download(container, "/var/log/logs/", dst)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, `/bin/sh -c 'errfile=$(mktemp) && echo COPILOT-CP-BEGIN && { tar cf - -C "/var/log" "logs" 2>"$errfile" || echo failed >"$errfile.status"; } | base64 && `+
+			`if [ -e "$errfile.status" ]; then echo COPILOT-CP-FAILED && cat "$errfile"; else echo COPILOT-CP-END; fi; rm -f "$errfile" "$errfile.status"'`, exec.gotIn.Command)
+		require.Equal(t, map[string]string{
+			"logs/app.log":     "started",
+			"logs/old/app.log": "stopped",
+		}, readFiles(t, dst))
+	})
+}
+
+func TestExtractArchive(t *testing.T) {
+	t.Run("rejects entries outside of the destination", func(t *testing.T) {
+		// GIVEN
+		archive := &bytes.Buffer{}
+		tw := tar.NewWriter(archive)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0644}))
+		require.NoError(t, tw.Close())
+		dst := t.TempDir()
+
+		// WHEN
+		err := extractArchive(archive, dst)
+
+		// THEN
+		require.EqualError(t, err, "archive entry ../evil is outside of "+dst)
+	})
+}
+
+func extractBase64(payload, dst string) error {
+	archive, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return err
+	}
+	return extractArchive(bytes.NewReader(archive), dst)
+}
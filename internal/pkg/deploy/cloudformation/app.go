@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 )
 
 // DeployApp sets up everything required for our application-wide resources.
@@ -54,7 +55,7 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 		stackset.WithTags(toMap(appConfig.Tags())))
 }
 
-func (cf CloudFormation) UpgradeApplication(in *deploy.CreateAppInput) error {
+func (cf CloudFormation) UpgradeApplication(out progress.FileWriter, in *deploy.CreateAppInput) error {
 	appConfig := stack.NewAppStackConfig(in)
 	appStack, err := cf.cfnClient.Describe(appConfig.StackName())
 	if err != nil {
@@ -66,10 +67,40 @@ func (cf CloudFormation) UpgradeApplication(in *deploy.CreateAppInput) error {
 	if err != nil {
 		return err
 	}
-	if err := cf.upgradeAppStack(s); err != nil {
+	if err := cf.upgradeAppStack(out, s); err != nil {
 		return err
 	}
-	return cf.upgradeAppStackSet(appConfig)
+	return cf.upgradeAppStackSetWithEvents(out, appConfig)
+}
+
+// upgradeAppStackSetWithEvents upgrades the app stack set. If out is an EventWriter, the status of the
+// stack set is written to it before and after the upgrade, as stack sets don't emit stack events.
+func (cf CloudFormation) upgradeAppStackSetWithEvents(out progress.FileWriter, config *stack.AppStackConfig) error {
+	w, ok := out.(progress.EventWriter)
+	if !ok {
+		return cf.upgradeAppStackSet(config)
+	}
+	ssName := config.StackSetName()
+	ev := progress.Event{
+		Type:              progress.StackResourceEventType,
+		Stack:             ssName,
+		LogicalResourceID: ssName,
+		ResourceType:      stackSetResourceType,
+		ResourceStatus:    sdkcloudformation.ResourceStatusUpdateInProgress,
+	}
+	if err := w.WriteEvent(ev); err != nil {
+		return err
+	}
+	err := cf.upgradeAppStackSet(config)
+	ev.ResourceStatus = sdkcloudformation.ResourceStatusUpdateComplete
+	if err != nil {
+		ev.ResourceStatus = sdkcloudformation.ResourceStatusUpdateFailed
+		ev.ResourceStatusReason = err.Error()
+	}
+	if writeErr := w.WriteEvent(ev); writeErr != nil && err == nil {
+		return writeErr
+	}
+	return err
 }
 
 func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error {
@@ -95,7 +126,7 @@ func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error
 	}
 }
 
-func (cf CloudFormation) upgradeAppStack(s *cloudformation.Stack) error {
+func (cf CloudFormation) upgradeAppStack(out progress.FileWriter, s *cloudformation.Stack) error {
 	for {
 		// Upgrade app stack.
 		descr, err := cf.cfnClient.Describe(s.Name)
@@ -111,7 +142,7 @@ func (cf CloudFormation) upgradeAppStack(s *cloudformation.Stack) error {
 		// We only need the tags from the previously deployed stack.
 		s.Tags = descr.Tags
 
-		err = cf.cfnClient.UpdateAndWait(s)
+		err = cf.updateAppStack(out, s)
 		if err == nil {
 			return nil
 		}
@@ -127,6 +158,22 @@ func (cf CloudFormation) upgradeAppStack(s *cloudformation.Stack) error {
 	}
 }
 
+// updateAppStack updates the app stack and waits until the update is complete.
+// If out is an EventWriter, the events of the stack are written to it while waiting.
+func (cf CloudFormation) updateAppStack(out progress.FileWriter, s *cloudformation.Stack) error {
+	if _, ok := out.(progress.EventWriter); !ok {
+		return cf.cfnClient.UpdateAndWait(s)
+	}
+	return cf.renderStackChanges(&renderStackChangesInput{
+		w:                out,
+		stackName:        s.Name,
+		stackDescription: fmt.Sprintf("Upgrading the infrastructure for stack %s", s.Name),
+		createChangeSet: func() (string, error) {
+			return cf.cfnClient.Update(s)
+		},
+	})
+}
+
 // DelegateDNSPermissions grants the provided account ID the ability to write to this application's
 // DNS HostedZone. This allows us to perform cross account DNS delegation.
 func (cf CloudFormation) DelegateDNSPermissions(app *config.Application, accountID string) error {
@@ -6,7 +6,9 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -17,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -227,7 +230,7 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 			cf := tc.mockDeployer(t, ctrl)
 
 			// WHEN
-			err := cf.UpgradeApplication(&deploy.CreateAppInput{
+			err := cf.UpgradeApplication(mockFileWriter{Writer: new(strings.Builder)}, &deploy.CreateAppInput{
 				Name: "phonetool",
 			})
 
@@ -241,6 +244,93 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpgradeApplication_WriteEvents(t *testing.T) {
+	testCases := map[string]struct {
+		stackSetErr error
+
+		wantedErr    error
+		wantedEvents []string
+	}{
+		"writes the events of the app stack and stack set": {
+			wantedEvents: []string{
+				`"stack":"phonetool-infrastructure-roles","logicalResourceId":"phonetool-infrastructure-roles","resourceType":"AWS::CloudFormation::Stack","resourceStatus":"UPDATE_COMPLETE"`,
+				`"stack":"phonetool-infrastructure","logicalResourceId":"phonetool-infrastructure","resourceType":"AWS::CloudFormation::StackSet","resourceStatus":"UPDATE_IN_PROGRESS"`,
+				`"stack":"phonetool-infrastructure","logicalResourceId":"phonetool-infrastructure","resourceType":"AWS::CloudFormation::StackSet","resourceStatus":"UPDATE_COMPLETE"`,
+			},
+		},
+		"writes the reason the stack set failed to upgrade": {
+			stackSetErr: errors.New("some error"),
+
+			wantedErr: errors.New("some error"),
+			wantedEvents: []string{
+				`"stack":"phonetool-infrastructure-roles","logicalResourceId":"phonetool-infrastructure-roles","resourceType":"AWS::CloudFormation::Stack","resourceStatus":"UPDATE_COMPLETE"`,
+				`"stack":"phonetool-infrastructure","logicalResourceId":"phonetool-infrastructure","resourceType":"AWS::CloudFormation::StackSet","resourceStatus":"UPDATE_IN_PROGRESS"`,
+				`"stack":"phonetool-infrastructure","logicalResourceId":"phonetool-infrastructure","resourceType":"AWS::CloudFormation::StackSet","resourceStatus":"UPDATE_FAILED","resourceStatusReason":"some error"`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+
+			mockCFNClient := mocks.NewMockcfnClient(ctrl)
+			mockCFNClient.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{}, nil).Times(2)
+			mockCFNClient.EXPECT().Update(gomock.Any()).Return("1234", nil)
+			mockCFNClient.EXPECT().DescribeChangeSet("1234", "phonetool-infrastructure-roles").Return(&cloudformation.ChangeSetDescription{}, nil)
+			mockCFNClient.EXPECT().DescribeStackEvents(&awscfn.DescribeStackEventsInput{
+				StackName: aws.String("phonetool-infrastructure-roles"),
+			}).Return(&awscfn.DescribeStackEventsOutput{
+				StackEvents: []*awscfn.StackEvent{
+					{
+						EventId:           aws.String("1"),
+						LogicalResourceId: aws.String("phonetool-infrastructure-roles"),
+						ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+						ResourceStatus:    aws.String("UPDATE_COMPLETE"),
+						Timestamp:         aws.Time(deploymentTime),
+					},
+				},
+			}, nil).AnyTimes()
+			mockCFNClient.EXPECT().Describe("phonetool-infrastructure-roles").Return(&cloudformation.StackDescription{
+				StackStatus: aws.String("UPDATE_COMPLETE"),
+			}, nil)
+
+			mockAppStackSet := mocks.NewMockstackSetClient(ctrl)
+			mockAppStackSet.EXPECT().WaitForStackSetLastOperationComplete("phonetool-infrastructure").Return(nil)
+			mockAppStackSet.EXPECT().Describe("phonetool-infrastructure").Return(stackset.Description{}, nil)
+			mockAppStackSet.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(tc.stackSetErr)
+
+			cf := CloudFormation{
+				cfnClient:   mockCFNClient,
+				appStackSet: mockAppStackSet,
+				region:      "us-west-2",
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			err := cf.UpgradeApplication(progress.NewJSONEventWriter(buf), &deploy.CreateAppInput{
+				Name: "phonetool",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Len(t, lines, len(tc.wantedEvents))
+			for i, wanted := range tc.wantedEvents {
+				require.Contains(t, lines[i], wanted)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_AddEnvToApp(t *testing.T) {
 	mockApp := config.Application{
 		Name:      "testapp",
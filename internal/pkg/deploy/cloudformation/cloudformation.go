@@ -38,6 +38,7 @@ const (
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+	stackSetResourceType      = "AWS::CloudFormation::StackSet"
 )
 
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
@@ -201,13 +202,19 @@ func (cf CloudFormation) renderStackChanges(in *renderStackChangesInput) error {
 	defer cancelWait()
 	g, ctx := errgroup.WithContext(waitCtx)
 
-	renderer, err := cf.createChangeSetRenderer(g, ctx, changeSetID, in.stackName, in.stackDescription, progress.RenderOptions{})
-	if err != nil {
-		return err
+	if w, ok := in.w.(progress.EventWriter); ok {
+		if err := cf.writeChangeSetEvents(g, ctx, w, changeSetID, in.stackName); err != nil {
+			return err
+		}
+	} else {
+		renderer, err := cf.createChangeSetRenderer(g, ctx, changeSetID, in.stackName, in.stackDescription, progress.RenderOptions{})
+		if err != nil {
+			return err
+		}
+		g.Go(func() error {
+			return progress.Render(ctx, progress.NewTabbedFileWriter(in.w), renderer)
+		})
 	}
-	g.Go(func() error {
-		return progress.Render(ctx, progress.NewTabbedFileWriter(in.w), renderer)
-	})
 	if err := g.Wait(); err != nil {
 		return err
 	}
@@ -252,6 +259,34 @@ func (cf CloudFormation) createChangeSetRenderer(group *errgroup.Group, ctx cont
 	return renderer, nil
 }
 
+// writeChangeSetEvents writes the events of a stack mutated with a change set, and of its nested stacks, to w.
+func (cf CloudFormation) writeChangeSetEvents(group *errgroup.Group, ctx context.Context, w progress.EventWriter, changeSetID, stackName string) error {
+	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, stackName)
+	if err != nil {
+		return err
+	}
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange.ChangeSetId == nil {
+			continue
+		}
+		// The resource change is a nested stack.
+		nestedStackName := parseStackNameFromARN(aws.StringValue(change.ResourceChange.PhysicalResourceId))
+		if err := cf.writeChangeSetEvents(group, ctx, w, aws.StringValue(change.ResourceChange.ChangeSetId), nestedStackName); err != nil {
+			return err
+		}
+	}
+	streamer := stream.NewStackStreamer(cf.cfnClient, stackName, changeSet.CreationTime)
+	progress.WriteStackEvents(w, streamer, stackName, progress.StackEventWriterOpts{
+		ECSDescriber: cf.ecsClient,
+		Group:        group,
+		Ctx:          ctx,
+	})
+	group.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	return nil
+}
+
 type changeRenderersInput struct {
 	g                  *errgroup.Group             // Group that all goroutines belong.
 	ctx                context.Context             // Context associated with the group.
@@ -252,6 +252,88 @@ Resources:
 	require.Contains(t, buf.String(), "[completed]", "Rollout state of service should be rendered")
 }
 
+func testDeployWorkload_WriteEventsOfNewlyCreatedStackWithECSService(t *testing.T, stackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCFN := mocks.NewMockcfnClient(ctrl)
+	mockECS := mocks.NewMockecsClient(ctrl)
+	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+
+	mockCFN.EXPECT().Create(gomock.Any()).Return("1234", nil)
+	mockCFN.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{
+		Changes: []*sdkcloudformation.Change{
+			{
+				ResourceChange: &sdkcloudformation.ResourceChange{
+					LogicalResourceId: aws.String("Service"),
+					ResourceType:      aws.String("AWS::ECS::Service"),
+				},
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+		StackEvents: []*sdkcloudformation.StackEvent{
+			{
+				EventId:           aws.String("3"),
+				LogicalResourceId: aws.String(stackName),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String("CREATE_COMPLETE"),
+				Timestamp:         aws.Time(deploymentTime),
+			},
+			{
+				EventId:            aws.String("2"),
+				LogicalResourceId:  aws.String("Service"),
+				PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/cluster/service"),
+				ResourceType:       aws.String("AWS::ECS::Service"),
+				ResourceStatus:     aws.String("CREATE_COMPLETE"),
+				Timestamp:          aws.Time(deploymentTime),
+			},
+			{
+				EventId:            aws.String("1"),
+				LogicalResourceId:  aws.String("Service"),
+				PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/cluster/service"),
+				ResourceType:       aws.String("AWS::ECS::Service"),
+				ResourceStatus:     aws.String("CREATE_IN_PROGRESS"),
+				Timestamp:          aws.Time(deploymentTime),
+			},
+		},
+	}, nil).AnyTimes()
+	mockECS.EXPECT().Service("cluster", "service").Return(&ecs.Service{
+		Deployments: []*awsecs.Deployment{
+			{
+				DesiredCount:   aws.Int64(1),
+				RunningCount:   aws.Int64(1),
+				RolloutState:   aws.String("COMPLETED"),
+				Status:         aws.String("PRIMARY"),
+				TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/hello:10"),
+				UpdatedAt:      aws.Time(deploymentTime),
+			},
+		},
+	}, nil)
+	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+		StackStatus: aws.String("CREATE_COMPLETE"),
+	}, nil)
+	client := CloudFormation{cfnClient: mockCFN, ecsClient: mockECS}
+	buf := new(strings.Builder)
+
+	// WHEN
+	err := when(progress.NewJSONEventWriter(buf), client)
+
+	// THEN
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4, "every stack event and the service deployment should be written")
+	require.Contains(t, lines[0], `"type":"stackResource"`)
+	require.Contains(t, lines[0], `"resourceStatus":"CREATE_IN_PROGRESS"`)
+	require.Contains(t, lines[0], fmt.Sprintf(`"stack":"%s"`, stackName))
+	require.Contains(t, buf.String(), `"type":"ecsDeployment"`)
+	require.Contains(t, buf.String(), `"runningCount":1`)
+	require.Contains(t, buf.String(), fmt.Sprintf(`"logicalResourceId":"%s","resourceType":"AWS::CloudFormation::Stack","resourceStatus":"CREATE_COMPLETE"`, stackName))
+	require.NotContains(t, buf.String(), "\x1b", "no terminal escape sequences should be written")
+}
+
 func testDeployWorkload_WithEnvControllerRenderer_NoStackUpdates(t *testing.T, svcStackName string, when func(w progress.FileWriter, cf CloudFormation) error) {
 	// GIVEN
 	ctrl := gomock.NewController(t)
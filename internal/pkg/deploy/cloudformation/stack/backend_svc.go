@@ -84,11 +84,15 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := convertSidecar(s.manifest.Sidecars)
+	variables, envAddonsOutputs, err := s.envAddonsOutputs(s.manifest.BackendServiceConfig.Variables)
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := convertSidecar(s.manifest.Sidecars, s.rc.SidecarImages)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -116,15 +120,19 @@ func (s *BackendService) Template() (string, error) {
 		return "", err
 	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
-		Variables:                s.manifest.BackendServiceConfig.Variables,
+		Variables:                variables,
 		Secrets:                  s.manifest.BackendServiceConfig.Secrets,
 		NestedStack:              addonsOutputs,
+		EnvAddons:                envAddonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
+		TaskRole:                 convertIAMRole(s.manifest.IAM.TaskRole),
+		ExecutionRole:            convertIAMRole(s.manifest.IAM.ExecutionRole),
+		PullThroughCache:         s.pullsThroughCache(sidecars),
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(s.manifest.Logging),
@@ -136,7 +144,7 @@ func (s *BackendService) Template() (string, error) {
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
-		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
+		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.PullCredentials()),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
 		Platform:                 convertPlatform(s.manifest.Platform),
@@ -85,6 +85,25 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("parse addons parameters for %s: %w", testServiceName, errors.New("some error")),
 		},
+		"error if a variable references an environment addons output that isn't deployed": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Variables = map[string]string{
+					"TABLE": "${env.addons.SharedTableName}",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockAddons{tplErr: &addon.ErrAddonsNotFound{}}
+				svc.rc.EnvAddonsOutputs = map[string]string{
+					"OtherTableName": "other-table",
+				}
+			},
+			wantedErr: fmt.Errorf(`variable TABLE references output SharedTableName of the environment addons, which is not exported by environment %s: run "copilot env upgrade" from the workspace that holds the addons first`, testEnvName),
+		},
 		"failed parsing sidecars template": {
 			setUpManifest: func(svc *BackendService) {
 				testBackendSvcManifestWithBadSidecar := manifest.NewBackendService(baseProps)
@@ -230,6 +249,41 @@ Outputs:
 			},
 			wantedTemplate: "template",
 		},
+		"render template with environment addons": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(manifest.BackendServiceProps{
+					WorkloadProps: manifest.WorkloadProps{
+						Name:       testServiceName,
+						Dockerfile: testDockerfile,
+					},
+					Port: 8080,
+				})
+				svc.manifest.Variables = map[string]string{
+					"LOG_LEVEL": "info",
+					"TABLE":     "${env.addons.SharedTableName}",
+				}
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, map[string]string{"LOG_LEVEL": "info"}, actual.Variables)
+					require.Equal(t, &template.WorkloadEnvAddonsOpts{
+						Variables:     map[string]string{"TABLE": "SharedTableName"},
+						PolicyOutputs: []string{"SharedTableAccessPolicy"},
+					}, actual.EnvAddons)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockAddons{tplErr: &addon.ErrAddonsNotFound{}}
+				svc.rc.EnvAddonsOutputs = map[string]string{
+					"SharedTableName":         "shared-table",
+					"SharedTableAccessPolicy": "arn:aws:iam::123456789012:policy/shared-table-access",
+				}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -10,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
 )
 
 type envReadParser interface {
@@ -26,6 +28,7 @@ type envReadParser interface {
 type EnvStackConfig struct {
 	in     *deploy.CreateEnvironmentInput
 	parser envReadParser
+	addons addons // Read only if the input has an addons template URL.
 }
 
 const (
@@ -44,6 +47,8 @@ const (
 	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
 	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
+	envAddonsOutputPrefix            = "Addons" // Prefix of the outputs that export the outputs of the addons stack.
+	envAddonsStackLogicalID          = "AddonsStack"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -87,6 +92,10 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if e.in.AdjustVPCConfig != nil {
 		vpcConf = e.in.AdjustVPCConfig
 	}
+	addonsOpts, err := e.addonsOpts()
+	if err != nil {
+		return "", err
+	}
 
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
 		AppName:                e.in.App.Name,
@@ -96,6 +105,11 @@ func (e *EnvStackConfig) Template() (string, error) {
 		ScriptBucketName:       bucket,
 		ImportVPC:              e.in.ImportVPCConfig,
 		VPCConfig:              vpcConf,
+		Addons:                 addonsOpts,
+		VPCEndpoints:           e.in.VPCEndpoints,
+		ImportCertARNs:         e.in.ImportCertARNs,
+		PublicLoadBalancer:     e.in.PublicLoadBalancer,
+		PullThroughCacheRules:  e.in.PullThroughCacheRules,
 		Version:                e.in.Version,
 		LatestVersion:          deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
@@ -107,6 +121,114 @@ func (e *EnvStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+func (e *EnvStackConfig) addonsOpts() (*template.EnvAddonsOpts, error) {
+	if e.in.AddonsTemplateURL == "" {
+		if deployed := e.in.DeployedAddons; deployed != nil {
+			return &template.EnvAddonsOpts{
+				URL:         deployed.TemplateURL,
+				ExtraParams: deployed.Parameters,
+				Outputs:     deployed.Outputs,
+			}, nil
+		}
+		return nil, nil
+	}
+	if e.addons == nil {
+		envAddons, err := addon.NewEnv()
+		if err != nil {
+			return nil, fmt.Errorf("new environment addons: %w", err)
+		}
+		e.addons = envAddons
+	}
+	tpl, err := e.addons.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate addons template for environment %s: %w", e.in.Name, err)
+	}
+	outputs, err := addon.Outputs(tpl)
+	if err != nil {
+		return nil, fmt.Errorf("get addons outputs for environment %s: %w", e.in.Name, err)
+	}
+	params, err := e.addons.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("parse addons parameters for environment %s: %w", e.in.Name, err)
+	}
+	var outputNames []string
+	for _, out := range outputs {
+		outputNames = append(outputNames, out.Name)
+	}
+	return &template.EnvAddonsOpts{
+		URL:         e.in.AddonsTemplateURL,
+		ExtraParams: params,
+		Outputs:     outputNames,
+	}, nil
+}
+
+// EnvAddonsOutputs returns the outputs of the environment addons stack, keyed by output name, from the outputs
+// of the environment stack that exports them.
+func EnvAddonsOutputs(envOutputs map[string]string) map[string]string {
+	var outputs map[string]string
+	for name, value := range envOutputs {
+		if !strings.HasPrefix(name, envAddonsOutputPrefix) || name == envAddonsOutputPrefix {
+			continue
+		}
+		if outputs == nil {
+			outputs = make(map[string]string)
+		}
+		outputs[strings.TrimPrefix(name, envAddonsOutputPrefix)] = value
+	}
+	return outputs
+}
+
+// DeployedEnvAddons returns the addons stack of a deployed environment template, or nil if the environment
+// does not deploy addons.
+func DeployedEnvAddons(tpl string) (*deploy.EnvAddons, error) {
+	var body struct {
+		Resources map[string]struct {
+			Properties struct {
+				Parameters  yaml.Node `yaml:"Parameters"`
+				TemplateURL string    `yaml:"TemplateURL"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+		Outputs yaml.Node `yaml:"Outputs"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &body); err != nil {
+		return nil, fmt.Errorf("unmarshal environment template: %w", err)
+	}
+	addonsStack, ok := body.Resources[envAddonsStackLogicalID]
+	if !ok {
+		return nil, nil
+	}
+	addons := &deploy.EnvAddons{
+		TemplateURL: addonsStack.Properties.TemplateURL,
+	}
+	// The App and Env parameters are always passed to the addons stack, the remaining ones come from the addons parameters file.
+	params := addonsStack.Properties.Parameters
+	var extraParams []*yaml.Node
+	for i := 0; i+1 < len(params.Content); i += 2 {
+		if name := params.Content[i].Value; name == "App" || name == "Env" {
+			continue
+		}
+		extraParams = append(extraParams, params.Content[i], params.Content[i+1])
+	}
+	if len(extraParams) > 0 {
+		params.Content = extraParams
+		buf := new(strings.Builder)
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(&params); err != nil {
+			return nil, fmt.Errorf("marshal parameters of the environment addons stack: %w", err)
+		}
+		addons.Parameters = buf.String()
+	}
+	for i := 0; i+1 < len(body.Outputs.Content); i += 2 {
+		name := body.Outputs.Content[i].Value
+		if !strings.HasPrefix(name, envAddonsOutputPrefix) || name == envAddonsOutputPrefix {
+			continue
+		}
+		addons.Outputs = append(addons.Outputs, strings.TrimPrefix(name, envAddonsOutputPrefix))
+	}
+	return addons, nil
+}
+
 // Parameters returns the parameters to be passed into a environment CloudFormation template.
 func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
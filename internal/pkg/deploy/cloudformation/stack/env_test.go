@@ -5,6 +5,7 @@ package stack
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -46,6 +47,129 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should include the environment addons when the template URL is present": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.AddonsTemplateURL = "https://mockbucket.s3-us-west-2.amazonaws.com/environments/env.addons.stack.yml"
+				e.addons = mockAddons{
+					tpl: `
+Resources:
+  SharedTable:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  SharedTableName:
+    Value: !Ref SharedTable`,
+					params: "VpcId: !Ref VPC",
+				}
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &template.EnvAddonsOpts{
+						URL:         "https://mockbucket.s3-us-west-2.amazonaws.com/environments/env.addons.stack.yml",
+						ExtraParams: "VpcId: !Ref VPC",
+						Outputs:     []string{"SharedTableName"},
+					}, data.Addons)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should keep the deployed environment addons when the template URL is not present": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.DeployedAddons = &deploy.EnvAddons{
+					TemplateURL: "https://mockbucket.s3-us-west-2.amazonaws.com/environments/env.addons.stack.yml",
+					Parameters:  "VpcId: !Ref VPC\n",
+					Outputs:     []string{"SharedTableName"},
+				}
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &template.EnvAddonsOpts{
+						URL:         "https://mockbucket.s3-us-west-2.amazonaws.com/environments/env.addons.stack.yml",
+						ExtraParams: "VpcId: !Ref VPC\n",
+						Outputs:     []string{"SharedTableName"},
+					}, data.Addons)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should create VPC endpoints instead of NAT gateways when enabled": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.VPCEndpoints = true
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.True(t, data.VPCEndpoints)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should use imported certificates for the HTTPS listener": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.ImportCertARNs = []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"}
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"}, data.ImportCertARNs)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should protect and log the public load balancer": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.PublicLoadBalancer = &config.PublicLoadBalancer{
+					ManagedRules: true,
+					AccessLogs: &config.ALBAccessLogs{
+						Prefix:        "prod",
+						RetentionDays: 90,
+					},
+				}
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &config.PublicLoadBalancer{
+						ManagedRules: true,
+						AccessLogs: &config.ALBAccessLogs{
+							Prefix:        "prod",
+							RetentionDays: 90,
+						},
+					}, data.PublicLoadBalancer)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should create pull through cache rules": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.PullThroughCacheRules = []config.PullThroughCacheRule{
+					{
+						RepositoryPrefix:    "ecr-public",
+						UpstreamRegistryURL: "public.ecr.aws",
+					},
+				}
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, []config.PullThroughCacheRule{
+						{
+							RepositoryPrefix:    "ecr-public",
+							UpstreamRegistryURL: "public.ecr.aws",
+						},
+					}, data.PullThroughCacheRules)
+					return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+				})
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should return an error if the environment addons cannot be read": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				e.in.AddonsTemplateURL = "https://mockbucket.s3-us-west-2.amazonaws.com/environments/env.addons.stack.yml"
+				e.addons = mockAddons{tplErr: errors.New("some error")}
+			},
+			want: errors.New("generate addons template for environment env: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -250,6 +374,95 @@ func mockEnvironmentStack(stackArn, managerRoleARN, executionRoleARN string) *cl
 	}
 }
 
+func TestEnvAddonsOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		in     map[string]string
+		wanted map[string]string
+	}{
+		"nil if the environment has no addons": {
+			in: map[string]string{
+				"VpcId":     "vpc-1234",
+				"ClusterId": "cluster",
+			},
+		},
+		"returns the outputs of the addons stack": {
+			in: map[string]string{
+				"VpcId":                         "vpc-1234",
+				"AddonsSharedTableName":         "shared-table",
+				"AddonsSharedTableAccessPolicy": "arn:aws:iam::123456789012:policy/shared-table-access",
+			},
+			wanted: map[string]string{
+				"SharedTableName":         "shared-table",
+				"SharedTableAccessPolicy": "arn:aws:iam::123456789012:policy/shared-table-access",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, EnvAddonsOutputs(tc.in))
+		})
+	}
+}
+
+func TestDeployedEnvAddons(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    *deploy.EnvAddons
+		wantedErr error
+	}{
+		"nil if the environment has no addons": {
+			in: `Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+Outputs:
+  VpcId:
+    Value: !Ref VPC
+`,
+		},
+		"returns the deployed addons stack": {
+			in: `Resources:
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+    Properties:
+      Parameters:
+        App: !Ref AppName
+        Env: !Ref EnvironmentName
+        DBName: orders
+        VpcId: !Ref VPC
+      TemplateURL: https://mockbucket.s3-us-west-2.amazonaws.com/environments/test.addons.stack.yml
+Outputs:
+  VpcId:
+    Value: !Ref VPC
+  AddonsSharedTableName:
+    Value: !GetAtt AddonsStack.Outputs.SharedTableName
+  AddonsSharedTableAccessPolicy:
+    Value: !GetAtt AddonsStack.Outputs.SharedTableAccessPolicy
+`,
+			wanted: &deploy.EnvAddons{
+				TemplateURL: "https://mockbucket.s3-us-west-2.amazonaws.com/environments/test.addons.stack.yml",
+				Parameters:  "DBName: orders\nVpcId: !Ref VPC\n",
+				Outputs:     []string{"SharedTableName", "SharedTableAccessPolicy"},
+			},
+		},
+		"errors if the template is not valid YAML": {
+			in:        "Resources: [",
+			wantedErr: errors.New("unmarshal environment template: yaml: line 1: did not find expected node content"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DeployedEnvAddons(tc.in)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func mockDeployEnvironmentInput() *deploy.CreateEnvironmentInput {
 	return &deploy.CreateEnvironmentInput{
 		Name: "env",
@@ -45,6 +45,9 @@ type LoadBalancedWebService struct {
 	*ecsWkld
 	manifest     *manifest.LoadBalancedWebService
 	httpsEnabled bool
+	// importedCerts is true if the environment's HTTPS listener serves certificates imported by the user
+	// instead of a certificate validated with the application's domain.
+	importedCerts bool
 
 	// Fields for LoadBalancedWebService that needs a Network Load Balancer.
 
@@ -71,6 +74,15 @@ func WithHTTPS() func(s *LoadBalancedWebService) {
 	}
 }
 
+// WithImportedCerts enables HTTPS for a LoadBalancedWebService deployed in an environment whose HTTPS listener
+// serves certificates imported by the user. The service's aliases are not required to be in hosted zones managed by Copilot.
+func WithImportedCerts() func(s *LoadBalancedWebService) {
+	return func(s *LoadBalancedWebService) {
+		s.httpsEnabled = true
+		s.importedCerts = true
+	}
+}
+
 // WithNLB enables Network Load Balancer in a LoadBalancedWebService.
 func WithNLB(cidrBlocks []string) func(s *LoadBalancedWebService) {
 	return func(s *LoadBalancedWebService) {
@@ -141,11 +153,15 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := convertSidecar(s.manifest.Sidecars)
+	variables, envAddonsOutputs, err := s.envAddonsOutputs(s.manifest.TaskConfig.Variables)
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := convertSidecar(s.manifest.Sidecars, s.rc.SidecarImages)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
@@ -196,10 +212,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		return "", err
 	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
-		Variables:                    s.manifest.TaskConfig.Variables,
+		Variables:                    variables,
 		Secrets:                      s.manifest.TaskConfig.Secrets,
 		Aliases:                      aliases,
 		NestedStack:                  addonsOutputs,
+		EnvAddons:                    envAddonsOutputs,
 		AddonsExtraParams:            addonsParams,
 		Sidecars:                     sidecars,
 		LogConfig:                    convertLogging(s.manifest.Logging),
@@ -208,11 +225,15 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CapacityProviders:            capacityProviders,
 		DesiredCountOnSpot:           desiredCountOnSpot,
 		ExecuteCommand:               convertExecuteCommand(&s.manifest.ExecuteCommand),
+		TaskRole:                     convertIAMRole(s.manifest.IAM.TaskRole),
+		ExecutionRole:                convertIAMRole(s.manifest.IAM.ExecutionRole),
+		PullThroughCache:             s.pullsThroughCache(sidecars),
 		WorkloadType:                 manifest.LoadBalancedWebServiceType,
 		HealthCheck:                  convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
 		HTTPHealthCheck:              convertHTTPHealthCheck(&s.manifest.HealthCheck),
 		DeregistrationDelay:          deregistrationDelay,
 		AllowedSourceIps:             allowedSourceIPs,
+		SkipHTTPSRedirect:            s.manifest.RedirectToHTTPS != nil && !aws.BoolValue(s.manifest.RedirectToHTTPS),
 		RulePriorityLambda:           rulePriorityLambda.String(),
 		DesiredCountLambda:           desiredCountLambda.String(),
 		EnvControllerLambda:          envControllerLambda.String(),
@@ -222,7 +243,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		EntryPoint:                   entrypoint,
 		Command:                      command,
 		DependsOn:                    convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
-		CredentialsParameter:         aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
+		CredentialsParameter:         aws.StringValue(s.manifest.ImageConfig.Image.PullCredentials()),
 		ServiceDiscoveryEndpoint:     s.rc.ServiceDiscoveryEndpoint,
 		Publish:                      publishers,
 		Platform:                     convertPlatform(s.manifest.Platform),
@@ -307,6 +328,9 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 }
 
 func (s *LoadBalancedWebService) dnsDelegated() bool {
+	if s.importedCerts {
+		return s.dnsDelegationEnabled
+	}
 	return s.dnsDelegationEnabled || s.httpsEnabled
 }
 
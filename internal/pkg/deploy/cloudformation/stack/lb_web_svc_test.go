@@ -442,6 +442,7 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 	testCases := map[string]struct {
 		httpsEnabled         bool
 		dnsDelegationEnabled bool
+		importedCerts        bool
 		manifest             *manifest.LoadBalancedWebService
 
 		expectedParams []*cloudformation.Parameter
@@ -478,6 +479,38 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"HTTPS Enabled with imported certificates": {
+			httpsEnabled:  true,
+			importedCerts: true,
+			manifest:      testLBWebServiceManifest,
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+					ParameterValue: aws.String("2"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSDelegatedParamKey),
+					ParameterValue: aws.String("false"),
+				},
+			}...),
+		},
 		"HTTPS Not Enabled": {
 			httpsEnabled: false,
 			manifest:     testLBWebServiceManifest,
@@ -664,6 +697,7 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				manifest:             tc.manifest,
 				httpsEnabled:         tc.httpsEnabled,
 				dnsDelegationEnabled: tc.dnsDelegationEnabled,
+				importedCerts:        tc.importedCerts,
 			}
 
 			// WHEN
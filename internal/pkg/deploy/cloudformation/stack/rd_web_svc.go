@@ -111,6 +111,10 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	variables, envAddonsOutputs, err := s.envAddonsOutputs(s.manifest.Variables)
+	if err != nil {
+		return "", err
+	}
 	var layerARN, bucket, dnsDelegationRole, dnsName *string
 	var urls map[string]*string
 	if s.manifest.Alias != nil {
@@ -121,15 +125,16 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		dnsDelegationRole, dnsName = convertAppInformation(s.app)
 		layerARN = awsSDKLayerForRegion[s.rc.Region]
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app.Name, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app.Name, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
 	content, err := s.parser.ParseRequestDrivenWebService(template.WorkloadOpts{
-		Variables:         s.manifest.Variables,
+		Variables:         variables,
 		StartCommand:      s.manifest.StartCommand,
 		Tags:              s.manifest.Tags,
 		NestedStack:       addonsOutputs,
+		EnvAddons:         envAddonsOutputs,
 		AddonsExtraParams: addonsParams,
 		EnableHealthCheck: !s.healthCheckConfig.IsEmpty(),
 
@@ -4,8 +4,10 @@
 package stack
 
 import (
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +38,12 @@ const (
 	defaultNLBProtocol     = "TCP_UDP"
 )
 
+// Events published to the environment's event bus.
+const (
+	eventPatternSourceKey = "source"
+	fmtEventSource        = "copilot.%s" // Source that a service's task role is allowed to publish events with.
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -44,15 +52,52 @@ const (
 
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
+
+	// envAddonsVariableRegexp matches a variable value that references an output of the environment addons stack.
+	envAddonsVariableRegexp = regexp.MustCompile(`^\$\{env\.addons\.([a-zA-Z0-9]+)\}$`)
 )
 
+// convertEnvAddonsVariables splits the manifest variables into the plain variables and the ones that reference an
+// output of the environment addons stack, such as "${env.addons.MyTableName}", keyed by variable name.
+func convertEnvAddonsVariables(vars map[string]string) (plain map[string]string, envAddons map[string]string) {
+	for name, value := range vars {
+		matches := envAddonsVariableRegexp.FindStringSubmatch(value)
+		if matches == nil {
+			continue
+		}
+		if envAddons == nil {
+			envAddons = make(map[string]string)
+		}
+		envAddons[name] = matches[1]
+	}
+	if envAddons == nil {
+		return vars, nil
+	}
+	plain = make(map[string]string)
+	for name, value := range vars {
+		if _, ok := envAddons[name]; !ok {
+			plain[name] = value
+		}
+	}
+	return plain, envAddons
+}
+
 // convertSidecar converts the manifest sidecar configuration into a format parsable by the templates pkg.
-func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOpts, error) {
+// The images of the sidecars built from local Dockerfiles are looked up by sidecar name.
+func convertSidecar(s map[string]*manifest.SidecarConfig, images map[string]ECRImage) ([]*template.SidecarOpts, error) {
 	if s == nil {
 		return nil, nil
 	}
 	var sidecars []*template.SidecarOpts
 	for name, config := range s {
+		image := config.Image
+		if config.BuildRequired() {
+			img, ok := images[name]
+			if !ok {
+				return nil, fmt.Errorf("image of sidecar %s is built from a local Dockerfile but its location is missing", name)
+			}
+			image = aws.String(img.GetLocation())
+		}
 		port, protocol, err := parsePortMapping(config.Port)
 		if err != nil {
 			return nil, err
@@ -68,7 +113,7 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 		mp := convertSidecarMountPoints(config.MountPoints)
 		sidecars = append(sidecars, &template.SidecarOpts{
 			Name:       aws.String(name),
-			Image:      config.Image,
+			Image:      image,
 			Essential:  config.Essential,
 			Port:       port,
 			Protocol:   protocol,
@@ -345,6 +390,27 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+func convertIAMRole(r manifest.IAMRole) *template.IAMRoleOpts {
+	if r.IsEmpty() {
+		return nil
+	}
+	opts := &template.IAMRoleOpts{
+		ManagedPolicyARNs: r.ManagedPolicies,
+	}
+	for _, statement := range r.Statements {
+		effect := manifest.IAMEffectAllow
+		if statement.Effect != nil {
+			effect = aws.StringValue(statement.Effect)
+		}
+		opts.Statements = append(opts.Statements, template.IAMPolicyStatement{
+			Effect:    effect,
+			Actions:   statement.Actions,
+			Resources: statement.Resources,
+		})
+	}
+	return opts
+}
+
 func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
@@ -617,8 +683,8 @@ func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	return out, nil
 }
 
-func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc string) (*template.PublishOpts, error) {
-	if len(topics) == 0 {
+func convertPublish(p manifest.PublishConfig, accountID, region, app, env, svc string) (*template.PublishOpts, error) {
+	if len(p.Topics) == 0 && p.EventBus.IsEmpty() {
 		return nil, nil
 	}
 	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
@@ -627,9 +693,10 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 	}
 	var publishers template.PublishOpts
 	// convert the topics to template Topics
-	for _, topic := range topics {
+	for _, topic := range p.Topics {
 		publishers.Topics = append(publishers.Topics, &template.Topic{
 			Name:      topic.Name,
+			FIFO:      aws.BoolValue(topic.FIFO),
 			AccountID: accountID,
 			Partition: partition.ID(),
 			Region:    region,
@@ -638,12 +705,27 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 			Svc:       svc,
 		})
 	}
-
+	publishers.EventBus = convertEventBus(p.EventBus)
+	if publishers.Topics == nil && publishers.EventBus == nil {
+		return nil, nil
+	}
 	return &publishers, nil
 }
 
+func convertEventBus(b manifest.EventBusOrBool) *template.EventBus {
+	if !b.Advanced.IsEmpty() {
+		return &template.EventBus{
+			Name: b.Advanced.Name,
+		}
+	}
+	if aws.BoolValue(b.Enabled) {
+		return &template.EventBus{}
+	}
+	return nil
+}
+
 func convertSubscribe(s manifest.SubscribeConfig, accountID, region, app, env, svc string) (*template.SubscribeOpts, error) {
-	if s.Topics == nil {
+	if s.Topics == nil && s.Events == nil {
 		return nil, nil
 	}
 	sqsEndpoint, err := endpoints.DefaultResolver().EndpointFor(endpoints.SqsServiceID, region)
@@ -652,26 +734,71 @@ func convertSubscribe(s manifest.SubscribeConfig, accountID, region, app, env, s
 	}
 	var subscriptions template.SubscribeOpts
 	for _, sb := range s.Topics {
-		ts := convertTopicSubscription(sb, sqsEndpoint.URL, accountID, app, env, svc)
+		ts, err := convertTopicSubscription(sb, sqsEndpoint.URL, accountID, app, env, svc)
+		if err != nil {
+			return nil, err
+		}
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
+	for _, e := range s.Events {
+		es, err := convertEventSubscription(e)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions.Events = append(subscriptions.Events, es)
+	}
 	subscriptions.Queue = convertQueue(s.Queue)
 	return &subscriptions, nil
 }
 
-func convertTopicSubscription(t manifest.TopicSubscription, url, accountID, app, env, svc string) *template.TopicSubscription {
+func convertTopicSubscription(t manifest.TopicSubscription, url, accountID, app, env, svc string) (*template.TopicSubscription, error) {
+	filterPolicy, err := convertFilterPolicy(t.FilterPolicy)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "filter_policy" of topic %s: %w`, aws.StringValue(t.Name), err)
+	}
+	ts := &template.TopicSubscription{
+		Name:         t.Name,
+		Service:      t.Service,
+		Queue:        convertQueue(t.Queue.Advanced),
+		FilterPolicy: filterPolicy,
+		FIFO:         aws.BoolValue(t.FIFO),
+	}
 	if aws.BoolValue(t.Queue.Enabled) {
-		return &template.TopicSubscription{
-			Name:    t.Name,
-			Service: t.Service,
-			Queue:   &template.SQSQueue{},
+		ts.Queue = &template.SQSQueue{}
+	}
+	return ts, nil
+}
+
+func convertEventSubscription(e manifest.EventSubscription) (*template.EventSubscription, error) {
+	policy := e.Pattern
+	if e.Service != nil {
+		// Services publish to the environment's event bus with their own source, so only match the events of the service.
+		policy = make(map[string]interface{}, len(e.Pattern)+1)
+		for k, v := range e.Pattern {
+			policy[k] = v
 		}
+		policy[eventPatternSourceKey] = []interface{}{fmt.Sprintf(fmtEventSource, aws.StringValue(e.Service))}
 	}
-	return &template.TopicSubscription{
-		Name:    t.Name,
-		Service: t.Service,
-		Queue:   convertQueue(t.Queue.Advanced),
+	pattern, err := convertFilterPolicy(policy)
+	if err != nil {
+		return nil, fmt.Errorf(`convert "pattern" of event %s: %w`, aws.StringValue(e.Name), err)
+	}
+	return &template.EventSubscription{
+		Name:    e.Name,
+		Bus:     e.Bus,
+		Pattern: pattern,
+	}, nil
+}
+
+func convertFilterPolicy(policy map[string]interface{}) (*string, error) {
+	if len(policy) == 0 {
+		return nil, nil
+	}
+	out, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
 	}
+	return aws.String(string(out)), nil
 }
 
 func convertQueue(q manifest.SQSQueue) *template.SQSQueue {
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -220,7 +221,7 @@ func Test_convertSidecar(t *testing.T) {
 					HealthCheck:   tc.inHealthCheck,
 				},
 			}
-			got, err := convertSidecar(sidecar)
+			got, err := convertSidecar(sidecar, nil)
 
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
@@ -232,6 +233,110 @@ func Test_convertSidecar(t *testing.T) {
 	}
 }
 
+func Test_convertSidecar_buildFromDockerfile(t *testing.T) {
+	testCases := map[string]struct {
+		inImages map[string]ECRImage
+
+		wantedImage *string
+		wantedErr   error
+	}{
+		"error if the image location is missing": {
+			wantedErr: errors.New("image of sidecar logshipper is built from a local Dockerfile but its location is missing"),
+		},
+		"use the digest of the pushed image": {
+			inImages: map[string]ECRImage{
+				"logshipper": {
+					RepoURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc",
+					Digest:  "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+				},
+			},
+			wantedImage: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"),
+		},
+		"use the tag of the pushed image": {
+			inImages: map[string]ECRImage{
+				"logshipper": {
+					RepoURL:  "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc",
+					ImageTag: "logshipper-g123bfc",
+				},
+			},
+			wantedImage: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc:logshipper-g123bfc"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sidecars := map[string]*manifest.SidecarConfig{
+				"logshipper": {
+					Build: manifest.BuildArgsOrString{
+						BuildString: aws.String("sidecars/logshipper/Dockerfile"),
+					},
+				},
+			}
+
+			got, err := convertSidecar(sidecars, tc.inImages)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedImage, got[0].Image)
+			}
+		})
+	}
+}
+
+func Test_convertEnvAddonsVariables(t *testing.T) {
+	testCases := map[string]struct {
+		in map[string]string
+
+		wantedPlain     map[string]string
+		wantedEnvAddons map[string]string
+	}{
+		"no variables": {},
+		"keeps variables without references": {
+			in: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+			wantedPlain: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+		},
+		"splits references to environment addons outputs": {
+			in: map[string]string{
+				"LOG_LEVEL": "info",
+				"TABLE":     "${env.addons.SharedTableName}",
+				"BUCKET":    "${env.addons.SharedBucketName}",
+			},
+			wantedPlain: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+			wantedEnvAddons: map[string]string{
+				"TABLE":  "SharedTableName",
+				"BUCKET": "SharedBucketName",
+			},
+		},
+		"ignores references that are not the full value": {
+			in: map[string]string{
+				"TABLE": "prefix-${env.addons.SharedTableName}",
+				"QUEUE": "${env.addons.shared-queue}",
+			},
+			wantedPlain: map[string]string{
+				"TABLE": "prefix-${env.addons.SharedTableName}",
+				"QUEUE": "${env.addons.shared-queue}",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			plain, envAddons := convertEnvAddonsVariables(tc.in)
+
+			// THEN
+			require.Equal(t, tc.wantedPlain, plain)
+			require.Equal(t, tc.wantedEnvAddons, envAddons)
+		})
+	}
+}
+
 func Test_convertAdvancedCount(t *testing.T) {
 	mockRange := manifest.IntRangeBand("1-10")
 	mockPerc := manifest.Percentage(70)
@@ -1162,6 +1267,55 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertIAMRole(t *testing.T) {
+	testCases := map[string]struct {
+		inRole manifest.IAMRole
+
+		wanted *template.IAMRoleOpts
+	}{
+		"empty role": {
+			inRole: manifest.IAMRole{},
+			wanted: nil,
+		},
+		"statements default to Allow": {
+			inRole: manifest.IAMRole{
+				ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				Statements: []manifest.IAMPolicyStatement{
+					{
+						Actions:   []string{"kms:Decrypt"},
+						Resources: []string{"arn:aws:kms:us-west-2:123456789012:key/mockKey"},
+					},
+					{
+						Effect:    aws.String("Deny"),
+						Actions:   []string{"s3:DeleteObject"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wanted: &template.IAMRoleOpts{
+				ManagedPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				Statements: []template.IAMPolicyStatement{
+					{
+						Effect:    "Allow",
+						Actions:   []string{"kms:Decrypt"},
+						Resources: []string{"arn:aws:kms:us-west-2:123456789012:key/mockKey"},
+					},
+					{
+						Effect:    "Deny",
+						Actions:   []string{"s3:DeleteObject"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertIAMRole(tc.inRole))
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1247,26 +1401,62 @@ func Test_convertPublish(t *testing.T) {
 	env := "testenv"
 	svc := "hello"
 	testCases := map[string]struct {
-		inTopics []manifest.Topic
+		inPublish manifest.PublishConfig
 
 		wanted      *template.PublishOpts
 		wantedError error
 	}{
 		"no manifest publishers should return nil": {
-			inTopics: nil,
-			wanted:   nil,
+			wanted: nil,
 		},
 		"empty manifest publishers should return nil": {
-			inTopics: []manifest.Topic{},
-			wanted:   nil,
+			inPublish: manifest.PublishConfig{
+				Topics: []manifest.Topic{},
+			},
+			wanted: nil,
 		},
-		"valid publish": {
-			inTopics: []manifest.Topic{
-				{
-					Name: aws.String("topic1"),
+		"disabled event bus should return nil": {
+			inPublish: manifest.PublishConfig{
+				EventBus: manifest.EventBusOrBool{
+					Enabled: aws.Bool(false),
 				},
-				{
-					Name: aws.String("topic2"),
+			},
+			wanted: nil,
+		},
+		"valid publish with a new event bus": {
+			inPublish: manifest.PublishConfig{
+				EventBus: manifest.EventBusOrBool{
+					Enabled: aws.Bool(true),
+				},
+			},
+			wanted: &template.PublishOpts{
+				EventBus: &template.EventBus{},
+			},
+		},
+		"valid publish with an existing event bus": {
+			inPublish: manifest.PublishConfig{
+				EventBus: manifest.EventBusOrBool{
+					Advanced: manifest.EventBus{
+						Name: aws.String("partner-bus"),
+					},
+				},
+			},
+			wanted: &template.PublishOpts{
+				EventBus: &template.EventBus{
+					Name: aws.String("partner-bus"),
+				},
+			},
+		},
+		"valid publish": {
+			inPublish: manifest.PublishConfig{
+				Topics: []manifest.Topic{
+					{
+						Name: aws.String("topic1"),
+					},
+					{
+						Name: aws.String("topic2"),
+						FIFO: aws.Bool(true),
+					},
 				},
 			},
 			wanted: &template.PublishOpts{
@@ -1283,6 +1473,7 @@ func Test_convertPublish(t *testing.T) {
 					{
 
 						Name:      aws.String("topic2"),
+						FIFO:      true,
 						AccountID: accountId,
 						Partition: partition,
 						Region:    region,
@@ -1296,7 +1487,7 @@ func Test_convertPublish(t *testing.T) {
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got, err := convertPublish(tc.inTopics, accountId, region, app, env, svc)
+			got, err := convertPublish(tc.inPublish, accountId, region, app, env, svc)
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
@@ -1356,6 +1547,63 @@ func Test_convertSubscribe(t *testing.T) {
 				},
 			},
 		},
+		"valid subscribe to a FIFO topic with a filter policy": {
+			inSubscribe: manifest.SubscribeConfig{
+				Topics: []manifest.TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("svc"),
+						FIFO:    aws.Bool(true),
+						FilterPolicy: map[string]interface{}{
+							"event": []interface{}{"order_placed"},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:         aws.String("orders"),
+						Service:      aws.String("svc"),
+						FilterPolicy: aws.String(`{"event":["order_placed"]}`),
+						FIFO:         true,
+					},
+				},
+			},
+		},
+		"valid subscribe to events": {
+			inSubscribe: manifest.SubscribeConfig{
+				Events: []manifest.EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Service: aws.String("api"),
+						Pattern: map[string]interface{}{
+							"detail-type": []interface{}{"OrderPlaced"},
+						},
+					},
+					{
+						Name: aws.String("partnerOrders"),
+						Bus:  aws.String("partner-bus"),
+						Pattern: map[string]interface{}{
+							"source": []interface{}{"partner.orders"},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Events: []*template.EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Pattern: aws.String(`{"detail-type":["OrderPlaced"],"source":["copilot.api"]}`),
+					},
+					{
+						Name:    aws.String("partnerOrders"),
+						Bus:     aws.String("partner-bus"),
+						Pattern: aws.String(`{"source":["partner.orders"]}`),
+					},
+				},
+			},
+		},
 		"valid subscribe with minimal queue": {
 			inSubscribe: manifest.SubscribeConfig{
 				Topics: []manifest.TopicSubscription{
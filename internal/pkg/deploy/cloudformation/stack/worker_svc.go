@@ -82,7 +82,11 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := convertSidecar(s.manifest.Sidecars)
+	variables, envAddonsOutputs, err := s.envAddonsOutputs(s.manifest.WorkerServiceConfig.Variables)
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := convertSidecar(s.manifest.Sidecars, s.rc.SidecarImages)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -112,20 +116,24 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	publishers, err := convertPublish(s.manifest.Publish(), s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
+	publishers, err := convertPublish(s.manifest.PublishConfig, s.rc.AccountID, s.rc.Region, s.app, s.env, s.name)
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for service %s: %w`, s.name, err)
 	}
 	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
-		Variables:                      s.manifest.WorkerServiceConfig.Variables,
+		Variables:                      variables,
 		Secrets:                        s.manifest.WorkerServiceConfig.Secrets,
 		NestedStack:                    addonsOutputs,
+		EnvAddons:                      envAddonsOutputs,
 		AddonsExtraParams:              addonsParams,
 		Sidecars:                       sidecars,
 		Autoscaling:                    autoscaling,
 		CapacityProviders:              capacityProviders,
 		DesiredCountOnSpot:             desiredCountOnSpot,
 		ExecuteCommand:                 convertExecuteCommand(&s.manifest.ExecuteCommand),
+		TaskRole:                       convertIAMRole(s.manifest.IAM.TaskRole),
+		ExecutionRole:                  convertIAMRole(s.manifest.IAM.ExecutionRole),
+		PullThroughCache:               s.pullsThroughCache(sidecars),
 		WorkloadType:                   manifest.WorkerServiceType,
 		HealthCheck:                    convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                      convertLogging(s.manifest.Logging),
@@ -138,7 +146,7 @@ func (s *WorkerService) Template() (string, error) {
 		EntryPoint:                     entrypoint,
 		Command:                        command,
 		DependsOn:                      convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
-		CredentialsParameter:           aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
+		CredentialsParameter:           aws.StringValue(s.manifest.ImageConfig.Image.PullCredentials()),
 		ServiceDiscoveryEndpoint:       s.rc.ServiceDiscoveryEndpoint,
 		Subscribe:                      subscribe,
 		Publish:                        publishers,
@@ -6,9 +6,12 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
@@ -62,10 +65,13 @@ const (
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
-	Image             *ECRImage         // Optional. Image location in an ECR repository.
-	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
-	EnvFileARN        string            // Optional. S3 object ARN for the env file.
-	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the workload stack.
+	Image                    *ECRImage           // Optional. Image location in an ECR repository.
+	SidecarImages            map[string]ECRImage // Optional. Image locations of the sidecars built from local Dockerfiles, keyed by sidecar name.
+	AddonsTemplateURL        string              // Optional. S3 object URL for the addons template.
+	EnvFileARN               string              // Optional. S3 object ARN for the env file.
+	EnvAddonsOutputs         map[string]string   // Optional. Outputs of the environment addons stack as deployed, keyed by output name.
+	PullThroughCachePrefixes []string            // Optional. Repository prefixes of the pull through cache rules of the environment.
+	AdditionalTags           map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
 
 	// The target environment metadata.
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
@@ -212,6 +218,55 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+// envAddonsOutputs returns the variables that are not references to the environment addons stack, and
+// the outputs of the environment addons stack that the workload should import.
+// The outputs are the ones exported by the deployed environment stack, rather than the ones of the local
+// environment addons templates, so that the workload never imports a value that isn't exported.
+func (w *wkld) envAddonsOutputs(vars map[string]string) (map[string]string, *template.WorkloadEnvAddonsOpts, error) {
+	plainVars, envAddonsVars := convertEnvAddonsVariables(vars)
+	for _, name := range sortedKeys(envAddonsVars) {
+		if _, ok := w.rc.EnvAddonsOutputs[envAddonsVars[name]]; !ok {
+			return nil, nil, fmt.Errorf("variable %s references output %s of the environment addons, which is not exported by environment %s: run %q from the workspace that holds the addons first",
+				name, envAddonsVars[name], w.env, "copilot env upgrade")
+		}
+	}
+	policies := managedPolicyEnvAddonsOutputNames(w.rc.EnvAddonsOutputs)
+	if len(envAddonsVars) == 0 && len(policies) == 0 {
+		return plainVars, nil, nil
+	}
+	return plainVars, &template.WorkloadEnvAddonsOpts{
+		Variables:     envAddonsVars,
+		PolicyOutputs: policies,
+	}, nil
+}
+
+// pullsThroughCache returns true if the image of the workload or of one of its sidecars is pulled
+// through a pull through cache rule of the environment.
+func (w *wkld) pullsThroughCache(sidecars []*template.SidecarOpts) bool {
+	images := make([]string, 0, len(sidecars)+1)
+	if w.image != nil {
+		images = append(images, w.image.GetLocation())
+	}
+	for _, sidecar := range sidecars {
+		images = append(images, aws.StringValue(sidecar.Image))
+	}
+	// Cached images are pulled from the environment's own registry, for example
+	// "123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub/library/nginx:latest".
+	registry := fmt.Sprintf("%s.dkr.ecr.%s.", w.rc.AccountID, w.rc.Region)
+	for _, image := range images {
+		i := strings.Index(image, "/")
+		if i == -1 || !strings.HasPrefix(image, registry) {
+			continue
+		}
+		for _, prefix := range w.rc.PullThroughCachePrefixes {
+			if strings.HasPrefix(image[i+1:], prefix+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (w *wkld) addonsParameters() (string, error) {
 	params, err := w.addons.Parameters()
 	if err != nil {
@@ -254,6 +309,30 @@ func managedPolicyOutputNames(outputs []addon.Output) []string {
 	return policies
 }
 
+// managedPolicyEnvAddonsOutputNames returns the sorted names of the environment addons outputs that are IAM managed policy ARNs.
+func managedPolicyEnvAddonsOutputNames(outputs map[string]string) []string {
+	var policies []string
+	for _, name := range sortedKeys(outputs) {
+		parsed, err := arn.Parse(outputs[name])
+		if err != nil {
+			continue
+		}
+		if parsed.Service == "iam" && strings.HasPrefix(parsed.Resource, "policy/") {
+			policies = append(policies, name)
+		}
+	}
+	return policies
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func envVarOutputNames(outputs []addon.Output) []string {
 	var envVars []string
 	for _, out := range outputs {
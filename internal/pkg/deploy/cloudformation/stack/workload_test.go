@@ -6,6 +6,9 @@ package stack
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +47,52 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestWkld_pullsThroughCache(t *testing.T) {
+	testCases := map[string]struct {
+		image    string
+		sidecars []*template.SidecarOpts
+		prefixes []string
+
+		wanted bool
+	}{
+		"false without pull through cache rules": {
+			image: "123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub/library/nginx:latest",
+		},
+		"true if the image is pulled through a cache rule": {
+			image:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub/library/nginx:latest",
+			prefixes: []string{"quay", "docker-hub"},
+			wanted:   true,
+		},
+		"true if a sidecar image is pulled through a cache rule": {
+			image: "nginx",
+			sidecars: []*template.SidecarOpts{
+				{Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/quay/prometheus/node-exporter")},
+			},
+			prefixes: []string{"quay"},
+			wanted:   true,
+		},
+		"false if the repository only shares the beginning of a prefix": {
+			image:    "123456789012.dkr.ecr.us-west-2.amazonaws.com/docker-hub-mirror/nginx:latest",
+			prefixes: []string{"docker-hub"},
+		},
+		"false if the image is in the registry of another region": {
+			image:    "123456789012.dkr.ecr.us-east-1.amazonaws.com/docker-hub/library/nginx:latest",
+			prefixes: []string{"docker-hub"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := &wkld{
+				image: manifest.Image{Location: aws.String(tc.image)},
+				rc: RuntimeConfig{
+					PullThroughCachePrefixes: tc.prefixes,
+					AccountID:                "123456789012",
+					Region:                   "us-west-2",
+				},
+			}
+			require.Equal(t, tc.wanted, w.pullsThroughCache(tc.sidecars))
+		})
+	}
+}
@@ -94,6 +94,9 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with addons template if stack creation is successful", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithAddons(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("writes the events of a stack with an ECS service as JSON", func(t *testing.T) {
+		testDeployWorkload_WriteEventsOfNewlyCreatedStackWithECSService(t, "myapp-myenv-mysvc", when)
+	})
 }
 
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
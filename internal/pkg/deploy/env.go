@@ -13,7 +13,12 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.7.0"
+	LatestEnvTemplateVersion = "v1.13.0"
+	// EventBusLeastEnvTemplateVersion is the least environment template version that creates an EventBridge event bus.
+	EventBusLeastEnvTemplateVersion = "v1.8.0"
+
+	// EnvAddonsCfnTemplateNameFormat is the S3 object key of an environment's addons template.
+	EnvAddonsCfnTemplateNameFormat = "environments/%s.addons.stack.yml"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -21,13 +26,19 @@ type CreateEnvironmentInput struct {
 	// The version of the environment template to create the stack. If empty, creates the legacy stack.
 	Version string
 
-	App                 AppInformation    // Information about the application that the environment belongs to, include app name, DNS name, the principal ARN of the account.
-	Name                string            // Name of the environment, must be unique within an application.
-	Prod                bool              // Whether or not this environment is a production environment.
-	AdditionalTags      map[string]string // AdditionalTags are labels applied to resources under the application.
-	CustomResourcesURLs map[string]string // Environment custom resource script S3 object URLs.
-	ImportVPCConfig     *config.ImportVPC // Optional configuration if users have an existing VPC.
-	AdjustVPCConfig     *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	App                   AppInformation                // Information about the application that the environment belongs to, include app name, DNS name, the principal ARN of the account.
+	Name                  string                        // Name of the environment, must be unique within an application.
+	Prod                  bool                          // Whether or not this environment is a production environment.
+	AdditionalTags        map[string]string             // AdditionalTags are labels applied to resources under the application.
+	CustomResourcesURLs   map[string]string             // Environment custom resource script S3 object URLs.
+	ImportVPCConfig       *config.ImportVPC             // Optional configuration if users have an existing VPC.
+	AdjustVPCConfig       *config.AdjustVPC             // Optional configuration if users want to override default VPC configuration.
+	VPCEndpoints          bool                          // Optional. True means private subnets reach AWS services through VPC endpoints instead of NAT gateways.
+	ImportCertARNs        []string                      // Optional. ACM certificates for the HTTPS listener of the public load balancer.
+	PublicLoadBalancer    *config.PublicLoadBalancer    // Optional. Web ACL and access logs configuration of the public load balancer.
+	PullThroughCacheRules []config.PullThroughCacheRule // Optional. ECR pull through cache rules for upstream registries.
+	AddonsTemplateURL     string                        // Optional. S3 object URL for the addons template shared by all workloads in the environment.
+	DeployedAddons        *EnvAddons                    // Optional. Addons stack already deployed with the environment, kept if AddonsTemplateURL is empty.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
@@ -38,3 +49,10 @@ type CreateEnvironmentResponse struct {
 	Env *config.Environment
 	Err error
 }
+
+// EnvAddons holds the configuration of an addons stack that is already deployed with an environment.
+type EnvAddons struct {
+	TemplateURL string   // S3 object URL of the addons template.
+	Parameters  string   // Parameters passed to the addons stack in addition to App and Env, as YAML.
+	Outputs     []string // Names of the addons stack outputs that the environment stack exports.
+}
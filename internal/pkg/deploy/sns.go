@@ -24,6 +24,8 @@ var (
 	fmtTopicDescription = "%s (%s)"
 )
 
+const fifoTopicSuffix = ".fifo"
+
 // Topic holds information about a Copilot SNS topic and its ARN, ID, and Name.
 type Topic struct {
 	awsARN arn.ARN
@@ -31,6 +33,7 @@ type Topic struct {
 	wkld   string
 
 	name string
+	fifo bool
 }
 
 // NewTopic creates a new Topic struct, validating the ARN as a Copilot-managed SNS topic.
@@ -79,6 +82,9 @@ func (t Topic) Workload() string { return t.wkld }
 // Name returns the name of the given topic.
 func (t Topic) Name() string { return t.name }
 
+// FIFO returns true if the topic is a FIFO topic.
+func (t Topic) FIFO() bool { return t.fifo }
+
 // validateAndExtractName determines whether the given ARN is a Copilot-valid SNS topic ARN.
 // It extracts the topic name from the ARN resource field.
 func (t *Topic) validateAndExtractName() error {
@@ -92,6 +98,10 @@ func (t *Topic) validateAndExtractName() error {
 	}
 
 	t.name = t.awsARN.Resource[len(t.prefix):]
+	if strings.HasSuffix(t.name, fifoTopicSuffix) {
+		t.name = strings.TrimSuffix(t.name, fifoTopicSuffix)
+		t.fifo = true
+	}
 
 	return nil
 }
@@ -70,3 +70,30 @@ func TestTopic_String(t *testing.T) {
 		})
 	}
 }
+
+func TestTopic_FIFO(t *testing.T) {
+	testCases := map[string]struct {
+		inputARN string
+
+		wantedName string
+		wantedFIFO bool
+	}{
+		"standard topic": {
+			inputARN:   mockGoodARN,
+			wantedName: "topic",
+		},
+		"FIFO topic": {
+			inputARN:   "arn:aws:sns:us-west-2:12345678012:app-env-svc-orders.fifo",
+			wantedName: "orders",
+			wantedFIFO: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			topic, err := NewTopic(tc.inputARN, mockApp, mockEnv, mockSvc)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, topic.Name())
+			require.Equal(t, tc.wantedFIFO, topic.FIFO())
+		})
+	}
+}
@@ -47,6 +47,8 @@ type LBWebServiceDescriber struct {
 
 	// cache only last svc paramerters
 	svcParams map[string]string
+	// cache only last CNAME records that the user has to create for the svc's aliases
+	cnameRecords []*CNAMERecord
 }
 
 // NewLBWebServiceDescriber instantiates a load balanced service describer.
@@ -95,6 +97,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	var routes []*WebServiceRoute
+	var cnameRecords []*CNAMERecord
 	var configs []*ECSServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
@@ -112,6 +115,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		cnameRecords = append(cnameRecords, d.cnameRecords...)
 		containerPlatform, err := d.ecsServiceDescribers[env].Platform()
 		if err != nil {
 			return nil, fmt.Errorf("retrieve platform: %w", err)
@@ -167,6 +171,7 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		App:              d.app,
 		Configurations:   configs,
 		Routes:           routes,
+		CNAMERecords:     cnameRecords,
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Secrets:          secrets,
@@ -238,6 +243,24 @@ type WebServiceRoute struct {
 	URL         string `json:"url"`
 }
 
+// CNAMERecord is a DNS record that the user has to create to route an alias to the load balancer of the environment.
+type CNAMERecord struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+}
+
+type cnameRecords []*CNAMERecord
+
+func (r cnameRecords) humanString(w io.Writer) {
+	headers := []string{"Environment", "Name", "Value"}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, record := range r {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", record.Environment, record.Name, record.Value)
+	}
+}
+
 // ServiceDiscovery contains serialized service discovery info for an service.
 type ServiceDiscovery struct {
 	Environment []string `json:"environment"`
@@ -262,6 +285,7 @@ type webSvcDesc struct {
 	App              string               `json:"application"`
 	Configurations   ecsConfigurations    `json:"configurations"`
 	Routes           []*WebServiceRoute   `json:"routes"`
+	CNAMERecords     cnameRecords         `json:"cnameRecords,omitempty"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
@@ -299,6 +323,11 @@ func (w *webSvcDesc) HumanString() string {
 	for _, route := range w.Routes {
 		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
 	}
+	if len(w.CNAMERecords) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nCNAME Records\n\n"))
+		writer.Flush()
+		w.CNAMERecords.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
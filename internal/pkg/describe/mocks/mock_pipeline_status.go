@@ -48,3 +48,18 @@ func (mr *MockpipelineStateGetterMockRecorder) GetPipelineState(pipelineName int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStateGetter)(nil).GetPipelineState), pipelineName)
 }
+
+// LatestExecutionStatus mocks base method.
+func (m *MockpipelineStateGetter) LatestExecutionStatus(pipelineName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestExecutionStatus", pipelineName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestExecutionStatus indicates an expected call of LatestExecutionStatus.
+func (mr *MockpipelineStateGetterMockRecorder) LatestExecutionStatus(pipelineName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestExecutionStatus", reflect.TypeOf((*MockpipelineStateGetter)(nil).LatestExecutionStatus), pipelineName)
+}
@@ -17,6 +17,7 @@ import (
 
 type pipelineStateGetter interface {
 	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+	LatestExecutionStatus(pipelineName string) (string, error)
 }
 
 // PipelineStatusDescriber retrieves status of a pipeline.
@@ -28,6 +29,8 @@ type PipelineStatusDescriber struct {
 // PipelineStatus contains the status for a pipeline.
 type PipelineStatus struct {
 	codepipeline.PipelineState
+
+	latestExecutionStatus string // Status of the latest execution of the pipeline, empty if it was never executed.
 }
 
 // NewPipelineStatusDescriber instantiates a new PipelineStatus struct.
@@ -50,7 +53,14 @@ func (d *PipelineStatusDescriber) Describe() (HumanJSONStringer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get pipeline status: %w", err)
 	}
-	pipelineStatus := &PipelineStatus{*ps}
+	status, err := d.pipelineSvc.LatestExecutionStatus(d.pipelineName)
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline status: %w", err)
+	}
+	pipelineStatus := &PipelineStatus{
+		PipelineState:         *ps,
+		latestExecutionStatus: status,
+	}
 	return pipelineStatus, nil
 }
 
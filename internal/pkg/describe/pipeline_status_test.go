@@ -93,12 +93,23 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			expectedError:  fmt.Errorf("get pipeline status: %w", mockError),
 			expectedOutput: nil,
 		},
+		"wraps LatestExecutionStatus error": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineName).Return(mockPipelineState, nil)
+				m.pipelineStateGetter.EXPECT().LatestExecutionStatus(pipelineName).Return("", mockError)
+			},
+			expectedError: fmt.Errorf("get pipeline status: %w", mockError),
+		},
 		"success": {
 			setupMocks: func(m pipelineStatusDescriberMocks) {
 				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineName).Return(mockPipelineState, nil)
+				m.pipelineStateGetter.EXPECT().LatestExecutionStatus(pipelineName).Return("InProgress", nil)
+			},
+			expectedError: nil,
+			expectedOutput: &PipelineStatus{
+				PipelineState:         *mockPipelineState,
+				latestExecutionStatus: "InProgress",
 			},
-			expectedError:  nil,
-			expectedOutput: &PipelineStatus{*mockPipelineState},
 		},
 	}
 	for name, tc := range testCases {
@@ -147,7 +158,7 @@ func TestPipelineStatusDescriber_String(t *testing.T) {
 		expectedJSONString  string
 	}{
 		"correct output with correct aggregate statuses": {
-			testPipelineStatus: &PipelineStatus{*mockPipelineState},
+			testPipelineStatus: &PipelineStatus{PipelineState: *mockPipelineState},
 			expectedHumanString: `Pipeline Status
 
 Stage             Transition  Status
@@ -0,0 +1,225 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	sqsQueueType = "AWS::SQS::Queue"
+
+	// Worker service queues are named "EventsQueue" and "DeadLetterQueue", or prefixed by the publishing service and topic names
+	// for topic-specific queues, for example "apiordersEventsQueue" and "apiordersDeadLetterQueue".
+	eventsQueueSuffix     = "EventsQueue"
+	deadLetterQueueSuffix = "DeadLetterQueue"
+
+	sqsMetricNamespace        = "AWS/SQS"
+	sqsOldestMessageAgeMetric = "ApproximateAgeOfOldestMessage"
+	sqsQueueNameDimension     = "QueueName"
+)
+
+type stackResourcesDescriber interface {
+	ServiceStackResources() ([]*stack.Resource, error)
+}
+
+type queueAttributesGetter interface {
+	QueueAttributes(url string) (*sqs.QueueAttributes, error)
+}
+
+type latestMetricGetter interface {
+	LatestMaximum(namespace, metricName string, dimensions map[string]string) (*float64, error)
+}
+
+// WorkerQueue is an SQS queue of a worker service and its dead-letter queue.
+type WorkerQueue struct {
+	Name          string // Logical ID of the queue in the service stack.
+	URL           string
+	DeadLetterURL string // Empty if the queue does not have a dead-letter queue.
+}
+
+// QueueStats contains the approximate message counts of a worker service queue.
+type QueueStats struct {
+	Name                    string   `json:"name"`
+	Messages                int64    `json:"messages"`
+	InFlight                int64    `json:"inFlight"`
+	Delayed                 int64    `json:"delayed"`
+	OldestMessageAgeSeconds *float64 `json:"oldestMessageAgeSeconds,omitempty"`
+	DeadLetterMessages      *int64   `json:"deadLetterMessages,omitempty"`
+}
+
+// WorkerQueuesStats contains the stats of all the queues of a worker service.
+type WorkerQueuesStats struct {
+	Queues []QueueStats `json:"queues"`
+}
+
+// QueueDescriber retrieves information about the SQS queues of a worker service.
+type QueueDescriber struct {
+	stack   stackResourcesDescriber
+	sqs     queueAttributesGetter
+	metrics latestMetricGetter
+}
+
+// NewQueueDescriber instantiates a new QueueDescriber.
+func NewQueueDescriber(opt NewServiceConfig) (*QueueDescriber, error) {
+	stackDescriber, err := newServiceStackDescriber(opt)
+	if err != nil {
+		return nil, err
+	}
+	return newQueueDescriber(stackDescriber, stackDescriber.sess), nil
+}
+
+func newQueueDescriber(stackDescriber stackResourcesDescriber, sess *session.Session) *QueueDescriber {
+	return &QueueDescriber{
+		stack:   stackDescriber,
+		sqs:     sqs.New(sess),
+		metrics: cloudwatch.New(sess),
+	}
+}
+
+// Queues returns the queues of the worker service sorted by name.
+func (d *QueueDescriber) Queues() ([]*WorkerQueue, error) {
+	resources, err := d.stack.ServiceStackResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve service stack resources: %w", err)
+	}
+	dlqs := make(map[string]string)
+	var queues []*WorkerQueue
+	for _, r := range resources {
+		if r.Type != sqsQueueType {
+			continue
+		}
+		switch {
+		case strings.HasSuffix(r.LogicalID, deadLetterQueueSuffix):
+			dlqs[strings.TrimSuffix(r.LogicalID, deadLetterQueueSuffix)] = r.PhysicalID
+		case strings.HasSuffix(r.LogicalID, eventsQueueSuffix):
+			queues = append(queues, &WorkerQueue{
+				Name: r.LogicalID,
+				URL:  r.PhysicalID, // The physical ID of an SQS queue is its URL.
+			})
+		}
+	}
+	for _, q := range queues {
+		q.DeadLetterURL = dlqs[strings.TrimSuffix(q.Name, eventsQueueSuffix)]
+	}
+	sortQueues(queues)
+	return queues, nil
+}
+
+// Stats returns the approximate message counts of all the queues of the worker service.
+func (d *QueueDescriber) Stats() (*WorkerQueuesStats, error) {
+	queues, err := d.Queues()
+	if err != nil {
+		return nil, err
+	}
+	stats := &WorkerQueuesStats{
+		Queues: make([]QueueStats, 0, len(queues)),
+	}
+	for _, q := range queues {
+		s, err := d.QueueStats(q)
+		if err != nil {
+			return nil, err
+		}
+		stats.Queues = append(stats.Queues, *s)
+	}
+	return stats, nil
+}
+
+// QueueStats returns the approximate message counts of a single queue.
+func (d *QueueDescriber) QueueStats(q *WorkerQueue) (*QueueStats, error) {
+	attrs, err := d.sqs.QueueAttributes(q.URL)
+	if err != nil {
+		return nil, fmt.Errorf("get stats of queue %s: %w", q.Name, err)
+	}
+	age, err := d.metrics.LatestMaximum(sqsMetricNamespace, sqsOldestMessageAgeMetric, map[string]string{
+		sqsQueueNameDimension: queueNameFromURL(q.URL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get age of oldest message in queue %s: %w", q.Name, err)
+	}
+	stats := &QueueStats{
+		Name:                    q.Name,
+		Messages:                attrs.Visible,
+		InFlight:                attrs.InFlight,
+		Delayed:                 attrs.Delayed,
+		OldestMessageAgeSeconds: age,
+	}
+	if q.DeadLetterURL == "" {
+		return stats, nil
+	}
+	dlqAttrs, err := d.sqs.QueueAttributes(q.DeadLetterURL)
+	if err != nil {
+		return nil, fmt.Errorf("get stats of dead-letter queue for %s: %w", q.Name, err)
+	}
+	stats.DeadLetterMessages = &dlqAttrs.Visible
+	return stats, nil
+}
+
+// JSONString returns the stringified WorkerQueuesStats struct with json format.
+func (s *WorkerQueuesStats) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal queue stats: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified WorkerQueuesStats struct with human readable format.
+func (s *WorkerQueuesStats) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	writeQueueStats(writer, s.Queues)
+	writer.Flush()
+	return b.String()
+}
+
+func writeQueueStats(writer io.Writer, queues []QueueStats) {
+	headers := []string{"Queue", "Messages", "In Flight", "Delayed", "Oldest Message", "Dead-Letter Messages"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, q := range queues {
+		oldest := "-"
+		if q.OldestMessageAgeSeconds != nil {
+			oldest = (time.Duration(*q.OldestMessageAgeSeconds) * time.Second).String()
+		}
+		dlq := "-"
+		if q.DeadLetterMessages != nil {
+			dlq = fmt.Sprintf("%d", *q.DeadLetterMessages)
+			if *q.DeadLetterMessages > 0 {
+				dlq = color.Red.Sprint(dlq)
+			}
+		}
+		fmt.Fprintf(writer, "  %s\t%d\t%d\t%d\t%s\t%s\n", q.Name, q.Messages, q.InFlight, q.Delayed, oldest, dlq)
+	}
+}
+
+// sortQueues sorts the queues by name, with the default "EventsQueue" first.
+func sortQueues(queues []*WorkerQueue) {
+	sort.SliceStable(queues, func(i, j int) bool {
+		if queues[i].Name == eventsQueueSuffix || queues[j].Name == eventsQueueSuffix {
+			return queues[i].Name == eventsQueueSuffix && queues[j].Name != eventsQueueSuffix
+		}
+		return queues[i].Name < queues[j].Name
+	})
+}
+
+// queueNameFromURL returns the name of a queue given its URL.
+// For example: https://sqs.us-west-2.amazonaws.com/123456789012/app-env-svc-EventsQueue-1A2B3C
+// returns app-env-svc-EventsQueue-1A2B3C
+func queueNameFromURL(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
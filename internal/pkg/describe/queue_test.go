@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockEventsQueueURL     = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-EventsQueue-1A2B"
+	mockDeadLetterQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-DeadLetterQueue-3C4D"
+	mockOrdersQueueURL     = "https://sqs.us-west-2.amazonaws.com/123456789012/app-test-worker-apiordersEventsQueue-5E6F"
+)
+
+var mockWorkerStackResources = []*stack.Resource{
+	{
+		Type:       "AWS::ECS::Service",
+		PhysicalID: "app-test-worker-Service-1234",
+		LogicalID:  "Service",
+	},
+	{
+		Type:       "AWS::SQS::Queue",
+		PhysicalID: mockOrdersQueueURL,
+		LogicalID:  "apiordersEventsQueue",
+	},
+	{
+		Type:       "AWS::SQS::Queue",
+		PhysicalID: mockDeadLetterQueueURL,
+		LogicalID:  "DeadLetterQueue",
+	},
+	{
+		Type:       "AWS::SQS::Queue",
+		PhysicalID: mockEventsQueueURL,
+		LogicalID:  "EventsQueue",
+	},
+}
+
+type queueDescriberMocks struct {
+	stack   *mocks.MockstackResourcesDescriber
+	sqs     *mocks.MockqueueAttributesGetter
+	metrics *mocks.MocklatestMetricGetter
+}
+
+func TestQueueDescriber_Queues(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m queueDescriberMocks)
+
+		wantedQueues []*WorkerQueue
+		wantedErr    error
+	}{
+		"errors if failed to get stack resources": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("retrieve service stack resources: some error"),
+		},
+		"returns the queues paired with their dead-letter queues": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(mockWorkerStackResources, nil)
+			},
+			wantedQueues: []*WorkerQueue{
+				{
+					Name:          "EventsQueue",
+					URL:           mockEventsQueueURL,
+					DeadLetterURL: mockDeadLetterQueueURL,
+				},
+				{
+					Name: "apiordersEventsQueue",
+					URL:  mockOrdersQueueURL,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := queueDescriberMocks{
+				stack: mocks.NewMockstackResourcesDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &QueueDescriber{
+				stack: m.stack,
+			}
+
+			// WHEN
+			queues, err := d.Queues()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueues, queues)
+		})
+	}
+}
+
+func TestQueueDescriber_Stats(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m queueDescriberMocks)
+
+		wantedStats *WorkerQueuesStats
+		wantedErr   error
+	}{
+		"errors if failed to get queue attributes": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(mockWorkerStackResources, nil)
+				m.sqs.EXPECT().QueueAttributes(mockEventsQueueURL).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stats of queue EventsQueue: some error"),
+		},
+		"errors if failed to get the age of the oldest message": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(mockWorkerStackResources, nil)
+				m.sqs.EXPECT().QueueAttributes(mockEventsQueueURL).Return(&sqs.QueueAttributes{}, nil)
+				m.metrics.EXPECT().LatestMaximum(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get age of oldest message in queue EventsQueue: some error"),
+		},
+		"errors if failed to get dead-letter queue attributes": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(mockWorkerStackResources, nil)
+				m.sqs.EXPECT().QueueAttributes(mockEventsQueueURL).Return(&sqs.QueueAttributes{}, nil)
+				m.metrics.EXPECT().LatestMaximum(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.sqs.EXPECT().QueueAttributes(mockDeadLetterQueueURL).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get stats of dead-letter queue for EventsQueue: some error"),
+		},
+		"returns the stats of every queue": {
+			setupMocks: func(m queueDescriberMocks) {
+				m.stack.EXPECT().ServiceStackResources().Return(mockWorkerStackResources, nil)
+				m.sqs.EXPECT().QueueAttributes(mockEventsQueueURL).Return(&sqs.QueueAttributes{
+					Visible:  12,
+					InFlight: 3,
+					Delayed:  1,
+				}, nil)
+				m.metrics.EXPECT().LatestMaximum("AWS/SQS", "ApproximateAgeOfOldestMessage", map[string]string{
+					"QueueName": "app-test-worker-EventsQueue-1A2B",
+				}).Return(aws.Float64(95), nil)
+				m.sqs.EXPECT().QueueAttributes(mockDeadLetterQueueURL).Return(&sqs.QueueAttributes{
+					Visible: 4,
+				}, nil)
+				m.sqs.EXPECT().QueueAttributes(mockOrdersQueueURL).Return(&sqs.QueueAttributes{}, nil)
+				m.metrics.EXPECT().LatestMaximum("AWS/SQS", "ApproximateAgeOfOldestMessage", map[string]string{
+					"QueueName": "app-test-worker-apiordersEventsQueue-5E6F",
+				}).Return(nil, nil)
+			},
+			wantedStats: &WorkerQueuesStats{
+				Queues: []QueueStats{
+					{
+						Name:                    "EventsQueue",
+						Messages:                12,
+						InFlight:                3,
+						Delayed:                 1,
+						OldestMessageAgeSeconds: aws.Float64(95),
+						DeadLetterMessages:      aws.Int64(4),
+					},
+					{
+						Name: "apiordersEventsQueue",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := queueDescriberMocks{
+				stack:   mocks.NewMockstackResourcesDescriber(ctrl),
+				sqs:     mocks.NewMockqueueAttributesGetter(ctrl),
+				metrics: mocks.NewMocklatestMetricGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &QueueDescriber{
+				stack:   m.stack,
+				sqs:     m.sqs,
+				metrics: m.metrics,
+			}
+
+			// WHEN
+			stats, err := d.Stats()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStats, stats)
+		})
+	}
+}
+
+func TestWorkerQueuesStats_String(t *testing.T) {
+	stats := &WorkerQueuesStats{
+		Queues: []QueueStats{
+			{
+				Name:                    "EventsQueue",
+				Messages:                12,
+				InFlight:                3,
+				OldestMessageAgeSeconds: aws.Float64(95),
+				DeadLetterMessages:      aws.Int64(0),
+			},
+			{
+				Name:     "apiordersEventsQueue",
+				Messages: 1,
+			},
+		},
+	}
+	wantedHuman := `  Queue                 Messages  In Flight  Delayed   Oldest Message  Dead-Letter Messages
+  -----                 --------  ---------  -------   --------------  --------------------
+  EventsQueue           12        3          0         1m35s           0
+  apiordersEventsQueue  1         0          0         -               -
+`
+	wantedJSON := `{"queues":[{"name":"EventsQueue","messages":12,"inFlight":3,"delayed":0,"oldestMessageAgeSeconds":95,"deadLetterMessages":0},{"name":"apiordersEventsQueue","messages":1,"inFlight":0,"delayed":0}]}
+`
+
+	human := stats.HumanString()
+	json, err := stats.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, wantedHuman, human)
+	require.Equal(t, wantedJSON, json)
+}
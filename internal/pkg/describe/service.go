@@ -11,6 +11,7 @@ import (
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
@@ -27,7 +28,10 @@ const (
 	waitConditionHandle  = "AWS::CloudFormation::WaitConditionHandle"
 )
 
-const apprunnerServiceType = "AWS::AppRunner::Service"
+const (
+	apprunnerServiceType = "AWS::AppRunner::Service"
+	nestedStackType      = "AWS::CloudFormation::Stack"
+)
 
 // ConfigStoreSvc wraps methods of config store.
 type ConfigStoreSvc interface {
@@ -79,8 +83,9 @@ type serviceStackDescriber struct {
 	service string
 	env     string
 
-	cfn  stackDescriber
-	sess *session.Session
+	cfn               stackDescriber
+	newStackDescriber func(stackName string) stackDescriber
+	sess              *session.Session
 }
 
 // ECSServiceDescriber retrieves information about a non-App Runner service.
@@ -149,6 +154,26 @@ func (d *serviceStackDescriber) Outputs() (map[string]string, error) {
 	return descr.Outputs, nil
 }
 
+// AddonsOutputs returns the outputs of the addons nested stack of the service.
+// If the service does not have any addons, returns an empty map.
+func (d *serviceStackDescriber) AddonsOutputs() (map[string]string, error) {
+	resources, err := d.cfn.Resources()
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		if resource.Type != nestedStackType || resource.LogicalID != addon.StackName {
+			continue
+		}
+		descr, err := d.newStackDescriber(resource.PhysicalID).Describe()
+		if err != nil {
+			return nil, err
+		}
+		return descr.Outputs, nil
+	}
+	return make(map[string]string), nil
+}
+
 // EnvVars returns the environment variables of the task definition.
 func (d *ECSServiceDescriber) EnvVars() ([]*awsecs.ContainerEnvVar, error) {
 	taskDefinition, err := d.ecsClient.TaskDefinition(d.app, d.env, d.service)
@@ -271,7 +296,10 @@ func newServiceStackDescriber(opt NewServiceConfig) (*serviceStackDescriber, err
 		service: opt.Svc,
 		env:     opt.Env,
 
-		cfn:  stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		cfn: stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		newStackDescriber: func(stackName string) stackDescriber {
+			return stack.NewStackDescriber(stackName, sess)
+		},
 		sess: sess,
 	}, nil
 }
@@ -301,6 +301,89 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 	}
 }
 
+func TestServiceDescriber_AddonsOutputs(t *testing.T) {
+	const addonsStackARN = "arn:aws:cloudformation:us-west-2:1234567890:stack/phonetool-test-jobs-AddonsStack-1ABCDEF/1234"
+	testCases := map[string]struct {
+		setupMocks func(svcStack, addonsStack *mocks.MockstackDescriber)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(svcStack, addonsStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns an empty map if the service has no addons": {
+			setupMocks: func(svcStack, addonsStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::EC2::SecurityGroup",
+						PhysicalID: "sg-0758ed6b233743530",
+					},
+				}, nil)
+			},
+			wantedOutputs: map[string]string{},
+		},
+		"returns the outputs of the addons stack": {
+			setupMocks: func(svcStack, addonsStack *mocks.MockstackDescriber) {
+				svcStack.EXPECT().Resources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: "arn:aws:cloudformation:us-west-2:1234567890:stack/phonetool-test-jobs-AddonsStack-1ABCDEF-Storage-2GHIJ/5678",
+						LogicalID:  "Storage",
+					},
+					{
+						Type:       "AWS::CloudFormation::Stack",
+						PhysicalID: addonsStackARN,
+						LogicalID:  "AddonsStack",
+					},
+				}, nil)
+				addonsStack.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						"dbClusterEndpoint": "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+					},
+				}, nil)
+			},
+			wantedOutputs: map[string]string{
+				"dbClusterEndpoint": "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svcStack := mocks.NewMockstackDescriber(ctrl)
+			addonsStack := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMocks(svcStack, addonsStack)
+
+			d := &serviceStackDescriber{
+				cfn: svcStack,
+				newStackDescriber: func(stackName string) stackDescriber {
+					require.Equal(t, addonsStackARN, stackName)
+					return addonsStack
+				},
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
+
 func TestServiceDescriber_Platform(t *testing.T) {
 	const (
 		testApp = "phonetool"
@@ -46,6 +46,7 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	Queues                   []QueueStats             `json:"queues,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -107,6 +108,13 @@ func (s *ecsServiceStatus) HumanString() string {
 	s.writeTaskSummary(writer)
 	writer.Flush()
 
+	if len(s.Queues) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nQueues\n\n"))
+		writer.Flush()
+		writeQueueStats(writer, s.Queues)
+		writer.Flush()
+	}
+
 	if len(s.StoppedTasks) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
 		writer.Flush()
@@ -16,7 +16,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
 const fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
@@ -61,6 +64,7 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	queueDescriber     *QueueDescriber // Only set for worker services.
 }
 
 type appRunnerStatusDescriber struct {
@@ -90,7 +94,11 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 	if err != nil {
 		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
-	return &ecsStatusDescriber{
+	wkld, err := opt.ConfigStore.GetWorkload(opt.App, opt.Svc)
+	if err != nil {
+		return nil, fmt.Errorf("get workload %s: %w", opt.Svc, err)
+	}
+	d := &ecsStatusDescriber{
 		app:                opt.App,
 		env:                opt.Env,
 		svc:                opt.Svc,
@@ -99,7 +107,16 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
-	}, nil
+	}
+	if wkld.Type == manifest.WorkerServiceType {
+		d.queueDescriber = newQueueDescriber(&serviceStackDescriber{
+			app:     opt.App,
+			service: opt.Svc,
+			env:     opt.Env,
+			cfn:     stack.NewStackDescriber(cfnstack.NameForService(opt.App, opt.Env, opt.Svc), sess),
+		}, sess)
+	}
+	return d, nil
 }
 
 // NewAppRunnerStatusDescriber instantiates a new appRunnerStatusDescriber struct.
@@ -185,12 +202,22 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
 
+	var queues []QueueStats
+	if s.queueDescriber != nil {
+		stats, err := s.queueDescriber.Stats()
+		if err != nil {
+			return nil, fmt.Errorf("get queue stats: %w", err)
+		}
+		queues = stats.Queues
+	}
+
 	return &ecsServiceStatus{
 		Service:                  service.ServiceStatus(),
 		DesiredRunningTasks:      taskStatus,
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		Queues:                   queues,
 	}, nil
 }
 
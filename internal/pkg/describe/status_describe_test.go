@@ -17,7 +17,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -529,6 +531,99 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestServiceStatus_DescribeWorkerQueues(t *testing.T) {
+	mockServiceDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Name:        "mockService",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m serviceStatusDescriberMocks, q queueDescriberMocks)
+
+		wantedError  error
+		wantedQueues []QueueStats
+	}{
+		"errors if failed to get queue stats": {
+			setupMocks: func(m serviceStatusDescriberMocks, q queueDescriberMocks) {
+				q.stack.EXPECT().ServiceStackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get queue stats: retrieve service stack resources: some error"),
+		},
+		"includes the queue stats of the worker service": {
+			setupMocks: func(m serviceStatusDescriberMocks, q queueDescriberMocks) {
+				q.stack.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+					{
+						Type:       "AWS::SQS::Queue",
+						PhysicalID: mockEventsQueueURL,
+						LogicalID:  "EventsQueue",
+					},
+				}, nil)
+				q.sqs.EXPECT().QueueAttributes(mockEventsQueueURL).Return(&sqs.QueueAttributes{Visible: 7}, nil)
+				q.metrics.EXPECT().LatestMaximum(gomock.Any(), gomock.Any(), gomock.Any()).Return(aws.Float64(30), nil)
+			},
+			wantedQueues: []QueueStats{
+				{
+					Name:                    "EventsQueue",
+					Messages:                7,
+					OldestMessageAgeSeconds: aws.Float64(30),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := serviceStatusDescriberMocks{
+				ecsServiceGetter:  mocks.NewMockecsServiceGetter(ctrl),
+				alarmStatusGetter: mocks.NewMockalarmStatusGetter(ctrl),
+				serviceDescriber:  mocks.NewMockserviceDescriber(ctrl),
+				aas:               mocks.NewMockautoscalingAlarmNamesGetter(ctrl),
+			}
+			q := queueDescriberMocks{
+				stack:   mocks.NewMockstackResourcesDescriber(ctrl),
+				sqs:     mocks.NewMockqueueAttributesGetter(ctrl),
+				metrics: mocks.NewMocklatestMetricGetter(ctrl),
+			}
+			m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil)
+			m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&awsecs.Service{
+				Deployments: []*ecsapi.Deployment{{}},
+			}, nil)
+			m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return(nil, nil)
+			m.aas.EXPECT().ECSServiceAlarmNames("mockCluster", "mockService").Return(nil, nil)
+			m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return(nil, nil).AnyTimes()
+			tc.setupMocks(m, q)
+
+			svcStatus := &ecsStatusDescriber{
+				svc:          "mockSvc",
+				env:          "mockEnv",
+				app:          "mockApp",
+				cwSvcGetter:  m.alarmStatusGetter,
+				ecsSvcGetter: m.ecsServiceGetter,
+				svcDescriber: m.serviceDescriber,
+				aasSvcGetter: m.aas,
+				queueDescriber: &QueueDescriber{
+					stack:   q.stack,
+					sqs:     q.sqs,
+					metrics: q.metrics,
+				},
+			}
+
+			// WHEN
+			statusDesc, err := svcStatus.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedQueues, statusDesc.(*ecsServiceStatus).Queues)
+		})
+	}
+}
+
 func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 	appName := "testapp"
 	envName := "test"
@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"fmt"
+	"strings"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	pipelineStageInProgress = "InProgress"
+	pipelineStageFailed     = "Failed"
+	pipelineStageSucceeded  = "Succeeded"
+
+	pipelineExecutionSucceeded  = "Succeeded"
+	pipelineExecutionSuperseded = "Superseded"
+	pipelineExecutionFailed     = "Failed"
+	pipelineExecutionStopped    = "Stopped"
+	pipelineExecutionCancelled  = "Cancelled"
+
+	appRunnerOperationInProgress = "OPERATION_IN_PROGRESS"
+	appRunnerFailedSuffix        = "_FAILED"
+)
+
+type humanJSONDescriber interface {
+	Describe() (HumanJSONStringer, error)
+}
+
+// watchableStatus is a status that can tell whether the resource reached a terminal state
+// and what changed since a previous status.
+type watchableStatus interface {
+	HumanJSONStringer
+
+	// settled returns true if the resource reached a terminal state.
+	// If the terminal state is a failure, then the reason is non-empty.
+	settled() (done bool, reason string)
+	// transitions returns the notable changes since the prev status.
+	transitions(prev watchableStatus) []string
+}
+
+// StatusPoller describes the status of a resource on each Poll and computes the transitions between polls.
+type StatusPoller struct {
+	describer humanJSONDescriber
+	prev      watchableStatus
+}
+
+// NewStatusPoller instantiates a new StatusPoller from a pipeline or service status describer.
+func NewStatusPoller(describer humanJSONDescriber) *StatusPoller {
+	return &StatusPoller{
+		describer: describer,
+	}
+}
+
+// Poll returns a snapshot of the latest status along with the transitions since the previous Poll.
+func (p *StatusPoller) Poll() (stream.StatusSnapshot, error) {
+	desc, err := p.describer.Describe()
+	if err != nil {
+		return stream.StatusSnapshot{}, err
+	}
+	status, ok := desc.(watchableStatus)
+	if !ok {
+		return stream.StatusSnapshot{}, fmt.Errorf("status of type %T cannot be watched", desc)
+	}
+	data, err := status.JSONString()
+	if err != nil {
+		return stream.StatusSnapshot{}, err
+	}
+	snapshot := stream.StatusSnapshot{
+		Human: status.HumanString(),
+		JSON:  data,
+	}
+	if p.prev != nil {
+		snapshot.Transitions = status.transitions(p.prev)
+	}
+	done, reason := status.settled()
+	snapshot.Settled = done
+	snapshot.Failed = reason != ""
+	snapshot.Reason = reason
+	p.prev = status
+	return snapshot, nil
+}
+
+// settled returns true if the latest execution of the pipeline reached a terminal status.
+// If the execution didn't succeed, then the reason lists the failed stages.
+func (p PipelineStatus) settled() (done bool, reason string) {
+	switch p.latestExecutionStatus {
+	case pipelineExecutionSucceeded, pipelineExecutionSuperseded:
+		return true, ""
+	case pipelineExecutionFailed, pipelineExecutionStopped, pipelineExecutionCancelled:
+	default:
+		return false, ""
+	}
+	var failed []string
+	for _, stage := range p.StageStates {
+		if stage.AggregateStatus() == pipelineStageFailed {
+			failed = append(failed, stage.StageName)
+		}
+	}
+	if len(failed) == 0 {
+		return true, fmt.Sprintf("execution %s", strings.ToLower(p.latestExecutionStatus))
+	}
+	return true, fmt.Sprintf("stage %s failed", strings.Join(failed, ", "))
+}
+
+// transitions returns the stages whose aggregate status changed since prev.
+func (p PipelineStatus) transitions(prev watchableStatus) []string {
+	old, ok := prev.(*PipelineStatus)
+	if !ok {
+		return nil
+	}
+	oldStatus := make(map[string]string)
+	for _, stage := range old.StageStates {
+		oldStatus[stage.StageName] = stage.AggregateStatus()
+	}
+	var out []string
+	for _, stage := range p.StageStates {
+		cur := stage.AggregateStatus()
+		if before, ok := oldStatus[stage.StageName]; ok && before == cur {
+			continue
+		}
+		out = append(out, fmt.Sprintf("Stage %s %s", stage.StageName, pipelineStageTransitionColor(cur)))
+	}
+	return out
+}
+
+// settled returns true if the service has a single deployment whose running count matches its desired count.
+// If the rollout of the primary deployment failed, then the reason is non-empty.
+func (s *ecsServiceStatus) settled() (done bool, reason string) {
+	for _, d := range s.Service.Deployments {
+		if d.Status == awsecs.ServiceDeploymentStatusPrimary && d.RolloutState == awsecs.ServiceDeploymentRolloutStateFailed {
+			return true, fmt.Sprintf("rollout of deployment %s failed", d.Id)
+		}
+	}
+	if len(s.Service.Deployments) != 1 {
+		return false, ""
+	}
+	return s.Service.RunningCount == s.Service.DesiredCount, ""
+}
+
+// transitions returns the tasks that stopped, the alarms whose state changed, and the rollout states that changed since prev.
+func (s *ecsServiceStatus) transitions(prev watchableStatus) []string {
+	old, ok := prev.(*ecsServiceStatus)
+	if !ok {
+		return nil
+	}
+	var out []string
+
+	oldRollouts := make(map[string]string)
+	for _, d := range old.Service.Deployments {
+		oldRollouts[d.Id] = d.RolloutState
+	}
+	for _, d := range s.Service.Deployments {
+		if before, ok := oldRollouts[d.Id]; !ok || before == d.RolloutState || d.RolloutState == "" {
+			continue
+		}
+		out = append(out, fmt.Sprintf("Deployment %s rollout %s", d.Id, strings.ToLower(d.RolloutState)))
+	}
+
+	stopped := make(map[string]bool)
+	for _, task := range old.StoppedTasks {
+		stopped[task.ID] = true
+	}
+	for _, task := range s.StoppedTasks {
+		if stopped[task.ID] {
+			continue
+		}
+		msg := fmt.Sprintf("Task %s %s", shortTaskID(task.ID), color.Red.Sprint("stopped"))
+		if task.StoppedReason != "" {
+			msg = fmt.Sprintf("%s: %s", msg, task.StoppedReason)
+		}
+		out = append(out, msg)
+	}
+
+	oldAlarms := make(map[string]string)
+	for _, alarm := range old.Alarms {
+		oldAlarms[alarm.Name] = alarm.Status
+	}
+	for _, alarm := range s.Alarms {
+		before, ok := oldAlarms[alarm.Name]
+		if !ok || before == alarm.Status {
+			continue
+		}
+		out = append(out, fmt.Sprintf("Alarm %s %s → %s", alarm.Name, alarmHealthColor(before), alarmHealthColor(alarm.Status)))
+	}
+	return out
+}
+
+// settled returns true if no operation is in progress for the service.
+// If the service ended in a failed state, then the reason is non-empty.
+func (a *appRunnerServiceStatus) settled() (done bool, reason string) {
+	switch {
+	case a.Service.Status == appRunnerOperationInProgress:
+		return false, ""
+	case strings.HasSuffix(a.Service.Status, appRunnerFailedSuffix):
+		return true, fmt.Sprintf("service is in status %s", a.Service.Status)
+	default:
+		return true, ""
+	}
+}
+
+// transitions returns the service status if it changed since prev.
+func (a *appRunnerServiceStatus) transitions(prev watchableStatus) []string {
+	old, ok := prev.(*appRunnerServiceStatus)
+	if !ok || old.Service.Status == a.Service.Status {
+		return nil
+	}
+	return []string{fmt.Sprintf("Service %s → %s", statusColor(old.Service.Status), statusColor(a.Service.Status))}
+}
+
+func pipelineStageTransitionColor(status string) string {
+	switch status {
+	case pipelineStageSucceeded:
+		return color.Green.Sprint("succeeded")
+	case pipelineStageFailed:
+		return color.Red.Sprint("failed")
+	case pipelineStageInProgress:
+		return color.Emphasize("started")
+	default:
+		return "reset"
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStatusDescriber struct {
+	statuses []HumanJSONStringer
+	err      error
+}
+
+func (d *fakeStatusDescriber) Describe() (HumanJSONStringer, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	status := d.statuses[0]
+	d.statuses = d.statuses[1:]
+	return status, nil
+}
+
+func pipelineStatusWithStages(execution string, statuses map[string]string) *PipelineStatus {
+	var stages []*codepipeline.StageState
+	for _, name := range []string{"Source", "Build", "DeployTo-test"} {
+		status, ok := statuses[name]
+		if !ok {
+			continue
+		}
+		stages = append(stages, &codepipeline.StageState{
+			StageName: name,
+			Actions: []codepipeline.StageAction{
+				{
+					Name:   "action",
+					Status: status,
+				},
+			},
+		})
+	}
+	return &PipelineStatus{
+		PipelineState: codepipeline.PipelineState{
+			PipelineName: "pipeline-dinder-badgoose-repo",
+			StageStates:  stages,
+		},
+		latestExecutionStatus: execution,
+	}
+}
+
+func TestStatusPoller_Poll(t *testing.T) {
+	t.Run("returns the error from the describer", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{err: errors.New("some error")})
+
+		// WHEN
+		_, err := poller.Poll()
+
+		// THEN
+		require.EqualError(t, err, "some error")
+	})
+	t.Run("reports pipeline stage transitions until all stages are done", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{
+			statuses: []HumanJSONStringer{
+				pipelineStatusWithStages("InProgress", map[string]string{
+					"Source": "Succeeded",
+					"Build":  "InProgress",
+				}),
+				pipelineStatusWithStages("Failed", map[string]string{
+					"Source": "Succeeded",
+					"Build":  "Failed",
+				}),
+			},
+		})
+
+		// WHEN
+		first, err := poller.Poll()
+		require.NoError(t, err)
+		second, err := poller.Poll()
+		require.NoError(t, err)
+
+		// THEN
+		require.False(t, first.Settled)
+		require.Nil(t, first.Transitions)
+		require.NotEmpty(t, first.Human)
+		require.NotEmpty(t, first.JSON)
+
+		require.True(t, second.Settled)
+		require.True(t, second.Failed)
+		require.Equal(t, "stage Build failed", second.Reason)
+		require.Equal(t, []string{"Stage Build failed"}, second.Transitions)
+	})
+	t.Run("waits for the latest pipeline execution to complete between stages", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{
+			statuses: []HumanJSONStringer{
+				pipelineStatusWithStages("InProgress", map[string]string{
+					"Source": "Succeeded",
+					"Build":  "Succeeded",
+				}),
+				pipelineStatusWithStages("Succeeded", map[string]string{
+					"Source":        "Succeeded",
+					"Build":         "Succeeded",
+					"DeployTo-test": "Succeeded",
+				}),
+			},
+		})
+
+		// WHEN
+		first, err := poller.Poll()
+		require.NoError(t, err)
+		second, err := poller.Poll()
+		require.NoError(t, err)
+
+		// THEN
+		require.False(t, first.Settled)
+		require.True(t, second.Settled)
+		require.False(t, second.Failed)
+		require.Equal(t, []string{"Stage DeployTo-test succeeded"}, second.Transitions)
+	})
+	t.Run("fails if the latest pipeline execution was stopped", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{
+			statuses: []HumanJSONStringer{
+				pipelineStatusWithStages("Stopped", map[string]string{
+					"Source": "Succeeded",
+					"Build":  "Succeeded",
+				}),
+			},
+		})
+
+		// WHEN
+		snapshot, err := poller.Poll()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, snapshot.Settled)
+		require.True(t, snapshot.Failed)
+		require.Equal(t, "execution stopped", snapshot.Reason)
+	})
+	t.Run("reports stopped tasks and alarm changes until the service is stable", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{
+			statuses: []HumanJSONStringer{
+				&ecsServiceStatus{
+					Service: awsecs.ServiceStatus{
+						DesiredCount: 2,
+						RunningCount: 1,
+						Deployments: []awsecs.Deployment{
+							{Id: "new", Status: "PRIMARY", RolloutState: "IN_PROGRESS"},
+							{Id: "old", Status: "ACTIVE", RolloutState: "COMPLETED"},
+						},
+					},
+					Alarms: []cloudwatch.AlarmStatus{
+						{Name: "mySupercalifragilisticexpialidociousAlarm", Status: "ALARM"},
+					},
+				},
+				&ecsServiceStatus{
+					Service: awsecs.ServiceStatus{
+						DesiredCount: 2,
+						RunningCount: 2,
+						Deployments: []awsecs.Deployment{
+							{Id: "new", Status: "PRIMARY", RolloutState: "COMPLETED"},
+						},
+					},
+					Alarms: []cloudwatch.AlarmStatus{
+						{Name: "mySupercalifragilisticexpialidociousAlarm", Status: "OK"},
+					},
+					StoppedTasks: []awsecs.TaskStatus{
+						{ID: "1234567890abcdef", StoppedReason: "Scaling activity initiated by deployment"},
+					},
+				},
+			},
+		})
+
+		// WHEN
+		first, err := poller.Poll()
+		require.NoError(t, err)
+		second, err := poller.Poll()
+		require.NoError(t, err)
+
+		// THEN
+		require.False(t, first.Settled)
+		require.True(t, second.Settled)
+		require.False(t, second.Failed)
+		require.Equal(t, []string{
+			"Deployment new rollout completed",
+			"Task 12345678 stopped: Scaling activity initiated by deployment",
+			"Alarm mySupercalifragilisticexpialidociousAlarm ALARM → OK",
+		}, second.Transitions)
+	})
+	t.Run("fails if the primary deployment rollout failed", func(t *testing.T) {
+		// GIVEN
+		poller := NewStatusPoller(&fakeStatusDescriber{
+			statuses: []HumanJSONStringer{
+				&ecsServiceStatus{
+					Service: awsecs.ServiceStatus{
+						Deployments: []awsecs.Deployment{
+							{Id: "new", Status: "PRIMARY", RolloutState: "FAILED"},
+							{Id: "old", Status: "ACTIVE", RolloutState: "COMPLETED"},
+						},
+					},
+				},
+			},
+		})
+
+		// WHEN
+		snapshot, err := poller.Poll()
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, snapshot.Settled)
+		require.True(t, snapshot.Failed)
+		require.Equal(t, "rollout of deployment new failed", snapshot.Reason)
+	})
+}
@@ -84,6 +84,18 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 			uri.DNSNames = value[d.svc]
 		}
 	}
+	d.cnameRecords = nil
+	if !isHTTPS && svcParams[stack.LBWebServiceHTTPSParamKey] == "true" {
+		// The aliases are served with certificates imported in the environment, and live in DNS zones that Copilot doesn't manage.
+		uri.HTTPS = true
+		for _, alias := range uri.DNSNames {
+			d.cnameRecords = append(d.cnameRecords, &CNAMERecord{
+				Environment: envName,
+				Name:        alias,
+				Value:       envOutputs[envOutputPublicLoadBalancerDNSName],
+			})
+		}
+	}
 	d.svcParams = svcParams
 	return uri.String(), nil
 }
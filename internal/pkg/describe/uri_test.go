@@ -27,8 +27,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
-		wantedURI   string
-		wantedError error
+		wantedURI          string
+		wantedCNAMERecords []*CNAMERecord
+		wantedError        error
 	}{
 		"fail to get parameters of environment stack": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -109,6 +110,31 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 
 			wantedURI: "https://example.com or https://v1.example.com",
 		},
+		"with alias served by imported certificates": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{
+						stack.EnvParamAliasesKey: `{"jobs": ["jobs.example.com"]}`,
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.ecsDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+						stack.LBWebServiceHTTPSParamKey:    "true",
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://jobs.example.com",
+			wantedCNAMERecords: []*CNAMERecord{
+				{
+					Environment: "test",
+					Name:        "jobs.example.com",
+					Value:       testEnvLBDNSName,
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -148,6 +174,7 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedURI, actual)
+				require.Equal(t, tc.wantedCNAMERecords, d.cnameRecords)
 			}
 		})
 	}
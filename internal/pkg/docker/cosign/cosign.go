@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cosign provides functionality to sign container images and verify their signatures with the cosign CLI.
+package cosign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	osexec "os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	cosignBin       = "cosign"
+	kmsKeyRefPrefix = "awskms:///"
+)
+
+// ErrCosignCommandNotFound means the cosign command is not found.
+var ErrCosignCommandNotFound = errors.New("cosign: command not found")
+
+// Cmd is the interface implemented by external commands.
+type Cmd interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+// CmdClient signs and verifies images via the cosign CLI.
+// Signatures are stored next to the image in its repository, in the format that `cosign verify` expects.
+type CmdClient struct {
+	runner   Cmd
+	lookPath func(file string) (string, error) // Override in unit tests.
+}
+
+// New returns a CmdClient that runs cosign commands with cmd.
+func New(cmd Cmd) CmdClient {
+	return CmdClient{
+		runner:   cmd,
+		lookPath: osexec.LookPath,
+	}
+}
+
+// Sign signs the image, which must be referenced by digest, with the key.
+// The key is either the ARN of an asymmetric KMS key or any key reference supported by cosign.
+// Signatures aren't uploaded to a public transparency log so that image names stay private.
+func (c CmdClient) Sign(image, key string) error {
+	if _, err := c.lookPath(cosignBin); err != nil {
+		return ErrCosignCommandNotFound
+	}
+	args := []string{"sign", "--key", KeyRef(key), "--tlog-upload=false", "--yes", image}
+	if err := c.runner.Run(cosignBin, args); err != nil {
+		return fmt.Errorf("sign image %s: %w", image, err)
+	}
+	return nil
+}
+
+// Verify verifies the signature of the image against the key.
+// The key is either the ARN of an asymmetric KMS key, or the path to a public key to verify the image without
+// calling AWS, or any key reference supported by cosign. Since Sign doesn't upload signatures to the transparency log,
+// the log isn't checked either, so verifying with a public key doesn't need network access besides the registry.
+// On success, it returns the digest of the verified image so that callers can deploy exactly that image.
+func (c CmdClient) Verify(image, key string) (string, error) {
+	if _, err := c.lookPath(cosignBin); err != nil {
+		return "", ErrCosignCommandNotFound
+	}
+	args := []string{"verify", "--key", KeyRef(key), "--insecure-ignore-tlog=true", image}
+	stdout := new(strings.Builder)
+	if err := c.runner.Run(cosignBin, args, exec.Stdout(stdout)); err != nil {
+		return "", fmt.Errorf("verify signature of image %s: %w", image, err)
+	}
+	digest, err := verifiedDigest(stdout.String())
+	if err != nil {
+		return "", fmt.Errorf("parse the verified signatures of image %s: %w", image, err)
+	}
+	return digest, nil
+}
+
+// CopySignature copies the signatures of the image, which must be referenced by digest, to the repository dstRepo
+// so that they apply to the copy of the image in that repository. Existing signatures in dstRepo are overwritten.
+func (c CmdClient) CopySignature(image, dstRepo string) error {
+	if _, err := c.lookPath(cosignBin); err != nil {
+		return ErrCosignCommandNotFound
+	}
+	args := []string{"copy", "--sig-only", "--force", image, dstRepo}
+	if err := c.runner.Run(cosignBin, args); err != nil {
+		return fmt.Errorf("copy signatures of image %s to %s: %w", image, dstRepo, err)
+	}
+	return nil
+}
+
+// signaturePayload is the simple signing payload printed by `cosign verify` for each verified signature.
+type signaturePayload struct {
+	Critical struct {
+		Image struct {
+			Digest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifiedDigest returns the image digest that the verified signatures in the output of `cosign verify` attest to.
+func verifiedDigest(out string) (string, error) {
+	var payloads []signaturePayload
+	if err := json.Unmarshal([]byte(out), &payloads); err != nil {
+		return "", err
+	}
+	if len(payloads) == 0 {
+		return "", errors.New("no verified signature")
+	}
+	digest := payloads[0].Critical.Image.Digest
+	for _, payload := range payloads {
+		if payload.Critical.Image.Digest != digest {
+			return "", fmt.Errorf("signatures attest to different digests %s and %s", digest, payload.Critical.Image.Digest)
+		}
+	}
+	if digest == "" {
+		return "", errors.New("no image digest in the signature")
+	}
+	return digest, nil
+}
+
+// KeyRef returns the cosign key reference of the key. ARNs of KMS keys are converted to cosign KMS URIs,
+// any other key is returned as is.
+func KeyRef(key string) string {
+	if strings.HasPrefix(key, "arn:") {
+		return kmsKeyRefPrefix + key
+	}
+	return key
+}
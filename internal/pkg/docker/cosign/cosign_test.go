@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign
+
+import (
+	"errors"
+	"fmt"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockImage  = "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api@sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	mockKMSKey = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+)
+
+func foundCosign(string) (string, error) {
+	return "/usr/local/bin/cosign", nil
+}
+
+func TestCmdClient_Sign(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		lookPath   func(string) (string, error)
+		setupMocks func(m *MockCmd)
+
+		wantedError error
+	}{
+		"error if cosign isn't installed": {
+			lookPath: func(string) (string, error) {
+				return "", errors.New("not found")
+			},
+			setupMocks:  func(m *MockCmd) {},
+			wantedError: ErrCosignCommandNotFound,
+		},
+		"wrap error returned from Run()": {
+			lookPath: foundCosign,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("sign image %s: %w", mockImage, mockError),
+		},
+		"sign with the KMS key": {
+			lookPath: foundCosign,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", []string{"sign", "--key", "awskms:///" + mockKMSKey, "--tlog-upload=false", "--yes", mockImage}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			c := CmdClient{
+				runner:   mockCmd,
+				lookPath: tc.lookPath,
+			}
+
+			err := c.Sign(mockImage, mockKMSKey)
+
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestCmdClient_CopySignature(t *testing.T) {
+	mockError := errors.New("mockError")
+	mockDstRepo := "123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app/api"
+
+	tests := map[string]struct {
+		lookPath   func(string) (string, error)
+		setupMocks func(m *MockCmd)
+
+		wantedError error
+	}{
+		"error if cosign isn't installed": {
+			lookPath: func(string) (string, error) {
+				return "", errors.New("not found")
+			},
+			setupMocks:  func(m *MockCmd) {},
+			wantedError: ErrCosignCommandNotFound,
+		},
+		"wrap error returned from Run()": {
+			lookPath: foundCosign,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("copy signatures of image %s to %s: %w", mockImage, mockDstRepo, mockError),
+		},
+		"copy only the signatures": {
+			lookPath: foundCosign,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", []string{"copy", "--sig-only", "--force", mockImage, mockDstRepo}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			c := CmdClient{
+				runner:   mockCmd,
+				lookPath: tc.lookPath,
+			}
+
+			err := c.CopySignature(mockImage, mockDstRepo)
+
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestCmdClient_Verify(t *testing.T) {
+	mockError := errors.New("mockError")
+	mockDigest := "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	writeStdout := func(out string) func(string, []string, ...exec.CmdOption) error {
+		return func(_ string, _ []string, opts ...exec.CmdOption) error {
+			cmd := &osexec.Cmd{}
+			for _, opt := range opts {
+				opt(cmd)
+			}
+			_, err := cmd.Stdout.Write([]byte(out))
+			return err
+		}
+	}
+
+	tests := map[string]struct {
+		key        string
+		setupMocks func(m *MockCmd)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"wrap error returned from Run()": {
+			key: mockKMSKey,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("verify signature of image %s: %w", mockImage, mockError),
+		},
+		"error if no signature attests to a digest": {
+			key: mockKMSKey,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", gomock.Any(), gomock.Any()).DoAndReturn(writeStdout(`[]`))
+			},
+			wantedError: fmt.Errorf("parse the verified signatures of image %s: %w", mockImage, errors.New("no verified signature")),
+		},
+		"error if signatures attest to different digests": {
+			key: mockKMSKey,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", gomock.Any(), gomock.Any()).DoAndReturn(writeStdout(
+					`[{"critical":{"image":{"docker-manifest-digest":"sha256:a"}}},{"critical":{"image":{"docker-manifest-digest":"sha256:b"}}}]`))
+			},
+			wantedError: fmt.Errorf("parse the verified signatures of image %s: %w", mockImage, errors.New("signatures attest to different digests sha256:a and sha256:b")),
+		},
+		"verify with the KMS key": {
+			key: mockKMSKey,
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", []string{"verify", "--key", "awskms:///" + mockKMSKey, "--insecure-ignore-tlog=true", mockImage}, gomock.Any()).
+					DoAndReturn(writeStdout(fmt.Sprintf(`[{"critical":{"identity":{"docker-reference":"repo"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}]`, mockDigest)))
+			},
+			wantedDigest: mockDigest,
+		},
+		"verify with a local public key without checking the transparency log": {
+			key: "/ws/cosign.pub",
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("cosign", []string{"verify", "--key", "/ws/cosign.pub", "--insecure-ignore-tlog=true", mockImage}, gomock.Any()).
+					DoAndReturn(writeStdout(fmt.Sprintf(`[{"critical":{"image":{"docker-manifest-digest":"%s"}}}]`, mockDigest)))
+			},
+			wantedDigest: mockDigest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			c := CmdClient{
+				runner:   mockCmd,
+				lookPath: foundCosign,
+			}
+
+			digest, err := c.Verify(mockImage, tc.key)
+
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
@@ -57,13 +57,14 @@ func New(cmd Cmd) CmdClient {
 
 // BuildArguments holds the arguments that can be passed while building a container.
 type BuildArguments struct {
-	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
+	URI        string            // Required. Location of ECR Repo, optionally with a tag that replaces "latest". Used to generate image name in conjunction with tag.
 	Tags       []string          // Optional. List of tags to apply to the image besides "latest".
 	Dockerfile string            // Required. Dockerfile to pass to `docker build` via --file flag.
 	Context    string            // Optional. Build context directory to pass to `docker build`.
 	Target     string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
 	Platform   string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms  []string          // Optional. OS/Arch pairs of a multi-architecture image to pass to `docker buildx build`.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 }
 
@@ -74,15 +75,61 @@ type dockerConfig struct {
 
 // Build will run a `docker build` command for the given ecr repo URI and build arguments.
 func (c CmdClient) Build(in *BuildArguments) error {
+	args := append([]string{"build"}, buildFlags(in, in.Platform)...)
+	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
+	if in.Platform != "" {
+		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
+	}
+	if err := c.runner.Run("docker", args); err != nil {
+		return fmt.Errorf("building image: %w", err)
+	}
+
+	return nil
+}
+
+// BuildxPush will run a `docker buildx build --push` command to build a multi-architecture image for the platforms
+// of the build arguments, and push its manifest list to the ecr repo URI. It returns the digest of the manifest list on success.
+func (c CmdClient) BuildxPush(in *BuildArguments) (digest string, err error) {
+	dir, err := ioutil.TempDir("", "copilot-buildx")
+	if err != nil {
+		return "", fmt.Errorf("create temporary directory for the build metadata: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	metadataFile := filepath.Join(dir, "metadata.json")
+
+	args := append([]string{"buildx", "build", "--push", "--metadata-file", metadataFile}, buildFlags(in, strings.Join(in.Platforms, ","))...)
+	log.Infof("Building your multi-architecture container image: docker %s\n", strings.Join(args, " "))
+	if err := c.runner.Run("docker", args); err != nil {
+		return "", fmt.Errorf("building multi-architecture image (a builder that supports multiple platforms may be missing, run `docker buildx create --use` to create one): %w", err)
+	}
+
+	// The metadata file records the digest of the manifest list that was pushed by this build,
+	// even if the tag was overwritten by another build since.
+	data, err := ioutil.ReadFile(metadataFile)
+	if err != nil {
+		return "", fmt.Errorf("read build metadata of %s: %w", in.URI, err)
+	}
+	var metadata struct {
+		Digest string `json:"containerimage.digest"`
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return "", fmt.Errorf("unmarshal build metadata of %s: %w", in.URI, err)
+	}
+	if metadata.Digest == "" {
+		return "", fmt.Errorf("parse the digest of the manifest list of %s from the build metadata", in.URI)
+	}
+	return metadata.Digest, nil
+}
+
+// buildFlags returns the flags and positional arguments shared by `docker build` and `docker buildx build`.
+func buildFlags(in *BuildArguments, platform string) []string {
 	dfDir := in.Context
 	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
 		dfDir = filepath.Dir(in.Dockerfile)
 	}
 
-	args := []string{"build"}
-
 	// Add additional image tags to the docker build call.
-	args = append(args, "-t", in.URI)
+	args := []string{"-t", in.URI}
 	for _, tag := range in.Tags {
 		args = append(args, "-t", imageName(in.URI, tag))
 	}
@@ -98,8 +145,8 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	}
 
 	// Add platform option.
-	if in.Platform != "" {
-		args = append(args, "--platform", in.Platform)
+	if platform != "" {
+		args = append(args, "--platform", platform)
 	}
 
 	// Add the "args:" override section from manifest to the docker build call.
@@ -114,16 +161,7 @@ func (c CmdClient) Build(in *BuildArguments) error {
 		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
 	}
 
-	args = append(args, dfDir, "-f", in.Dockerfile)
-	// If host platform is not linux/amd64, show the user how the container image is being built; if the build fails (if their docker server doesn't have multi-platform-- and therefore `--platform` capability, for instance) they may see why.
-	if in.Platform != "" {
-		log.Infof("Building your container image: docker %s\n", strings.Join(args, " "))
-	}
-	if err := c.runner.Run("docker", args); err != nil {
-		return fmt.Errorf("building image: %w", err)
-	}
-
-	return nil
+	return append(args, dfDir, "-f", in.Dockerfile)
 }
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
@@ -163,6 +201,19 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 	return parts[1], nil
 }
 
+// CopyImage will run a `docker buildx imagetools create` command to copy the image src, including every platform
+// of a multi-architecture image, to the images dsts without pulling it. The copies keep the digest of src.
+func (c CmdClient) CopyImage(src string, dsts ...string) error {
+	args := []string{"buildx", "imagetools", "create"}
+	for _, dst := range dsts {
+		args = append(args, "--tag", dst)
+	}
+	if err := c.runner.Run("docker", append(args, src)); err != nil {
+		return fmt.Errorf("copy image %s: %w", src, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c CmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -219,7 +270,18 @@ func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
 	}
-	return fmt.Sprintf("%s:%s", uri, tag)
+	repo, _ := SplitTag(uri)
+	return fmt.Sprintf("%s:%s", repo, tag)
+}
+
+// SplitTag splits an image URI into the repository URI and the tag of the image.
+// If the URI doesn't have a tag, the returned tag is "latest".
+func SplitTag(uri string) (repo, tag string) {
+	lastSlash := strings.LastIndex(uri, "/")
+	if colon := strings.LastIndex(uri, ":"); colon > lastSlash {
+		return uri[:colon], uri[colon+1:]
+	}
+	return uri, "latest"
 }
 
 // IsEcrCredentialHelperEnabled return true if ecr-login is enabled either globally or registry level
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	osexec "os/exec"
 	"path/filepath"
 	"testing"
@@ -214,6 +215,85 @@ func TestDockerCommand_Login(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_BuildxPush(t *testing.T) {
+	mockURI := "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"
+	mockArgs := &BuildArguments{
+		URI:        mockURI,
+		Tags:       []string{"g123bfc"},
+		Dockerfile: "mockPath/to/mockDockerfile",
+		Platforms:  []string{"linux/amd64", "linux/arm64"},
+		Args: map[string]string{
+			"GOPROXY": "direct",
+		},
+	}
+	wantedBuildArgs := []string{
+		"-t", mockURI,
+		"-t", mockURI + ":g123bfc",
+		"--platform", "linux/amd64,linux/arm64",
+		"--build-arg", "GOPROXY=direct",
+		"mockPath/to", "-f", "mockPath/to/mockDockerfile"}
+	// writeMetadata returns a mock that checks the build arguments and writes the build metadata file.
+	writeMetadata := func(t *testing.T, metadata string) func(string, []string, ...exec.CmdOption) {
+		return func(_ string, args []string, _ ...exec.CmdOption) {
+			require.Equal(t, []string{"buildx", "build", "--push", "--metadata-file"}, args[:4])
+			require.Equal(t, wantedBuildArgs, args[5:])
+			require.NoError(t, ioutil.WriteFile(args[4], []byte(metadata), 0644))
+		}
+	}
+
+	t.Run("builds and pushes a manifest list and returns its digest", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", gomock.Any()).
+			Do(writeMetadata(t, `{"containerimage.descriptor":{"mediaType":"application/vnd.docker.distribution.manifest.list.v2+json","size":743},"containerimage.digest":"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"}`)).
+			Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		digest, err := cmd.BuildxPush(mockArgs)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	})
+	t.Run("returns a wrapped error on failed build", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		_, err := cmd.BuildxPush(mockArgs)
+
+		// THEN
+		require.EqualError(t, err, "building multi-architecture image (a builder that supports multiple platforms may be missing, run `docker buildx create --use` to create one): some error")
+	})
+	t.Run("returns an error if the build metadata has no digest", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", gomock.Any()).Do(writeMetadata(t, "{}")).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		_, err := cmd.BuildxPush(mockArgs)
+
+		// THEN
+		require.EqualError(t, err, "parse the digest of the manifest list of "+mockURI+" from the build metadata")
+	})
+}
+
 func TestDockerCommand_Push(t *testing.T) {
 	t.Run("pushes an image with multiple tags and returns its digest", func(t *testing.T) {
 		// GIVEN
@@ -295,6 +375,112 @@ func TestDockerCommand_Push(t *testing.T) {
 		// THEN
 		require.EqualError(t, err, "parse the digest from the repo digest ''")
 	})
+	t.Run("replaces the tag of a tagged uri with the additional tags", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:logshipper-latest"}).Return(nil)
+		m.EXPECT().Run("docker", []string{"push", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:logshipper-g123bfc"}).Return(nil)
+		m.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:logshipper-latest"}, gomock.Any()).
+			Do(func(_ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("\"aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\"\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := CmdClient{
+			runner: m,
+		}
+		digest, err := cmd.Push("aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app:logshipper-latest", "logshipper-g123bfc")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	})
+}
+
+func TestDockerCommand_CopyImage(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	mockSrc := "123456789012.dkr.ecr.us-west-2.amazonaws.com/my-app/api@sha256:1234"
+	mockDsts := []string{
+		"123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app/api:latest",
+		"123456789012.dkr.ecr.us-east-1.amazonaws.com/my-app/api:v1",
+	}
+
+	var mockCmd *MockCmd
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+
+				mockCmd.EXPECT().Run("docker", []string{"buildx", "imagetools", "create", "--tag", mockDsts[0], "--tag", mockDsts[1], mockSrc}).Return(mockError)
+			},
+			want: fmt.Errorf("copy image %s: %w", mockSrc, mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+
+				mockCmd.EXPECT().Run("docker", []string{"buildx", "imagetools", "create", "--tag", mockDsts[0], "--tag", mockDsts[1], mockSrc}).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			got := s.CopyImage(mockSrc, mockDsts...)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestSplitTag(t *testing.T) {
+	testCases := map[string]struct {
+		uri string
+
+		wantedRepo string
+		wantedTag  string
+	}{
+		"defaults to latest without a tag": {
+			uri:        "aws_account_id.dkr.ecr.region.amazonaws.com/my-app/my-web-app",
+			wantedRepo: "aws_account_id.dkr.ecr.region.amazonaws.com/my-app/my-web-app",
+			wantedTag:  "latest",
+		},
+		"splits the tag": {
+			uri:        "aws_account_id.dkr.ecr.region.amazonaws.com/my-app/my-web-app:logshipper-latest",
+			wantedRepo: "aws_account_id.dkr.ecr.region.amazonaws.com/my-app/my-web-app",
+			wantedTag:  "logshipper-latest",
+		},
+		"ignores the port of the registry": {
+			uri:        "localhost:5000/my-web-app",
+			wantedRepo: "localhost:5000/my-web-app",
+			wantedTag:  "latest",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, tag := SplitTag(tc.uri)
+
+			require.Equal(t, tc.wantedRepo, repo)
+			require.Equal(t, tc.wantedTag, tag)
+		})
+	}
 }
 
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
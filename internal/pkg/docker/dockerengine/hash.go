@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/moby/buildkit/frontend/dockerfile/dockerignore"
+)
+
+const dockerignoreFileName = ".dockerignore"
+
+// ContentHash returns a deterministic hash of the inputs of an image build: the Dockerfile, the target stage,
+// the platforms, the build args, and every file of the build context that is not excluded by its .dockerignore file.
+// The image URI, tags and cache sources are left out since they don't change the content of the image.
+func ContentHash(in *BuildArguments) (string, error) {
+	h := sha256.New()
+
+	dockerfile, err := ioutil.ReadFile(in.Dockerfile)
+	if err != nil {
+		return "", fmt.Errorf("read Dockerfile %s: %w", in.Dockerfile, err)
+	}
+	fmt.Fprintf(h, "dockerfile %d\n", len(dockerfile))
+	h.Write(dockerfile)
+	fmt.Fprintf(h, "target %s\n", in.Target)
+	fmt.Fprintf(h, "platform %s\n", in.Platform)
+	fmt.Fprintf(h, "platforms %s\n", strings.Join(in.Platforms, ","))
+	var keys []string
+	for k := range in.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "arg %q=%q\n", k, in.Args[k])
+	}
+
+	context := in.Context
+	if context == "" { // Context wasn't specified use the Dockerfile's directory as context.
+		context = filepath.Dir(in.Dockerfile)
+	}
+	if err := hashContext(h, context); err != nil {
+		return "", fmt.Errorf("hash build context %s: %w", context, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashContext writes the path, mode and content of every file sent to the docker daemon from the context directory.
+func hashContext(w io.Writer, context string) error {
+	ignore, err := readDockerignore(context)
+	if err != nil {
+		return err
+	}
+	// filepath.Walk visits files in lexical order, so the hash doesn't depend on the order of the directory entries.
+	return filepath.Walk(context, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(context, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if ignore != nil {
+			// Match the files the same way as docker does when it sends the context to the daemon.
+			excluded, err := ignore.Matches(rel)
+			if err != nil {
+				return err
+			}
+			if excluded {
+				if info.IsDir() && !ignore.Exclusions() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		fmt.Fprintf(w, "file %q %s\n", filepath.ToSlash(rel), info.Mode())
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "link %q\n", target)
+		case info.Mode().IsRegular():
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			fmt.Fprintf(w, "size %d\n", info.Size())
+			if _, err := io.Copy(w, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// readDockerignore parses the .dockerignore file at the root of the context directory.
+// It returns nil if the context doesn't have one.
+func readDockerignore(context string) (*fileutils.PatternMatcher, error) {
+	f, err := os.Open(filepath.Join(context, dockerignoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open %s: %w", dockerignoreFileName, err)
+	}
+	defer f.Close()
+
+	patterns, err := dockerignore.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", dockerignoreFileName, err)
+	}
+	matcher, err := fileutils.NewPatternMatcher(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("parse patterns of %s: %w", dockerignoreFileName, err)
+	}
+	return matcher, nil
+}
@@ -0,0 +1,224 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerengine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentHash(t *testing.T) {
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+		}
+	}
+	baseFiles := map[string]string{
+		"Dockerfile":    "FROM nginx\nCOPY . /app\n",
+		".dockerignore": "# Ignore local artifacts.\nnode_modules\n**/*.log\n!keep.log\n",
+		"main.go":       "package main",
+		"pkg/lib.go":    "package pkg",
+	}
+
+	testCases := map[string]struct {
+		inChange func(t *testing.T, dir string, in *BuildArguments)
+
+		wantSameHash bool
+	}{
+		"same hash for identical inputs": {
+			inChange:     func(t *testing.T, dir string, in *BuildArguments) {},
+			wantSameHash: true,
+		},
+		"same hash if the uri, tags or cache sources change": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				in.URI = "other-uri"
+				in.Tags = []string{"v2"}
+				in.CacheFrom = []string{"other-uri:latest"}
+			},
+			wantSameHash: true,
+		},
+		"same hash if an ignored file changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					"node_modules/dep/index.js": "module.exports = {}",
+					"pkg/debug.log":             "debug",
+				})
+			},
+			wantSameHash: true,
+		},
+		"different hash if a re-included file changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					"keep.log": "keep",
+				})
+			},
+		},
+		"different hash if a source file changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					"pkg/lib.go": "package pkg // changed",
+				})
+			},
+		},
+		"different hash if a file is added": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					"pkg/new.go": "package pkg",
+				})
+			},
+		},
+		"different hash if the Dockerfile changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					"Dockerfile": "FROM nginx:alpine\nCOPY . /app\n",
+				})
+			},
+		},
+		"different hash if the .dockerignore changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				writeFiles(t, dir, map[string]string{
+					".dockerignore": "node_modules\n",
+				})
+			},
+		},
+		"different hash if a build arg changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				in.Args["GO_VERSION"] = "1.17"
+			},
+		},
+		"different hash if the target changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				in.Target = "prod"
+			},
+		},
+		"different hash if the platform changes": {
+			inChange: func(t *testing.T, dir string, in *BuildArguments) {
+				in.Platform = "linux/arm64"
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dir := t.TempDir()
+			writeFiles(t, dir, baseFiles)
+			newArgs := func() *BuildArguments {
+				return &BuildArguments{
+					URI:        "mockURI",
+					Tags:       []string{"v1"},
+					Dockerfile: filepath.Join(dir, "Dockerfile"),
+					Context:    dir,
+					Args: map[string]string{
+						"GO_VERSION": "1.16",
+						"ENV":        "test",
+					},
+				}
+			}
+			wantHash, err := ContentHash(newArgs())
+			require.NoError(t, err)
+
+			in := newArgs()
+			tc.inChange(t, dir, in)
+
+			// WHEN
+			gotHash, err := ContentHash(in)
+
+			// THEN
+			require.NoError(t, err)
+			if tc.wantSameHash {
+				require.Equal(t, wantHash, gotHash)
+			} else {
+				require.NotEqual(t, wantHash, gotHash)
+			}
+		})
+	}
+}
+
+func TestContentHash_MissingDockerfile(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := ContentHash(&BuildArguments{
+		Dockerfile: filepath.Join(dir, "Dockerfile"),
+	})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "read Dockerfile")
+}
+
+func TestReadDockerignore(t *testing.T) {
+	testCases := map[string]struct {
+		patterns []string
+		path     string
+
+		wanted bool
+	}{
+		"not excluded without patterns": {
+			path:   "main.go",
+			wanted: false,
+		},
+		"excluded by exact match": {
+			patterns: []string{"secrets.env"},
+			path:     "secrets.env",
+			wanted:   true,
+		},
+		"excluded by a parent directory": {
+			patterns: []string{"node_modules"},
+			path:     "node_modules/dep/index.js",
+			wanted:   true,
+		},
+		"star does not match separators": {
+			patterns: []string{"*.md"},
+			path:     "docs/README.md",
+			wanted:   false,
+		},
+		"double star matches any directory": {
+			patterns: []string{"**/*.md"},
+			path:     "docs/guides/README.md",
+			wanted:   true,
+		},
+		"re-included by a later exception": {
+			patterns: []string{"*.md", "!README.md"},
+			path:     "README.md",
+			wanted:   false,
+		},
+		"last matching pattern wins": {
+			patterns: []string{"!README.md", "*.md"},
+			path:     "README.md",
+			wanted:   true,
+		},
+		"leading slash is ignored": {
+			patterns: []string{"/build"},
+			path:     "build/out",
+			wanted:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dir := t.TempDir()
+			content := ""
+			for _, p := range tc.patterns {
+				content += p + "\n"
+			}
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, dockerignoreFileName), []byte(content), 0644))
+			ignore, err := readDockerignore(dir)
+			require.NoError(t, err)
+
+			// WHEN
+			got, err := ignore.Matches(tc.path)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/spf13/afero"
 )
@@ -41,8 +42,19 @@ const (
 
 	cmdInstructionPrefix = "CMD "
 	cmdShell             = "CMD-SHELL"
+
+	platformFlag = "--platform="
+	stageAlias   = "as"
 )
 
+// predefinedArgs are the build args that docker sets without an ARG instruction.
+var predefinedArgs = map[string]bool{
+	"HTTP_PROXY": true, "http_proxy": true, "HTTPS_PROXY": true, "https_proxy": true,
+	"FTP_PROXY": true, "ftp_proxy": true, "NO_PROXY": true, "no_proxy": true, "ALL_PROXY": true, "all_proxy": true,
+	"TARGETPLATFORM": true, "TARGETOS": true, "TARGETARCH": true, "TARGETVARIANT": true,
+	"BUILDPLATFORM": true, "BUILDOS": true, "BUILDARCH": true, "BUILDVARIANT": true,
+}
+
 // Port represents an exposed port in a Dockerfile.
 type Port struct {
 	Port      uint16
@@ -65,10 +77,35 @@ type HealthCheck struct {
 	Cmd         []string
 }
 
+// Stage represents a build stage of a Dockerfile, which starts with a FROM instruction.
+type Stage struct {
+	Name     string // Name of the stage set with "FROM <image> AS <name>", empty if the stage is unnamed.
+	Image    string // Base image of the stage.
+	Platform string // Platform set with the --platform flag, empty if unset.
+}
+
+// BuildArg represents a build arg declared with an ARG instruction.
+type BuildArg struct {
+	Name    string
+	Default *string // Nil if the ARG doesn't declare a default value.
+}
+
+// runtimeConfig holds the instructions of a stage that apply when running the image.
+type runtimeConfig struct {
+	user       string
+	env        map[string]string
+	entrypoint []string
+	cmd        []string
+	cmdInStage bool // True if CMD is set in the stage instead of inherited from a previous stage.
+}
+
 // Dockerfile represents a parsed Dockerfile.
 type Dockerfile struct {
 	exposedPorts []Port
 	healthCheck  *HealthCheck
+	stages       []Stage
+	args         []BuildArg
+	final        runtimeConfig // Runtime config of the final stage.
 	parsed       bool
 	path         string
 
@@ -121,6 +158,64 @@ func (df *Dockerfile) GetHealthCheck() (*HealthCheck, error) {
 	return df.healthCheck, nil
 }
 
+// GetStages returns the build stages of the Dockerfile in order. The last stage is the one that docker builds by default.
+func (df *Dockerfile) GetStages() ([]Stage, error) {
+	if err := df.parse(); err != nil {
+		return nil, err
+	}
+	return df.stages, nil
+}
+
+// GetBuildArgs returns the build args declared by the Dockerfile, excluding the args predefined by docker.
+func (df *Dockerfile) GetBuildArgs() ([]BuildArg, error) {
+	if err := df.parse(); err != nil {
+		return nil, err
+	}
+	return df.args, nil
+}
+
+// GetEnvVars returns the environment variables set with ENV instructions in the final stage
+// and the stages it's built from.
+func (df *Dockerfile) GetEnvVars() (map[string]string, error) {
+	if err := df.parse(); err != nil {
+		return nil, err
+	}
+	return df.final.env, nil
+}
+
+// GetUser returns the user of the final stage set with a USER instruction, or an empty string if there is none,
+// in which case the user of the base image applies.
+func (df *Dockerfile) GetUser() (string, error) {
+	if err := df.parse(); err != nil {
+		return "", err
+	}
+	return df.final.user, nil
+}
+
+// GetEntrypoint returns the ENTRYPOINT of the final stage in exec form, or nil if there is none.
+// The shell form "ENTRYPOINT cmd" is returned as ["/bin/sh", "-c", "cmd"], which is how docker runs it.
+func (df *Dockerfile) GetEntrypoint() ([]string, error) {
+	if err := df.parse(); err != nil {
+		return nil, err
+	}
+	return df.final.entrypoint, nil
+}
+
+// GetCommand returns the CMD of the final stage in exec form, or nil if there is none.
+func (df *Dockerfile) GetCommand() ([]string, error) {
+	if err := df.parse(); err != nil {
+		return nil, err
+	}
+	return df.final.cmd, nil
+}
+
+// IsRootUser returns true if user is the root user. An empty user is the default user of the base image, which is
+// usually root.
+func IsRootUser(user string) bool {
+	name := strings.SplitN(user, ":", 2)[0]
+	return name == "" || name == "root" || name == "0"
+}
+
 // parse takes a Dockerfile and fills in struct members based on methods like parseExpose and parseHealthcheck.
 func (df *Dockerfile) parse() error {
 	if df.parsed {
@@ -146,6 +241,9 @@ func (df *Dockerfile) parse() error {
 
 	df.exposedPorts = parsedDockerfile.exposedPorts
 	df.healthCheck = parsedDockerfile.healthCheck
+	df.stages = parsedDockerfile.stages
+	df.args = parsedDockerfile.args
+	df.final = parsedDockerfile.final
 	df.parsed = true
 	return nil
 }
@@ -155,6 +253,8 @@ func parse(name, content string) (*Dockerfile, error) {
 	var df Dockerfile
 	df.exposedPorts = []Port{}
 
+	var stageConfigs []runtimeConfig // Runtime config of each stage so that stages built from a previous stage inherit it.
+	argIndex := make(map[string]int)
 	lexer := lex(strings.NewReader(content))
 	for {
 		instr := lexer.next()
@@ -162,7 +262,41 @@ func parse(name, content string) (*Dockerfile, error) {
 		case instrErr:
 			return nil, fmt.Errorf("scan Dockerfile %s: %s", name, instr.args)
 		case instrEOF:
+			if len(stageConfigs) > 0 {
+				df.final = stageConfigs[len(stageConfigs)-1]
+			}
 			return &df, nil
+		case instrFrom:
+			stage := parseFrom(instr.args)
+			config := runtimeConfig{
+				env: make(map[string]string),
+			}
+			for i, prev := range df.stages {
+				if prev.Name != "" && strings.EqualFold(prev.Name, stage.Image) {
+					config = stageConfigs[i].inherit()
+				}
+			}
+			df.stages = append(df.stages, stage)
+			stageConfigs = append(stageConfigs, config)
+		case instrArg:
+			for _, arg := range parseArg(instr.args) {
+				if predefinedArgs[arg.Name] {
+					continue
+				}
+				if i, ok := argIndex[arg.Name]; ok {
+					if df.args[i].Default == nil {
+						df.args[i].Default = arg.Default
+					}
+					continue
+				}
+				argIndex[arg.Name] = len(df.args)
+				df.args = append(df.args, arg)
+			}
+		case instrEnv, instrUser, instrEntrypoint, instrCmd:
+			if len(stageConfigs) == 0 {
+				continue // Not a valid Dockerfile, these instructions must follow a FROM instruction.
+			}
+			stageConfigs[len(stageConfigs)-1].apply(instr)
 		case instrExpose:
 			currentPorts := parseExpose(instr.args)
 			df.exposedPorts = append(df.exposedPorts, currentPorts...)
@@ -176,6 +310,143 @@ func parse(name, content string) (*Dockerfile, error) {
 	}
 }
 
+// inherit returns the runtime config of a stage built from the stage with this config.
+func (c runtimeConfig) inherit() runtimeConfig {
+	env := make(map[string]string, len(c.env))
+	for k, v := range c.env {
+		env[k] = v
+	}
+	return runtimeConfig{
+		user:       c.user,
+		env:        env,
+		entrypoint: c.entrypoint,
+		cmd:        c.cmd,
+	}
+}
+
+// apply updates the runtime config with an ENV, USER, ENTRYPOINT or CMD instruction.
+func (c *runtimeConfig) apply(instr instruction) {
+	switch instr.name {
+	case instrEnv:
+		for k, v := range parseEnv(instr.args) {
+			c.env[k] = v
+		}
+	case instrUser:
+		c.user = strings.TrimSpace(instr.args)
+	case instrEntrypoint:
+		c.entrypoint = parseExecOrShell(instr.args)
+		if !c.cmdInStage {
+			c.cmd = nil // Setting ENTRYPOINT resets the CMD inherited from the base image.
+		}
+	case instrCmd:
+		c.cmd = parseExecOrShell(instr.args)
+		c.cmdInStage = true
+	}
+}
+
+// parseFrom parses the arguments of "FROM [--platform=<platform>] <image> [AS <name>]".
+func parseFrom(args string) Stage {
+	var stage Stage
+	fields := strings.Fields(args)
+	for i := 0; i < len(fields); i++ {
+		switch {
+		case strings.HasPrefix(strings.ToLower(fields[i]), platformFlag):
+			stage.Platform = fields[i][len(platformFlag):]
+		case strings.EqualFold(fields[i], stageAlias) && i+1 < len(fields):
+			stage.Name = fields[i+1]
+			i++
+		case stage.Image == "":
+			stage.Image = fields[i]
+		}
+	}
+	return stage
+}
+
+// parseArg parses the arguments of "ARG <name>[=<default value>] ...".
+func parseArg(args string) []BuildArg {
+	var buildArgs []BuildArg
+	for _, word := range splitWords(args) {
+		parts := strings.SplitN(word, "=", 2)
+		arg := BuildArg{
+			Name: parts[0],
+		}
+		if len(parts) == 2 {
+			arg.Default = &parts[1]
+		}
+		buildArgs = append(buildArgs, arg)
+	}
+	return buildArgs
+}
+
+// parseEnv parses the arguments of "ENV <key>=<value> ..." or of the legacy form "ENV <key> <value>".
+func parseEnv(args string) map[string]string {
+	env := make(map[string]string)
+	words := splitWords(args)
+	if len(words) == 0 {
+		return env
+	}
+	if !strings.Contains(words[0], "=") {
+		key := words[0]
+		value := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(args), key))
+		env[key] = strings.Join(splitWords(value), " ")
+		return env
+	}
+	for _, word := range words {
+		parts := strings.SplitN(word, "=", 2)
+		if len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+	return env
+}
+
+// parseExecOrShell parses the arguments of an ENTRYPOINT or CMD instruction. The exec form is a JSON array,
+// the shell form is run with "/bin/sh -c".
+func parseExecOrShell(args string) []string {
+	args = strings.TrimSpace(args)
+	var exec []string
+	if err := json.Unmarshal([]byte(args), &exec); err == nil {
+		return exec
+	}
+	return []string{"/bin/sh", "-c", args}
+}
+
+// splitWords splits s around whitespaces that aren't quoted, and removes the quotes and escape characters.
+func splitWords(s string) []string {
+	var words []string
+	var word strings.Builder
+	var quote rune
+	var inWord, escaped bool
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inWord = r, true
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words
+}
+
 func parseExpose(line string) []Port {
 	// group 0: whole match
 	// group 1: port
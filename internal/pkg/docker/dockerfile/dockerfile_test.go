@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/moby/buildkit/frontend/dockerfile/instructions"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 
@@ -287,3 +288,152 @@ func stringifyPorts(ports []Port) []string {
 	}
 	return arr
 }
+
+func TestDockerfile_GetStages(t *testing.T) {
+	testCases := map[string]struct {
+		dockerfile   string
+		wantedStages []Stage
+	}{
+		"single stage": {
+			dockerfile: `FROM nginx`,
+			wantedStages: []Stage{
+				{Image: "nginx"},
+			},
+		},
+		"multi-stage build with platforms": {
+			dockerfile: `
+FROM --platform=$BUILDPLATFORM golang:1.17 AS build
+RUN go build -o /bin/app \
+    from ./cmd/app
+FROM build as test
+FROM --platform=linux/arm64 gcr.io/distroless/static AS Release
+`,
+			wantedStages: []Stage{
+				{Name: "build", Image: "golang:1.17", Platform: "$BUILDPLATFORM"},
+				{Name: "test", Image: "build"},
+				{Name: "Release", Image: "gcr.io/distroless/static", Platform: "linux/arm64"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("./Dockerfile", []byte(tc.dockerfile), 0644))
+
+			stages, err := New(fs, "./Dockerfile").GetStages()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStages, stages)
+		})
+	}
+}
+
+func TestDockerfile_GetBuildArgs(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	require.NoError(t, fs.WriteFile("./Dockerfile", []byte(`
+ARG GO_VERSION=1.17
+FROM golang:${GO_VERSION} AS build
+ARG TARGETARCH
+ARG VERSION
+ARG GO_VERSION
+ARG LDFLAGS="-s -w" CGO_ENABLED=0
+FROM scratch
+ARG VERSION=latest
+`), 0644))
+
+	args, err := New(fs, "./Dockerfile").GetBuildArgs()
+
+	require.NoError(t, err)
+	require.Equal(t, []BuildArg{
+		{Name: "GO_VERSION", Default: aws.String("1.17")},
+		{Name: "VERSION", Default: aws.String("latest")},
+		{Name: "LDFLAGS", Default: aws.String("-s -w")},
+		{Name: "CGO_ENABLED", Default: aws.String("0")},
+	}, args)
+}
+
+func TestDockerfile_RuntimeConfig(t *testing.T) {
+	testCases := map[string]struct {
+		dockerfile string
+
+		wantedEnv        map[string]string
+		wantedUser       string
+		wantedEntrypoint []string
+		wantedCommand    []string
+	}{
+		"no runtime instructions": {
+			dockerfile: `FROM nginx`,
+			wantedEnv:  map[string]string{},
+		},
+		"final stage only": {
+			dockerfile: `
+FROM golang AS build
+ENV CGO_ENABLED=0
+USER builder
+CMD ["go", "test"]
+FROM alpine
+ENV PORT=8080 GREETING="hello world"
+ENV LOG_LEVEL info
+USER 1000:1000
+ENTRYPOINT ["/app/server"]
+CMD --port 8080
+`,
+			wantedEnv: map[string]string{
+				"PORT":      "8080",
+				"GREETING":  "hello world",
+				"LOG_LEVEL": "info",
+			},
+			wantedUser:       "1000:1000",
+			wantedEntrypoint: []string{"/app/server"},
+			wantedCommand:    []string{"/bin/sh", "-c", "--port 8080"},
+		},
+		"inherits from a previous stage": {
+			dockerfile: `
+FROM node:16 AS base
+ENV NODE_ENV=production
+USER node
+CMD ["npm", "start"]
+FROM base
+ENV PORT=3000
+ENTRYPOINT ["docker-entrypoint.sh"]
+`,
+			wantedEnv: map[string]string{
+				"NODE_ENV": "production",
+				"PORT":     "3000",
+			},
+			wantedUser:       "node",
+			wantedEntrypoint: []string{"docker-entrypoint.sh"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("./Dockerfile", []byte(tc.dockerfile), 0644))
+			df := New(fs, "./Dockerfile")
+
+			env, err := df.GetEnvVars()
+			require.NoError(t, err)
+			user, err := df.GetUser()
+			require.NoError(t, err)
+			entrypoint, err := df.GetEntrypoint()
+			require.NoError(t, err)
+			command, err := df.GetCommand()
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantedEnv, env)
+			require.Equal(t, tc.wantedUser, user)
+			require.Equal(t, tc.wantedEntrypoint, entrypoint)
+			require.Equal(t, tc.wantedCommand, command)
+		})
+	}
+}
+
+func TestIsRootUser(t *testing.T) {
+	require.True(t, IsRootUser(""))
+	require.True(t, IsRootUser("root"))
+	require.True(t, IsRootUser("0:0"))
+	require.False(t, IsRootUser("node"))
+	require.False(t, IsRootUser("1000:root"))
+}
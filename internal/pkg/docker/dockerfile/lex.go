@@ -18,12 +18,24 @@ const (
 	instrErr         instructionName = iota // an error occurred while scanning.
 	instrHealthCheck                        // a HEALTHCHECK instruction.
 	instrExpose                             // an EXPOSE instruction.
+	instrFrom                               // a FROM instruction.
+	instrArg                                // an ARG instruction.
+	instrEnv                                // an ENV instruction.
+	instrUser                               // a USER instruction.
+	instrEntrypoint                         // an ENTRYPOINT instruction.
+	instrCmd                                // a CMD instruction.
 	instrEOF                                // done scanning.
 )
 
 const (
 	markerExposeInstr      = "expose "      // start of an EXPOSE instruction.
 	markerHealthCheckInstr = "healthcheck " // start of a HEALTHCHECK instruction.
+	markerFromInstr        = "from "        // start of a FROM instruction.
+	markerArgInstr         = "arg "         // start of an ARG instruction.
+	markerEnvInstr         = "env "         // start of an ENV instruction.
+	markerUserInstr        = "user "        // start of a USER instruction.
+	markerEntrypointInstr  = "entrypoint "  // start of an ENTRYPOINT instruction.
+	markerCmdInstr         = "cmd "         // start of a CMD instruction.
 )
 
 var (
@@ -32,6 +44,12 @@ var (
 	instrMarkers = map[instructionName]string{ // lookup table for how an instruction starts.
 		instrExpose:      markerExposeInstr,
 		instrHealthCheck: markerHealthCheckInstr,
+		instrFrom:        markerFromInstr,
+		instrArg:         markerArgInstr,
+		instrEnv:         markerEnvInstr,
+		instrUser:        markerUserInstr,
+		instrEntrypoint:  markerEntrypointInstr,
+		instrCmd:         markerCmdInstr,
 	}
 )
 
@@ -174,11 +192,43 @@ func lexContent(l *lexer) stateFn {
 		return lexExpose
 	case strings.HasPrefix(line, markerHealthCheckInstr):
 		return lexHealthCheck
+	case strings.HasPrefix(line, markerFromInstr):
+		return lexFrom
+	case strings.HasPrefix(line, markerArgInstr):
+		return lexArg
+	case strings.HasPrefix(line, markerEnvInstr):
+		return lexEnv
+	case strings.HasPrefix(line, markerUserInstr):
+		return lexUser
+	case strings.HasPrefix(line, markerEntrypointInstr):
+		return lexEntrypoint
+	case strings.HasPrefix(line, markerCmdInstr):
+		return lexCmd
+	case !strings.HasPrefix(line, "#") && strings.HasSuffix(line, "\\"):
+		return lexIgnoredContinuation // Ignore the other instructions that span multiple lines, such as a long RUN.
 	default:
 		return lexContent // Ignore all the other instructions, consume the line without emitting any instructions.
 	}
 }
 
+// lexIgnoredContinuation consumes the continuation lines of an ignored instruction, so that a continuation line
+// starting with a keyword like "user" or "from" isn't scanned as an instruction.
+func lexIgnoredContinuation(l *lexer) stateFn {
+	isEOF, err := l.readLine()
+	if err != nil {
+		l.emitErr(err)
+		return nil
+	}
+	if isEOF {
+		l.emit(instrEOF)
+		return nil
+	}
+	if strings.HasSuffix(l.curLine, "\\") {
+		return lexIgnoredContinuation
+	}
+	return lexContent
+}
+
 // lexExpose collects the arguments for an EXPOSE instruction and then emits it.
 func lexExpose(l *lexer) stateFn {
 	return lexInstruction(l, instrExpose)
@@ -189,6 +239,36 @@ func lexHealthCheck(l *lexer) stateFn {
 	return lexInstruction(l, instrHealthCheck)
 }
 
+// lexFrom collects the arguments for a FROM instruction and then emits it.
+func lexFrom(l *lexer) stateFn {
+	return lexInstruction(l, instrFrom)
+}
+
+// lexArg collects the arguments for an ARG instruction and then emits it.
+func lexArg(l *lexer) stateFn {
+	return lexInstruction(l, instrArg)
+}
+
+// lexEnv collects the arguments for an ENV instruction and then emits it.
+func lexEnv(l *lexer) stateFn {
+	return lexInstruction(l, instrEnv)
+}
+
+// lexUser collects the arguments for a USER instruction and then emits it.
+func lexUser(l *lexer) stateFn {
+	return lexInstruction(l, instrUser)
+}
+
+// lexEntrypoint collects the arguments for an ENTRYPOINT instruction and then emits it.
+func lexEntrypoint(l *lexer) stateFn {
+	return lexInstruction(l, instrEntrypoint)
+}
+
+// lexCmd collects the arguments for a CMD instruction and then emits it.
+func lexCmd(l *lexer) stateFn {
+	return lexInstruction(l, instrCmd)
+}
+
 // lexInstruction collects all the arguments for the named instruction and then emits it.
 func lexInstruction(l *lexer, name instructionName) stateFn {
 	args := trimContinuationLineMarker(trimInstruction(l.curLine, instrMarkers[name]))
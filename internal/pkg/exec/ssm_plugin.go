@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +60,40 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartSessionWithIO starts a session using the ssm plugin with the session's input read from stdin
+// and its output written to stdout instead of the terminal.
+func (s SSMPluginCommand) StartSessionWithIO(ssmSess *ecs.Session, stdin io.Reader, stdout io.Writer) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	if err := s.runner.Run(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction}, Stdin(stdin), Stdout(stdout)); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// StartPortForwardingSession starts a session created from a Session Manager document, like a port forwarding session,
+// using the ssm plugin. The session stays open until the user interrupts it.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	request, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	region := aws.StringValue(s.sess.Config.Region)
+	endpoint := s.sess.ClientConfig(ssm.EndpointsID).Endpoint
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), region, startSessionAction, "", string(request), endpoint}); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
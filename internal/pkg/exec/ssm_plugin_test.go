@@ -4,15 +4,18 @@
 package exec
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -31,6 +34,66 @@ func (c *fakeHTTPClient) Get(url string) (resp *http.Response, err error) {
 	return r.Result(), nil
 }
 
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockInput := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSession"),
+		Parameters: map[string][]*string{
+			"portNumber": aws.StringSlice([]string{"80"}),
+		},
+		Target: aws.String("ecs:cluster_task_runtime"),
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSession","Parameters":{"portNumber":["80"]},"Reason":null,"Target":"ecs:cluster_task_runtime"}`,
+		"https://ssm.us-west-2.amazonaws.com",
+	}
+	var mockRunner *Mockrunner
+	tests := map[string]struct {
+		setupMocks  func(controller *gomock.Controller)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = NewMockrunner(controller)
+				mockRunner.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = NewMockrunner(controller)
+				mockRunner.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tc.setupMocks(ctrl)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: session.Must(session.NewSession(&aws.Config{
+					Region: aws.String("us-west-2"),
+				})),
+			}
+			err := s.StartPortForwardingSession(mockSession, mockInput)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSSMPluginCommand_StartSession(t *testing.T) {
 	mockSession := &ecs.Session{
 		SessionId:  aws.String("mockSessionID"),
@@ -83,3 +146,50 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartSessionWithIO(t *testing.T) {
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockArgs := []string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(ssmPluginBinaryName, mockArgs, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(ssmPluginBinaryName, mockArgs, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartSessionWithIO(mockSession, strings.NewReader("input"), &bytes.Buffer{})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,11 @@
 // Package graph provides functionality for directed graphs.
 package graph
 
+import (
+	"fmt"
+	"sort"
+)
+
 // nodeStatus denotes the visiting status of a node when running DFS in a graph.
 type nodeStatus int
 
@@ -26,24 +31,33 @@ type Edge struct {
 
 type neighbors map[string]bool
 
-// New initiates a new Graph.
-func New() *Graph {
-	return &Graph{
+// New initiates a new Graph with the given nodes.
+func New(nodes ...string) *Graph {
+	g := &Graph{
 		nodes: make(map[string]neighbors),
 	}
+	for _, node := range nodes {
+		g.addNode(node)
+	}
+	return g
 }
 
 // Add adds a connection between two Nodes.
 func (g *Graph) Add(edge Edge) {
 	fromNode, toNode := edge.From, edge.To
-	// Add origin node if doesn't exist.
-	if _, ok := g.nodes[fromNode]; !ok {
-		g.nodes[fromNode] = make(neighbors)
-	}
+	// Add origin and destination nodes if they don't exist.
+	g.addNode(fromNode)
+	g.addNode(toNode)
 	// Add edge.
 	g.nodes[fromNode][toNode] = true
 }
 
+func (g *Graph) addNode(node string) {
+	if _, ok := g.nodes[node]; !ok {
+		g.nodes[node] = make(neighbors)
+	}
+}
+
 type findCycleTempVars struct {
 	status     map[string]nodeStatus
 	nodeParent map[string]string
@@ -95,3 +109,107 @@ func (g *Graph) hasCycles(temp *findCycleTempVars, currNode string) bool {
 	temp.status[currNode] = visited
 	return false
 }
+
+// ErrUpstreamFailed is returned by Traverse for a node that is skipped because a node it depends on failed.
+type ErrUpstreamFailed struct {
+	Upstream string // The node that failed.
+}
+
+func (e *ErrUpstreamFailed) Error() string {
+	return fmt.Sprintf("upstream %s failed", e.Upstream)
+}
+
+// Traverse calls fn once for every node of an acyclic graph, running at most maxConcurrency calls at the same time.
+// A maxConcurrency of 0 or less doesn't limit the number of concurrent calls.
+// A node is visited only after fn succeeded for every node that has an edge to it. If fn fails for a node,
+// the nodes reachable from it are skipped while the rest of the graph is still visited.
+// Traverse returns the errors of the nodes that failed or were skipped, keyed by node. The result is empty if every call succeeded.
+func (g *Graph) Traverse(maxConcurrency int, fn func(node string) error) map[string]error {
+	type result struct {
+		node string
+		err  error
+	}
+	inDegrees := make(map[string]int)
+	for _, node := range g.sortedNodes() {
+		if _, ok := inDegrees[node]; !ok {
+			inDegrees[node] = 0
+		}
+		for to := range g.nodes[node] {
+			inDegrees[to]++
+		}
+	}
+	var ready []string
+	for _, node := range g.sortedNodes() {
+		if inDegrees[node] == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	errs := make(map[string]error)
+	results := make(chan result)
+	remaining, running := len(inDegrees), 0
+	for remaining > 0 {
+		for len(ready) > 0 && (maxConcurrency <= 0 || running < maxConcurrency) {
+			node := ready[0]
+			ready = ready[1:]
+			running++
+			go func() {
+				results <- result{node: node, err: fn(node)}
+			}()
+		}
+		if running == 0 {
+			// Only reachable if the graph has a cycle: the nodes left are waiting on each other.
+			break
+		}
+		res := <-results
+		running--
+		remaining--
+		if res.err != nil {
+			errs[res.node] = res.err
+			remaining -= g.skipDownstream(res.node, res.node, errs)
+			continue
+		}
+		for _, to := range g.sortedNeighbors(res.node) {
+			if _, skipped := errs[to]; skipped {
+				continue
+			}
+			inDegrees[to]--
+			if inDegrees[to] == 0 {
+				ready = append(ready, to)
+			}
+		}
+	}
+	return errs
+}
+
+// skipDownstream records an ErrUpstreamFailed error for every node reachable from node that isn't skipped already,
+// and returns the number of newly skipped nodes.
+func (g *Graph) skipDownstream(node, failed string, errs map[string]error) int {
+	var skipped int
+	for _, to := range g.sortedNeighbors(node) {
+		if _, ok := errs[to]; ok {
+			continue
+		}
+		errs[to] = &ErrUpstreamFailed{Upstream: failed}
+		skipped += 1 + g.skipDownstream(to, failed, errs)
+	}
+	return skipped
+}
+
+func (g *Graph) sortedNodes() []string {
+	nodes := make([]string, 0, len(g.nodes))
+	for node := range g.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+func (g *Graph) sortedNeighbors(node string) []string {
+	var nodes []string
+	for to := range g.nodes[node] {
+		nodes = append(nodes, to)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
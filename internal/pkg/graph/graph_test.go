@@ -4,7 +4,10 @@
 package graph
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -91,3 +94,103 @@ func TestGraph_IsAcyclic(t *testing.T) {
 		})
 	}
 }
+
+func TestGraph_Traverse(t *testing.T) {
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		graph          *Graph
+		maxConcurrency int
+		failed         map[string]bool
+
+		wantedVisited []string
+		wantedErrs    map[string]error
+	}{
+		"visits every node of an empty graph": {
+			graph: New(),
+
+			wantedErrs: map[string]error{},
+		},
+		"visits nodes after the nodes they depend on": {
+			graph: func() *Graph {
+				g := New("D")
+				g.Add(Edge{From: "A", To: "B"})
+				g.Add(Edge{From: "B", To: "C"})
+				g.Add(Edge{From: "A", To: "C"})
+				return g
+			}(),
+			maxConcurrency: 1,
+
+			wantedVisited: []string{"A", "D", "B", "C"},
+			wantedErrs:    map[string]error{},
+		},
+		"skips the downstream nodes of a failed node": {
+			graph: func() *Graph {
+				g := New("E")
+				g.Add(Edge{From: "A", To: "B"})
+				g.Add(Edge{From: "B", To: "C"})
+				g.Add(Edge{From: "D", To: "C"})
+				return g
+			}(),
+			failed: map[string]bool{"A": true},
+
+			wantedVisited: []string{"A", "D", "E"},
+			wantedErrs: map[string]error{
+				"A": mockErr,
+				"B": &ErrUpstreamFailed{Upstream: "A"},
+				"C": &ErrUpstreamFailed{Upstream: "A"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var mu sync.Mutex
+			var visited []string
+
+			// WHEN
+			errs := tc.graph.Traverse(tc.maxConcurrency, func(node string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				visited = append(visited, node)
+				if tc.failed[node] {
+					return mockErr
+				}
+				return nil
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedErrs, errs)
+			if tc.maxConcurrency == 1 {
+				require.Equal(t, tc.wantedVisited, visited)
+			} else {
+				require.ElementsMatch(t, tc.wantedVisited, visited)
+			}
+		})
+	}
+}
+
+func TestGraph_Traverse_MaxConcurrency(t *testing.T) {
+	// GIVEN
+	g := New("A", "B", "C", "D", "E")
+	var mu sync.Mutex
+	var running, maxRunning int
+
+	// WHEN
+	errs := g.Traverse(2, func(node string) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+
+	// THEN
+	require.Empty(t, errs)
+	require.Equal(t, 2, maxRunning)
+}
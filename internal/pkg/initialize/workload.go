@@ -73,6 +73,10 @@ type WorkloadProps struct {
 	Image          string
 	Platform       manifest.PlatformArgsOrString
 	Topics         []manifest.TopicSubscription
+
+	// Settings detected in the Dockerfile.
+	BuildTarget       string
+	DockerfileRuntime manifest.DockerfileRuntime
 }
 
 // JobProps contains the information needed to represent a Job.
@@ -271,9 +275,11 @@ func newJobManifest(i *JobProps) (encoding.BinaryMarshaler, error) {
 	case manifest.ScheduledJobType:
 		return manifest.NewScheduledJob(&manifest.ScheduledJobProps{
 			WorkloadProps: &manifest.WorkloadProps{
-				Name:       i.Name,
-				Dockerfile: i.DockerfilePath,
-				Image:      i.Image,
+				Name:        i.Name,
+				Dockerfile:  i.DockerfilePath,
+				Image:       i.Image,
+				BuildTarget: i.BuildTarget,
+				Runtime:     i.DockerfileRuntime,
 			},
 			HealthCheck: i.HealthCheck,
 			Platform:    i.Platform,
@@ -311,9 +317,11 @@ func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(i *ServiceProps)
 	}
 	props := &manifest.LoadBalancedWebServiceProps{
 		WorkloadProps: &manifest.WorkloadProps{
-			Name:       i.Name,
-			Dockerfile: i.DockerfilePath,
-			Image:      i.Image,
+			Name:        i.Name,
+			Dockerfile:  i.DockerfilePath,
+			Image:       i.Image,
+			BuildTarget: i.BuildTarget,
+			Runtime:     i.DockerfileRuntime,
 		},
 		Path:        "/",
 		Port:        i.Port,
@@ -339,9 +347,11 @@ func (w *WorkloadInitializer) newLoadBalancedWebServiceManifest(i *ServiceProps)
 func (w *WorkloadInitializer) newRequestDrivenWebServiceManifest(i *ServiceProps) *manifest.RequestDrivenWebService {
 	props := &manifest.RequestDrivenWebServiceProps{
 		WorkloadProps: &manifest.WorkloadProps{
-			Name:       i.Name,
-			Dockerfile: i.DockerfilePath,
-			Image:      i.Image,
+			Name:        i.Name,
+			Dockerfile:  i.DockerfilePath,
+			Image:       i.Image,
+			BuildTarget: i.BuildTarget,
+			Runtime:     i.DockerfileRuntime,
 		},
 		Port:     i.Port,
 		Platform: i.Platform,
@@ -352,9 +362,11 @@ func (w *WorkloadInitializer) newRequestDrivenWebServiceManifest(i *ServiceProps
 func newBackendServiceManifest(i *ServiceProps) (*manifest.BackendService, error) {
 	return manifest.NewBackendService(manifest.BackendServiceProps{
 		WorkloadProps: manifest.WorkloadProps{
-			Name:       i.Name,
-			Dockerfile: i.DockerfilePath,
-			Image:      i.Image,
+			Name:        i.Name,
+			Dockerfile:  i.DockerfilePath,
+			Image:       i.Image,
+			BuildTarget: i.BuildTarget,
+			Runtime:     i.DockerfileRuntime,
 		},
 		Port:        i.Port,
 		HealthCheck: i.HealthCheck,
@@ -365,9 +377,11 @@ func newBackendServiceManifest(i *ServiceProps) (*manifest.BackendService, error
 func newWorkerServiceManifest(i *ServiceProps) (*manifest.WorkerService, error) {
 	return manifest.NewWorkerService(manifest.WorkerServiceProps{
 		WorkloadProps: manifest.WorkloadProps{
-			Name:       i.Name,
-			Dockerfile: i.DockerfilePath,
-			Image:      i.Image,
+			Name:        i.Name,
+			Dockerfile:  i.DockerfilePath,
+			Image:       i.Image,
+			BuildTarget: i.BuildTarget,
+			Runtime:     i.DockerfileRuntime,
 		},
 		HealthCheck: i.HealthCheck,
 		Platform:    i.Platform,
@@ -50,7 +50,8 @@ func NewBackendService(props BackendServiceProps) *BackendService {
 	// Apply overrides.
 	svc.Name = stringP(props.Name)
 	svc.BackendServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
-	svc.BackendServiceConfig.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	svc.BackendServiceConfig.ImageConfig.Image.Build.BuildArgs = props.dockerBuildArgs()
+	svc.dockerfileRuntime = props.Runtime
 	svc.BackendServiceConfig.ImageConfig.Port = uint16P(props.Port)
 	svc.BackendServiceConfig.ImageConfig.HealthCheck = props.HealthCheck
 	svc.BackendServiceConfig.Platform = props.Platform
@@ -90,6 +91,11 @@ func (s *BackendService) Publish() []Topic {
 	return s.BackendServiceConfig.PublishConfig.Topics
 }
 
+// UsesEnvEventBus returns true if the service publishes events to the event bus created by the environment.
+func (s *BackendService) UsesEnvEventBus() bool {
+	return s.BackendServiceConfig.PublishConfig.UsesEnvEventBus()
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *BackendService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
@@ -100,6 +106,27 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// SidecarBuildArgs returns the docker build arguments of the service's sidecars that are built from local Dockerfiles
+// keyed by sidecar name, given a workspace root directory.
+func (s *BackendService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
+// ImageRegistry returns the third-party registry that the service's image is pushed to.
+func (s *BackendService) ImageRegistry() ImageRegistry {
+	return s.ImageConfig.Image.Registry
+}
+
+// ImageScan returns the vulnerability scan configuration of the service's image.
+func (s *BackendService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ContainerImage returns the image of the service's main container.
+func (s *BackendService) ContainerImage() Image {
+	return s.ImageConfig.Image
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *BackendService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
@@ -214,6 +214,25 @@ func TestBackendSvc_MarshalBinary(t *testing.T) {
 			},
 			wantedTestdata: "backend-svc-customhealthcheck.yml",
 		},
+		"with settings detected in a multi-stage Dockerfile": {
+			inProps: BackendServiceProps{
+				WorkloadProps: WorkloadProps{
+					Name:        "subscribers",
+					Dockerfile:  "./subscribers/Dockerfile",
+					BuildTarget: "runtime",
+					Runtime: DockerfileRuntime{
+						Variables: map[string]string{
+							"NODE_ENV": "production",
+							"PORT":     "8080",
+						},
+						EntryPoint:     []string{"/usr/bin/tini", "--"},
+						Command:        []string{"node", "index.js"},
+						UnsetBuildArgs: []string{"GIT_SHA", "NPM_TOKEN"},
+					},
+				},
+			},
+			wantedTestdata: "backend-svc-dockerfile-settings.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// ecrPrivateImageRegexp matches images stored in an Amazon ECR private repository.
+var ecrPrivateImageRegexp = regexp.MustCompile(`^\d{12}\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(\.cn)?/`)
+
+// InternetEgress returns the reasons why the service's tasks need to reach the internet.
+// It returns nil if the tasks are not placed in private subnets.
+func (s *LoadBalancedWebService) InternetEgress() []string {
+	return internetEgress(s.Network, s.ImageConfig.Image, s.Logging, s.Sidecars, s.PublishConfig)
+}
+
+// InternetEgress returns the reasons why the service's tasks need to reach the internet.
+// It returns nil if the tasks are not placed in private subnets.
+func (s *BackendService) InternetEgress() []string {
+	return internetEgress(s.Network, s.ImageConfig.Image, s.Logging, s.Sidecars, s.PublishConfig)
+}
+
+// InternetEgress returns the reasons why the service's tasks need to reach the internet.
+// It returns nil if the tasks are not placed in private subnets.
+func (s *WorkerService) InternetEgress() []string {
+	reasons := internetEgress(s.Network, s.ImageConfig.Image, s.Logging, s.Sidecars, s.PublishConfig)
+	if !s.Network.isPrivate() {
+		return reasons
+	}
+	return append(reasons, "polling messages from the service's Amazon SQS queue")
+}
+
+// InternetEgress returns the reasons why the job's tasks need to reach the internet.
+// It returns nil if the tasks are not placed in private subnets.
+func (j *ScheduledJob) InternetEgress() []string {
+	return internetEgress(j.Network, j.ImageConfig.Image, j.Logging, j.Sidecars, j.PublishConfig)
+}
+
+func (c NetworkConfig) isPrivate() bool {
+	return c.VPC.Placement != nil && *c.VPC.Placement == PrivateSubnetPlacement
+}
+
+// internetEgress returns the dependencies of an Amazon ECS task in private subnets that can't be reached
+// only through the interface and gateway VPC endpoints of an environment.
+func internetEgress(network NetworkConfig, image Image, logging Logging, sidecars map[string]*SidecarConfig, publish PublishConfig) []string {
+	if !network.isPrivate() {
+		return nil
+	}
+	var reasons []string
+	if location := aws.StringValue(image.Location); location != "" && !ecrPrivateImageRegexp.MatchString(location) {
+		reasons = append(reasons, fmt.Sprintf(`pulling the image "%s"`, location))
+	}
+	var names []string
+	for name := range sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if sidecars[name] == nil {
+			continue
+		}
+		if location := aws.StringValue(sidecars[name].Image); location != "" && !ecrPrivateImageRegexp.MatchString(location) {
+			reasons = append(reasons, fmt.Sprintf(`pulling the image "%s" of sidecar %s`, location, name))
+		}
+	}
+	if !logging.IsEmpty() {
+		if location := aws.StringValue(logging.LogImage()); !ecrPrivateImageRegexp.MatchString(location) {
+			reasons = append(reasons, fmt.Sprintf(`pulling the FireLens image "%s"`, location))
+		}
+	}
+	if len(publish.Topics) > 0 {
+		reasons = append(reasons, "publishing to Amazon SNS topics")
+	}
+	if !publish.EventBus.Advanced.IsEmpty() || aws.BoolValue(publish.EventBus.Enabled) {
+		reasons = append(reasons, "publishing to an Amazon EventBridge event bus")
+	}
+	return reasons
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternetEgress(t *testing.T) {
+	private := PrivateSubnetPlacement
+	public := PublicSubnetPlacement
+	privateNetwork := NetworkConfig{
+		VPC: vpcConfig{
+			Placement: &private,
+		},
+	}
+	testCases := map[string]struct {
+		mft interface {
+			InternetEgress() []string
+		}
+
+		wanted []string
+	}{
+		"no egress needed in public subnets": {
+			mft: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: Image{
+								Location: aws.String("nginx"),
+							},
+						},
+					},
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: &public,
+						},
+					},
+				},
+			},
+		},
+		"no egress needed for images in Amazon ECR": {
+			mft: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Image: Image{
+								Location: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend:latest"),
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/envoy:v1"),
+						},
+					},
+					Network: privateNetwork,
+				},
+			},
+		},
+		"images outside of Amazon ECR, FireLens and publishers": {
+			mft: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: ImageWithHealthcheck{
+						Image: Image{
+							Location: aws.String("public.ecr.aws/acme/job:latest"),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"xray":   {Image: aws.String("amazon/aws-xray-daemon")},
+						"statsd": {Image: aws.String("datadog/agent")},
+					},
+					Logging: Logging{
+						Destination: map[string]string{"Name": "cloudwatch"},
+					},
+					PublishConfig: PublishConfig{
+						Topics: []Topic{{Name: aws.String("orders")}},
+						EventBus: EventBusOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+					Network: privateNetwork,
+				},
+			},
+			wanted: []string{
+				`pulling the image "public.ecr.aws/acme/job:latest"`,
+				`pulling the image "datadog/agent" of sidecar statsd`,
+				`pulling the image "amazon/aws-xray-daemon" of sidecar xray`,
+				`pulling the FireLens image "public.ecr.aws/aws-observability/aws-for-fluent-bit:latest"`,
+				"publishing to Amazon SNS topics",
+				"publishing to an Amazon EventBridge event bus",
+			},
+		},
+		"worker services poll their queue": {
+			mft: &WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					Network: privateNetwork,
+				},
+			},
+			wanted: []string{"polling messages from the service's Amazon SQS queue"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.mft.InternetEgress())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import "strings"
+
+// Effects of an IAM policy statement.
+const (
+	IAMEffectAllow = "Allow"
+	IAMEffectDeny  = "Deny"
+)
+
+// IAM holds the permissions granted to the IAM roles of a workload.
+type IAM struct {
+	TaskRole      IAMRole `yaml:"task_role"`
+	ExecutionRole IAMRole `yaml:"execution_role"`
+}
+
+// IAMRole holds the managed policies and inline policy statements attached to an IAM role.
+type IAMRole struct {
+	ManagedPolicies []string             `yaml:"managed_policies"`
+	Statements      []IAMPolicyStatement `yaml:"statements"`
+}
+
+// IAMPolicyStatement represents a statement of an IAM policy document.
+type IAMPolicyStatement struct {
+	Effect    *string  `yaml:"effect"` // Defaults to "Allow".
+	Actions   []string `yaml:"actions"`
+	Resources []string `yaml:"resources"`
+}
+
+// IsEmpty returns true if the struct has all zero members.
+func (i *IAM) IsEmpty() bool {
+	return i.TaskRole.IsEmpty() && i.ExecutionRole.IsEmpty()
+}
+
+// IsEmpty returns true if the struct has all zero members.
+func (r *IAMRole) IsEmpty() bool {
+	return len(r.ManagedPolicies) == 0 && len(r.Statements) == 0
+}
+
+// WildcardIAMActions returns the actions allowed by the inline policy statements of the workload
+// that match several actions, such as "*", "s3:*" or "s3:Get*".
+func (t *TaskConfig) WildcardIAMActions() []string {
+	var actions []string
+	for _, statement := range t.allowedIAMStatements() {
+		for _, action := range statement.Actions {
+			if strings.Contains(action, "*") {
+				actions = append(actions, action)
+			}
+		}
+	}
+	return actions
+}
+
+// HasWildcardIAMResources returns true if any inline policy statement of the workload allows actions on every resource.
+func (t *TaskConfig) HasWildcardIAMResources() bool {
+	for _, statement := range t.allowedIAMStatements() {
+		for _, resource := range statement.Resources {
+			if resource == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *TaskConfig) allowedIAMStatements() []IAMPolicyStatement {
+	var statements []IAMPolicyStatement
+	for _, role := range []IAMRole{t.IAM.TaskRole, t.IAM.ExecutionRole} {
+		for _, statement := range role.Statements {
+			if statement.Effect != nil && *statement.Effect == IAMEffectDeny {
+				continue
+			}
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
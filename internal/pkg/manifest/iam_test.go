@@ -0,0 +1,126 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskConfig_WildcardIAMActions(t *testing.T) {
+	testCases := map[string]struct {
+		in     IAM
+		wanted []string
+	}{
+		"no statements": {
+			in: IAM{
+				TaskRole: IAMRole{
+					ManagedPolicies: []string{"arn:aws:iam::aws:policy/AdministratorAccess"},
+				},
+			},
+		},
+		"allowed wildcard actions in both roles": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Actions:   []string{"s3:GetObject", "s3:Get*", "dynamodb:*"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+				ExecutionRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Effect:    aws.String("Allow"),
+							Actions:   []string{"*"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+			},
+			wanted: []string{"s3:Get*", "dynamodb:*", "*"},
+		},
+		"denied wildcard actions are ignored": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Effect:    aws.String("Deny"),
+							Actions:   []string{"s3:*"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := TaskConfig{IAM: tc.in}
+			require.Equal(t, tc.wanted, task.WildcardIAMActions())
+		})
+	}
+}
+
+func TestTaskConfig_HasWildcardIAMResources(t *testing.T) {
+	testCases := map[string]struct {
+		in     IAM
+		wanted bool
+	}{
+		"no statements": {
+			in: IAM{
+				TaskRole: IAMRole{
+					ManagedPolicies: []string{"arn:aws:iam::aws:policy/AdministratorAccess"},
+				},
+			},
+		},
+		"allowed actions on specific resources": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Actions:   []string{"s3:GetObject"},
+							Resources: []string{"arn:aws:s3:::my-bucket/*"},
+						},
+					},
+				},
+			},
+		},
+		"allowed actions on every resource": {
+			in: IAM{
+				ExecutionRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Actions:   []string{"ssm:GetParameters"},
+							Resources: []string{"arn:aws:ssm:us-west-2:123456789012:parameter/app", "*"},
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+		"denied actions on every resource are ignored": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Effect:    aws.String("Deny"),
+							Actions:   []string{"s3:DeleteBucket"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := TaskConfig{IAM: tc.in}
+			require.Equal(t, tc.wanted, task.HasWildcardIAMResources())
+		})
+	}
+}
@@ -74,7 +74,8 @@ func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
 	job := newDefaultScheduledJob()
 	// Apply overrides.
 	job.Name = stringP(props.Name)
-	job.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	job.ImageConfig.Image.Build.BuildArgs = props.dockerBuildArgs()
+	job.dockerfileRuntime = props.Runtime
 	job.ImageConfig.Image.Location = stringP(props.Image)
 	job.ImageConfig.HealthCheck = props.HealthCheck
 	job.Platform = props.Platform
@@ -94,7 +95,10 @@ func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
 // MarshalBinary serializes the manifest object into a binary YAML document.
 // Implements the encoding.BinaryMarshaler interface.
 func (j *ScheduledJob) MarshalBinary() ([]byte, error) {
-	content, err := j.parser.Parse(scheduledJobManifestPath, *j)
+	content, err := j.parser.Parse(scheduledJobManifestPath, *j, template.WithFuncs(map[string]interface{}{
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +130,11 @@ func (j *ScheduledJob) Publish() []Topic {
 	return j.ScheduledJobConfig.PublishConfig.Topics
 }
 
+// UsesEnvEventBus returns true if the job publishes events to the event bus created by the environment.
+func (j *ScheduledJob) UsesEnvEventBus() bool {
+	return j.ScheduledJobConfig.PublishConfig.UsesEnvEventBus()
+}
+
 // BuildArgs returns a docker.BuildArguments object for the job given a workspace root.
 func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.Image.BuildConfig(wsRoot)
@@ -136,6 +145,27 @@ func (j *ScheduledJob) BuildRequired() (bool, error) {
 	return requiresBuild(j.ImageConfig.Image)
 }
 
+// SidecarBuildArgs returns the docker build arguments of the job's sidecars that are built from local Dockerfiles
+// keyed by sidecar name, given a workspace root directory.
+func (j *ScheduledJob) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(j.Sidecars, wsRoot)
+}
+
+// ImageRegistry returns the third-party registry that the job's image is pushed to.
+func (j *ScheduledJob) ImageRegistry() ImageRegistry {
+	return j.ImageConfig.Image.Registry
+}
+
+// ImageScan returns the vulnerability scan configuration of the job's image.
+func (j *ScheduledJob) ImageScan() ImageScan {
+	return j.ImageConfig.Image.Scan
+}
+
+// ContainerImage returns the image of the job's main container.
+func (j *ScheduledJob) ContainerImage() Image {
+	return j.ImageConfig.Image
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (j *ScheduledJob) EnvFile() string {
 	return aws.StringValue(j.TaskConfig.EnvFile)
@@ -80,7 +80,8 @@ func NewLoadBalancedWebService(props *LoadBalancedWebServiceProps) *LoadBalanced
 	// Apply overrides.
 	svc.Name = stringP(props.Name)
 	svc.LoadBalancedWebServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
-	svc.LoadBalancedWebServiceConfig.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	svc.LoadBalancedWebServiceConfig.ImageConfig.Image.Build.BuildArgs = props.dockerBuildArgs()
+	svc.dockerfileRuntime = props.Runtime
 	svc.LoadBalancedWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
 	svc.LoadBalancedWebServiceConfig.ImageConfig.HealthCheck = props.HealthCheck
 	svc.LoadBalancedWebServiceConfig.Platform = props.Platform
@@ -134,7 +135,10 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 // MarshalBinary serializes the manifest object into a binary YAML document.
 // Implements the encoding.BinaryMarshaler interface.
 func (s *LoadBalancedWebService) MarshalBinary() ([]byte, error) {
-	content, err := s.parser.Parse(lbWebSvcManifestPath, *s)
+	content, err := s.parser.Parse(lbWebSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +156,11 @@ func (s *LoadBalancedWebService) Publish() []Topic {
 	return s.LoadBalancedWebServiceConfig.PublishConfig.Topics
 }
 
+// UsesEnvEventBus returns true if the service publishes events to the event bus created by the environment.
+func (s *LoadBalancedWebService) UsesEnvEventBus() bool {
+	return s.LoadBalancedWebServiceConfig.PublishConfig.UsesEnvEventBus()
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *LoadBalancedWebService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
@@ -162,6 +171,27 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// SidecarBuildArgs returns the docker build arguments of the service's sidecars that are built from local Dockerfiles
+// keyed by sidecar name, given a workspace root directory.
+func (s *LoadBalancedWebService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
+// ImageRegistry returns the third-party registry that the service's image is pushed to.
+func (s *LoadBalancedWebService) ImageRegistry() ImageRegistry {
+	return s.ImageConfig.Image.Registry
+}
+
+// ImageScan returns the vulnerability scan configuration of the service's image.
+func (s *LoadBalancedWebService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ContainerImage returns the image of the service's main container.
+func (s *LoadBalancedWebService) ContainerImage() Image {
+	return s.ImageConfig.Image
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *LoadBalancedWebService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
@@ -206,6 +236,7 @@ type RoutingRule struct {
 	HealthCheck         HealthCheckArgsOrString `yaml:"healthcheck"`
 	Stickiness          *bool                   `yaml:"stickiness"`
 	Alias               Alias                   `yaml:"alias"`
+	RedirectToHTTPS     *bool                   `yaml:"redirect_to_https"` // Defaults to true when the service is served over HTTPS.
 	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer          *string `yaml:"target_container"`
@@ -80,7 +80,8 @@ func NewRequestDrivenWebService(props *RequestDrivenWebServiceProps) *RequestDri
 	svc := newDefaultRequestDrivenWebService()
 	svc.Name = aws.String(props.Name)
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
-	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	svc.RequestDrivenWebServiceConfig.ImageConfig.Image.Build.BuildArgs = props.dockerBuildArgs()
+	svc.dockerfileRuntime = props.Runtime
 	svc.RequestDrivenWebServiceConfig.ImageConfig.Port = aws.Uint16(props.Port)
 	svc.RequestDrivenWebServiceConfig.InstanceConfig.Platform = props.Platform
 	svc.parser = template.New()
@@ -90,7 +91,10 @@ func NewRequestDrivenWebService(props *RequestDrivenWebServiceProps) *RequestDri
 // MarshalBinary serializes the manifest object into a binary YAML document.
 // Implements the encoding.BinaryMarshaler interface.
 func (s *RequestDrivenWebService) MarshalBinary() ([]byte, error) {
-	content, err := s.parser.Parse(requestDrivenWebSvcManifestPath, *s)
+	content, err := s.parser.Parse(requestDrivenWebSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -108,11 +112,26 @@ func (s *RequestDrivenWebService) Publish() []Topic {
 	return s.RequestDrivenWebServiceConfig.PublishConfig.Topics
 }
 
+// UsesEnvEventBus returns true if the service publishes events to the event bus created by the environment.
+func (s *RequestDrivenWebService) UsesEnvEventBus() bool {
+	return s.RequestDrivenWebServiceConfig.PublishConfig.UsesEnvEventBus()
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (s *RequestDrivenWebService) BuildRequired() (bool, error) {
 	return requiresBuild(s.ImageConfig.Image)
 }
 
+// ImageScan returns the vulnerability scan configuration of the service's image.
+func (s *RequestDrivenWebService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ContainerImage returns the image of the service's main container.
+func (s *RequestDrivenWebService) ContainerImage() Image {
+	return s.ImageConfig.Image
+}
+
 // ContainerPlatform returns the platform for the service.
 func (s *RequestDrivenWebService) ContainerPlatform() string {
 	if s.InstanceConfig.Platform.IsEmpty() {
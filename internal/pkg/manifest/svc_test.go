@@ -298,7 +298,13 @@ subscribe:
     - name: publisher2
       service: testpubjob
       queue:
-        timeout: 15s`,
+        timeout: 15s
+    - name: orders
+      service: testpubsvc
+      fifo: true
+      queue: true
+      filter_policy:
+        event: [order_placed]`,
 			requireCorrectValues: func(t *testing.T, i interface{}) {
 				actualManifest, ok := i.(*WorkerService)
 				duration15Seconds := 15 * time.Second
@@ -349,6 +355,17 @@ subscribe:
 										},
 									},
 								},
+								{
+									Name:    aws.String("orders"),
+									Service: aws.String("testpubsvc"),
+									FIFO:    aws.Bool(true),
+									Queue: SQSQueueOrBool{
+										Enabled: aws.Bool(true),
+									},
+									FilterPolicy: map[string]interface{}{
+										"event": []interface{}{"order_placed"},
+									},
+								},
 							},
 							Queue: SQSQueue{
 								Delay: &duration15Seconds,
@@ -17,6 +17,7 @@ var defaultTransformers = []mergo.Transformers{
 	// do not merge anything - they just unset the fields that do not get specified in source manifest.
 	basicTransformer{},
 	imageTransformer{},
+	sidecarConfigTransformer{},
 	buildArgsOrStringTransformer{},
 	stringSliceOrStringTransformer{},
 	platformArgsOrStringTransformer{},
@@ -27,6 +28,7 @@ var defaultTransformers = []mergo.Transformers{
 	efsConfigOrBoolTransformer{},
 	efsVolumeConfigurationTransformer{},
 	sqsQueueOrBoolTransformer{},
+	eventBusOrBoolTransformer{},
 }
 
 // See a complete list of `reflect.Kind` here: https://pkg.go.dev/reflect#Kind.
@@ -60,6 +62,38 @@ func (t imageTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Va
 
 		if srcStruct.Location != nil {
 			dstStruct.Build = BuildArgsOrString{}
+			dstStruct.Registry = ImageRegistry{} // Images that aren't built aren't pushed to a registry.
+			dstStruct.Scan = ImageScan{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
+type sidecarConfigTransformer struct{}
+
+// Transformer provides custom logic to transform a SidecarConfig.
+func (t sidecarConfigTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(SidecarConfig{}) {
+		return nil
+	}
+
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(SidecarConfig), src.Interface().(SidecarConfig)
+
+		if !srcStruct.Build.isEmpty() && srcStruct.Image != nil {
+			return fmt.Errorf(fmtExclusiveFieldsSpecifiedTogether, "sidecar.build", "is", "sidecar.image")
+		}
+
+		if !srcStruct.Build.isEmpty() {
+			dstStruct.Image = nil
+		}
+
+		if srcStruct.Image != nil {
+			dstStruct.Build = BuildArgsOrString{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -135,10 +169,17 @@ func (t platformArgsOrStringTransformer) Transformer(typ reflect.Type) func(dst,
 
 		if srcStruct.PlatformString != nil {
 			dstStruct.PlatformArgs = PlatformArgs{}
+			dstStruct.PlatformList = nil
 		}
 
 		if !srcStruct.PlatformArgs.isEmpty() {
 			dstStruct.PlatformString = nil
+			dstStruct.PlatformList = nil
+		}
+
+		if srcStruct.PlatformList != nil {
+			dstStruct.PlatformString = nil
+			dstStruct.PlatformArgs = PlatformArgs{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -326,6 +367,31 @@ func (q sqsQueueOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src r
 	}
 }
 
+type eventBusOrBoolTransformer struct{}
+
+// Transformer returns custom merge logic for EventBusOrBool's fields.
+func (t eventBusOrBoolTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(EventBusOrBool{}) {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(EventBusOrBool), src.Interface().(EventBusOrBool)
+
+		if !srcStruct.Advanced.IsEmpty() {
+			dstStruct.Enabled = nil
+		}
+
+		if srcStruct.Enabled != nil {
+			dstStruct.Advanced = EventBus{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type basicTransformer struct{}
 
 // Transformer returns custom merge logic for volume's fields.
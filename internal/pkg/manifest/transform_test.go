@@ -194,6 +194,39 @@ func TestImageTransformer_Transformer(t *testing.T) {
 				}
 			},
 		},
+		"registry set to empty if location is not nil": {
+			original: func(i *Image) {
+				i.Build = BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				}
+				i.Registry = ImageRegistry{
+					URI:         aws.String("ghcr.io/org/repo"),
+					Credentials: aws.String("mockSecretARN"),
+				}
+			},
+			override: func(i *Image) {
+				i.Location = aws.String("mockLocation")
+			},
+			wanted: func(i *Image) {
+				i.Location = aws.String("mockLocation")
+			},
+		},
+		"scan set to empty if location is not nil": {
+			original: func(i *Image) {
+				i.Build = BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				}
+				i.Scan = ImageScan{
+					OnPush: aws.Bool(true),
+				}
+			},
+			override: func(i *Image) {
+				i.Location = aws.String("mockLocation")
+			},
+			wanted: func(i *Image) {
+				i.Location = aws.String("mockLocation")
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -218,6 +251,68 @@ func TestImageTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestSidecarConfigTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(s *SidecarConfig)
+		override func(s *SidecarConfig)
+		wanted   func(s *SidecarConfig)
+	}{
+		"build set to empty if image is not nil": {
+			original: func(s *SidecarConfig) {
+				s.Build = BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				}
+			},
+			override: func(s *SidecarConfig) {
+				s.Image = aws.String("mockImage")
+			},
+			wanted: func(s *SidecarConfig) {
+				s.Image = aws.String("mockImage")
+				s.Build = BuildArgsOrString{}
+			},
+		},
+		"image set to empty if build is not nil": {
+			original: func(s *SidecarConfig) {
+				s.Image = aws.String("mockImage")
+			},
+			override: func(s *SidecarConfig) {
+				s.Build = BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("mockDockerfile"),
+					},
+				}
+			},
+			wanted: func(s *SidecarConfig) {
+				s.Build = BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("mockDockerfile"),
+					},
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted SidecarConfig
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use sidecarConfigTransformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(sidecarConfigTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestBuildArgsOrStringTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(b *BuildArgsOrString)
@@ -371,6 +466,28 @@ func TestPlatformArgsOrStringTransformer_Transformer(t *testing.T) {
 				p.PlatformString = &mockPlatformStr
 			},
 		},
+		"string set to empty if list is not nil": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+		},
+		"list set to empty if string is not nil": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -819,3 +936,60 @@ func TestSQSQueueOrBoolTransformer_Transformer(t *testing.T) {
 		})
 	}
 }
+
+func TestEventBusOrBoolTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(e *EventBusOrBool)
+		override func(e *EventBusOrBool)
+		wanted   func(e *EventBusOrBool)
+	}{
+		"bool set to empty if config is not nil": {
+			original: func(e *EventBusOrBool) {
+				e.Enabled = aws.Bool(true)
+			},
+			override: func(e *EventBusOrBool) {
+				e.Advanced = EventBus{
+					Name: aws.String("orders"),
+				}
+			},
+			wanted: func(e *EventBusOrBool) {
+				e.Advanced = EventBus{
+					Name: aws.String("orders"),
+				}
+			},
+		},
+		"config set to empty if bool is not nil": {
+			original: func(e *EventBusOrBool) {
+				e.Advanced = EventBus{
+					Name: aws.String("orders"),
+				}
+			},
+			override: func(e *EventBusOrBool) {
+				e.Enabled = aws.Bool(false)
+			},
+			wanted: func(e *EventBusOrBool) {
+				e.Enabled = aws.Bool(false)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted EventBusOrBool
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use eventBusOrBoolTransformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(eventBusOrBoolTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
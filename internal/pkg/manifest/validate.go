@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/dustin/go-humanize/english"
 )
@@ -39,12 +40,15 @@ var (
 	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	awsEventBusRegexp   = regexp.MustCompile(`^[a-zA-Z0-9/._-]+$`) // Validates that an expression contains only characters allowed in an EventBridge event bus name.
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
+	imageScanSeverities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL"}
+
 	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 )
 
@@ -97,6 +101,9 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	if err = validateImageScanPlatforms(l.ImageConfig.Image.Scan, l.TaskConfig.Platform); err != nil {
+		return err
+	}
 	if err = l.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -170,6 +177,9 @@ func (b BackendServiceConfig) Validate() error {
 	if err = b.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	if err = validateImageScanPlatforms(b.ImageConfig.Image.Scan, b.TaskConfig.Platform); err != nil {
+		return err
+	}
 	if err = b.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -222,6 +232,9 @@ func (r RequestDrivenWebServiceConfig) Validate() error {
 	if err = r.ImageConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "image": %w`, err)
 	}
+	if !r.ImageConfig.Image.Registry.IsEmpty() {
+		return fmt.Errorf(`"image.registry" is not supported for %s`, RequestDrivenWebServiceType)
+	}
 	if err = r.InstanceConfig.Validate(); err != nil {
 		return err
 	}
@@ -269,6 +282,9 @@ func (w WorkerServiceConfig) Validate() error {
 	if err = w.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	if err = validateImageScanPlatforms(w.ImageConfig.Image.Scan, w.TaskConfig.Platform); err != nil {
+		return err
+	}
 	if err = w.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -342,6 +358,9 @@ func (s ScheduledJobConfig) Validate() error {
 	if err = s.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	if err = validateImageScanPlatforms(s.ImageConfig.Image.Scan, s.TaskConfig.Platform); err != nil {
+		return err
+	}
 	if err = s.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -393,6 +412,14 @@ func (w Workload) Validate() error {
 			missingField: "name",
 		}
 	}
+	for _, dep := range w.DependsOn {
+		if dep == "" {
+			return errors.New(`validate "depends_on": workload names must not be empty`)
+		}
+		if dep == aws.StringValue(w.Name) {
+			return fmt.Errorf(`validate "depends_on": workload %s cannot depend on itself`, dep)
+		}
+	}
 	return nil
 }
 
@@ -465,6 +492,100 @@ func (i Image) Validate() error {
 	if err = i.DependsOn.Validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if err = i.Registry.Validate(); err != nil {
+		return fmt.Errorf(`validate "registry": %w`, err)
+	}
+	if !i.Registry.IsEmpty() && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"registry"},
+		}
+	}
+	if err = i.Scan.Validate(); err != nil {
+		return fmt.Errorf(`validate "scan": %w`, err)
+	}
+	if !i.Scan.IsEmpty() && !i.Registry.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "scan",
+			secondField: "registry",
+		}
+	}
+	if !i.Scan.IsEmpty() && i.Build.isEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "build",
+			conditionalFields: []string{"scan"},
+		}
+	}
+	if err = i.Signature.Validate(); err != nil {
+		return fmt.Errorf(`validate "signature": %w`, err)
+	}
+	if !i.Signature.IsEmpty() && !i.Build.isEmpty() && !isKMSKeyARN(aws.StringValue(i.Signature.Key)) {
+		return fmt.Errorf(`"signature.key" must be the ARN of a KMS key to sign the image built from "build"`)
+	}
+	return nil
+}
+
+// Validate returns nil if ImageSignature is configured correctly.
+func (s ImageSignature) Validate() error {
+	if s.Key != nil && aws.StringValue(s.Key) == "" {
+		return errors.New(`"key" cannot be empty`)
+	}
+	return nil
+}
+
+func isKMSKeyARN(s string) bool {
+	parsed, err := arn.Parse(s)
+	return err == nil && parsed.Service == "kms"
+}
+
+// Validate returns nil if ImageScan is configured correctly.
+func (s ImageScan) Validate() error {
+	if s.FailOn == nil {
+		return nil
+	}
+	if !aws.BoolValue(s.OnPush) {
+		return &errFieldMustBeSpecified{
+			missingField:      "on_push",
+			conditionalFields: []string{"fail_on"},
+		}
+	}
+	severity := strings.ToUpper(aws.StringValue(s.FailOn))
+	for _, allowed := range imageScanSeverities {
+		if severity == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf(`"fail_on" %s must be one of %s`, aws.StringValue(s.FailOn), english.WordSeries(imageScanSeverities, "or"))
+}
+
+// validateImageScanPlatforms returns an error if the image is scanned and built for multiple platforms,
+// since ECR doesn't scan manifest lists.
+func validateImageScanPlatforms(scan ImageScan, platform PlatformArgsOrString) error {
+	if !aws.BoolValue(scan.OnPush) || !platform.IsMultiPlatform() {
+		return nil
+	}
+	return errors.New(`"image.scan" cannot be used with multiple platforms: ECR does not scan multi-architecture images`)
+}
+
+// Validate returns nil if ImageRegistry is configured correctly.
+func (r ImageRegistry) Validate() error {
+	if r.IsEmpty() {
+		return nil
+	}
+	if r.URI == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "uri",
+		}
+	}
+	if r.Credentials == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "credentials",
+		}
+	}
+	uri := aws.StringValue(r.URI)
+	if name := uri[strings.LastIndex(uri, "/")+1:]; strings.ContainsAny(name, ":@") {
+		return fmt.Errorf(`"uri" %s must not contain a tag or digest`, uri)
+	}
 	return nil
 }
 
@@ -623,6 +744,9 @@ func (t TaskConfig) Validate() error {
 	if err = t.Storage.Validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
+	if err = t.IAM.Validate(); err != nil {
+		return fmt.Errorf(`validate "iam": %w`, err)
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -632,6 +756,50 @@ func (t TaskConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if IAM is configured correctly.
+func (i IAM) Validate() error {
+	if err := i.TaskRole.Validate(); err != nil {
+		return fmt.Errorf(`validate "task_role": %w`, err)
+	}
+	if err := i.ExecutionRole.Validate(); err != nil {
+		return fmt.Errorf(`validate "execution_role": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if IAMRole is configured correctly.
+func (r IAMRole) Validate() error {
+	for _, arn := range r.ManagedPolicies {
+		if !strings.HasPrefix(arn, "arn:") || !strings.Contains(arn, ":policy/") {
+			return fmt.Errorf(`"managed_policies" must contain IAM policy ARNs: %s is not a policy ARN`, arn)
+		}
+	}
+	for ind, statement := range r.Statements {
+		if err := statement.Validate(); err != nil {
+			return fmt.Errorf(`validate "statements[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if IAMPolicyStatement is configured correctly.
+func (s IAMPolicyStatement) Validate() error {
+	if s.Effect != nil && *s.Effect != IAMEffectAllow && *s.Effect != IAMEffectDeny {
+		return fmt.Errorf(`"effect" must be one of %s or %s`, IAMEffectAllow, IAMEffectDeny)
+	}
+	if len(s.Actions) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "actions",
+		}
+	}
+	if len(s.Resources) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "resources",
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) Validate() error {
 	if p.IsEmpty() {
@@ -643,6 +811,20 @@ func (p PlatformArgsOrString) Validate() error {
 	if p.PlatformString != nil {
 		return p.PlatformString.Validate()
 	}
+	seen := make(map[string]bool)
+	for _, platform := range p.PlatformList {
+		if err := platform.Validate(); err != nil {
+			return err
+		}
+		lower := strings.ToLower(string(platform))
+		if strings.Split(lower, "/")[0] != OSLinux && len(p.PlatformList) > 1 {
+			return fmt.Errorf("platform '%s' is invalid; multi-architecture images can only be built for %s", platform, OSLinux)
+		}
+		if seen[lower] {
+			return fmt.Errorf("platform '%s' is specified more than once", platform)
+		}
+		seen[lower] = true
+	}
 	return nil
 }
 
@@ -959,6 +1141,15 @@ func (l Logging) Validate() error {
 
 // Validate returns nil if SidecarConfig is configured correctly.
 func (s SidecarConfig) Validate() error {
+	if s.Image != nil && !s.Build.isEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "image",
+			secondField: "build",
+		}
+	}
+	if err := s.Build.Validate(); err != nil {
+		return fmt.Errorf(`validate "build": %w`, err)
+	}
 	for ind, mp := range s.MountPoints {
 		if err := mp.Validate(); err != nil {
 			return fmt.Errorf(`validate "mount_points[%d]": %w`, ind, err)
@@ -1100,9 +1291,28 @@ func (p PublishConfig) Validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	if err := p.EventBus.Validate(); err != nil {
+		return fmt.Errorf(`validate "event_bus": %w`, err)
+	}
 	return nil
 }
 
+// Validate returns nil if EventBusOrBool is configured correctly.
+func (b EventBusOrBool) Validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	return b.Advanced.Validate()
+}
+
+// Validate returns nil if EventBus is configured correctly.
+func (b EventBus) Validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	return validateEventBusName(aws.StringValue(b.Name))
+}
+
 // Validate returns nil if Topic is configured correctly.
 func (t Topic) Validate() error {
 	return validatePubSubName(aws.StringValue(t.Name))
@@ -1118,12 +1328,46 @@ func (s SubscribeConfig) Validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	for ind, event := range s.Events {
+		if err := event.Validate(); err != nil {
+			return fmt.Errorf(`validate "events[%d]": %w`, ind, err)
+		}
+	}
+	if err := s.validateSharedQueueFIFO(); err != nil {
+		return err
+	}
 	if err := s.Queue.Validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
 	return nil
 }
 
+// validateSharedQueueFIFO returns an error if FIFO and standard topics deliver messages to the same queue.
+// A FIFO topic can only deliver to a FIFO queue, and a standard topic can only deliver to a standard queue.
+func (s SubscribeConfig) validateSharedQueueFIFO() error {
+	var fifo, standard *TopicSubscription
+	for i := range s.Topics {
+		topic := &s.Topics[i]
+		if !topic.UsesSharedQueue() {
+			continue
+		}
+		if aws.BoolValue(topic.FIFO) {
+			fifo = topic
+		} else {
+			standard = topic
+		}
+	}
+	if fifo != nil && len(s.Events) != 0 {
+		return fmt.Errorf(`FIFO topic %s and event subscriptions cannot deliver messages to the same queue: set "queue" on the topic subscription to use a dedicated queue`,
+			aws.StringValue(fifo.Name))
+	}
+	if fifo == nil || standard == nil {
+		return nil
+	}
+	return fmt.Errorf(`FIFO topic %s and standard topic %s cannot deliver messages to the same queue: set "queue" on one of the subscriptions to use a dedicated queue`,
+		aws.StringValue(fifo.Name), aws.StringValue(standard.Name))
+}
+
 // Validate returns nil if TopicSubscription is configured correctly.
 func (t TopicSubscription) Validate() error {
 	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
@@ -1141,6 +1385,69 @@ func (t TopicSubscription) Validate() error {
 	if err := t.Queue.Validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
+	if err := validateFilterPolicy(t.FilterPolicy); err != nil {
+		return fmt.Errorf(`validate "filter_policy": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if EventSubscription is configured correctly.
+func (e EventSubscription) Validate() error {
+	if err := validatePubSubName(aws.StringValue(e.Name)); err != nil {
+		return err
+	}
+	if e.Service == nil && e.Bus == nil {
+		return &errFieldMutualExclusive{
+			firstField:  "service",
+			secondField: "bus",
+			mustExist:   true,
+		}
+	}
+	if e.Service != nil && e.Bus != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "service",
+			secondField: "bus",
+		}
+	}
+	if e.Service != nil && !isValidSubSvcName(aws.StringValue(e.Service)) {
+		return fmt.Errorf("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
+	}
+	if e.Bus != nil {
+		if err := validateEventBusName(aws.StringValue(e.Bus)); err != nil {
+			return fmt.Errorf(`validate "bus": %w`, err)
+		}
+	}
+	if len(e.Pattern) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "pattern",
+		}
+	}
+	if err := validateFilterPolicy(e.Pattern); err != nil {
+		return fmt.Errorf(`validate "pattern": %w`, err)
+	}
+	if _, ok := e.Pattern["source"]; ok && e.Service != nil {
+		return fmt.Errorf(`"pattern" cannot match "source" when "service" is specified: events are matched against the source of service %s`, aws.StringValue(e.Service))
+	}
+	return nil
+}
+
+// validateFilterPolicy returns nil if every attribute of an SNS subscription filter policy
+// or an EventBridge event pattern is matched against a list of values or a nested policy.
+func validateFilterPolicy(policy map[string]interface{}) error {
+	for attr, val := range policy {
+		switch v := val.(type) {
+		case []interface{}:
+			if len(v) == 0 {
+				return fmt.Errorf("attribute %q must match at least one value", attr)
+			}
+		case map[string]interface{}:
+			if err := validateFilterPolicy(v); err != nil {
+				return fmt.Errorf("attribute %q: %w", attr, err)
+			}
+		default:
+			return fmt.Errorf("attribute %q must be a list of values", attr)
+		}
+	}
 	return nil
 }
 
@@ -1331,6 +1638,18 @@ func validatePubSubName(name string) error {
 	return nil
 }
 
+func validateEventBusName(name string) error {
+	if name == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if len(name) > 256 || !awsEventBusRegexp.MatchString(name) {
+		return fmt.Errorf("event bus name can only contain up to 256 letters, numbers, periods, slashes, underscores, and hyphens")
+	}
+	return nil
+}
+
 func isValidSubSvcName(name string) bool {
 	if !awsNameRegexp.MatchString(name) {
 		return false
@@ -357,6 +357,25 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate ARM: `,
 		},
+		"error if the image is scanned and built for multiple platforms": {
+			config: BackendService{
+				Workload: Workload{Name: aws.String("mockName")},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: Image{
+								Build: BuildArgsOrString{BuildString: aws.String("mockBuild")},
+								Scan:  ImageScan{OnPush: aws.Bool(true)},
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						Platform: PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "linux/arm64"}},
+					},
+				},
+			},
+			wantedError: errors.New(`"image.scan" cannot be used with multiple platforms: ECR does not scan multi-architecture images`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -750,6 +769,21 @@ func TestScheduledJob_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`"name" must be specified`),
 		},
+		"error if the job depends on itself": {
+			config: ScheduledJob{
+				Workload: Workload{
+					Name:      aws.String("mockWorkload"),
+					DependsOn: []string{"api", "mockWorkload"},
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: testImageConfig,
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "depends_on": workload mockWorkload cannot depend on itself`),
+		},
 		"error if fail to validate dependencies": {
 			config: ScheduledJob{
 				Workload: Workload{Name: aws.String("mockWorkload")},
@@ -862,6 +896,146 @@ func TestImage_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if registry credentials are missing": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Registry: ImageRegistry{
+					URI: aws.String("ghcr.io/org/repo"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "registry": "credentials" must be specified`),
+		},
+		"error if registry uri has a tag": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Registry: ImageRegistry{
+					URI:         aws.String("registry.example.com:5000/org/repo:v1"),
+					Credentials: aws.String("mockSecretARN"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "registry": "uri" registry.example.com:5000/org/repo:v1 must not contain a tag or digest`),
+		},
+		"error if registry is specified with location": {
+			Image: Image{
+				Location: aws.String("mockLocation"),
+				Registry: ImageRegistry{
+					URI:         aws.String("ghcr.io/org/repo"),
+					Credentials: aws.String("mockSecretARN"),
+				},
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "registry" is specified`),
+		},
+		"success with a registry on a port": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Registry: ImageRegistry{
+					URI:         aws.String("registry.example.com:5000/org/repo"),
+					Credentials: aws.String("mockSecretARN"),
+				},
+			},
+		},
+		"error if scan fail_on is set without on_push": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Scan: ImageScan{
+					FailOn: aws.String("HIGH"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scan": "on_push" must be specified if "fail_on" is specified`),
+		},
+		"error if scan fail_on is an invalid severity": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Scan: ImageScan{
+					OnPush: aws.Bool(true),
+					FailOn: aws.String("SEVERE"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scan": "fail_on" SEVERE must be one of CRITICAL, HIGH, MEDIUM, LOW or INFORMATIONAL`),
+		},
+		"error if scan is specified with registry": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Registry: ImageRegistry{
+					URI:         aws.String("ghcr.io/org/repo"),
+					Credentials: aws.String("mockSecretARN"),
+				},
+				Scan: ImageScan{
+					OnPush: aws.Bool(true),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "scan" and "registry"`),
+		},
+		"error if scan is specified with location": {
+			Image: Image{
+				Location: aws.String("mockLocation"),
+				Scan: ImageScan{
+					OnPush: aws.Bool(true),
+				},
+			},
+			wantedError: fmt.Errorf(`"build" must be specified if "scan" is specified`),
+		},
+		"error if signature key is empty": {
+			Image: Image{
+				Location: aws.String("mockLocation"),
+				Signature: ImageSignature{
+					Key: aws.String(""),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "signature": "key" cannot be empty`),
+		},
+		"error if signing a built image with a public key": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Signature: ImageSignature{
+					Key: aws.String("cosign.pub"),
+				},
+			},
+			wantedError: fmt.Errorf(`"signature.key" must be the ARN of a KMS key to sign the image built from "build"`),
+		},
+		"success verifying an image location with a public key": {
+			Image: Image{
+				Location: aws.String("mockLocation"),
+				Signature: ImageSignature{
+					Key: aws.String("cosign.pub"),
+				},
+			},
+		},
+		"success signing a built image with a KMS key": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Signature: ImageSignature{
+					Key: aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				},
+			},
+		},
+		"success with a lowercase scan severity": {
+			Image: Image{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				},
+				Scan: ImageScan{
+					OnPush: aws.Bool(true),
+					FailOn: aws.String("high"),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1098,6 +1272,18 @@ func TestPlatformArgsOrString_Validate(t *testing.T) {
 			in:     PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("foobar/amd64"))},
 			wanted: fmt.Errorf("platform 'foobar/amd64' is invalid; valid platforms are: linux/amd64, linux/x86_64, linux/arm, linux/arm64, windows/amd64 and windows/x86_64"),
 		},
+		"error if a platform in the list is invalid": {
+			in:     PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "linux"}},
+			wanted: fmt.Errorf("platform 'linux' must be in the format [OS]/[Arch]"),
+		},
+		"error if a multi-architecture image includes windows": {
+			in:     PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "windows/amd64"}},
+			wanted: fmt.Errorf("platform 'windows/amd64' is invalid; multi-architecture images can only be built for linux"),
+		},
+		"error if a platform is listed twice": {
+			in:     PlatformArgsOrString{PlatformList: []PlatformString{"linux/arm64", "Linux/ARM64"}},
+			wanted: fmt.Errorf("platform 'Linux/ARM64' is specified more than once"),
+		},
 		"error if only half of platform string is specified": {
 			in:     PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux"))},
 			wanted: fmt.Errorf("platform 'linux' must be in the format [OS]/[Arch]"),
@@ -1548,6 +1734,74 @@ func TestStorage_Validate(t *testing.T) {
 	}
 }
 
+func TestIAM_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in IAM
+
+		wantedError error
+	}{
+		"valid": {
+			in: IAM{
+				TaskRole: IAMRole{
+					ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+					Statements: []IAMPolicyStatement{
+						{
+							Effect:    aws.String("Deny"),
+							Actions:   []string{"s3:DeleteObject"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+			},
+		},
+		"error if a managed policy is not a policy ARN": {
+			in: IAM{
+				ExecutionRole: IAMRole{
+					ManagedPolicies: []string{"AmazonS3ReadOnlyAccess"},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "execution_role": "managed_policies" must contain IAM policy ARNs: AmazonS3ReadOnlyAccess is not a policy ARN`),
+		},
+		"error if the effect is invalid": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Effect:    aws.String("allow"),
+							Actions:   []string{"s3:GetObject"},
+							Resources: []string{"*"},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "task_role": validate "statements[0]": "effect" must be one of Allow or Deny`),
+		},
+		"error if resources are missing": {
+			in: IAM{
+				TaskRole: IAMRole{
+					Statements: []IAMPolicyStatement{
+						{
+							Actions: []string{"s3:GetObject"},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "task_role": validate "statements[0]": "resources" must be specified`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestVolume_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		Volume Volume
@@ -1662,6 +1916,24 @@ func TestSidecarConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "depends_on": `,
 		},
+		"error if both image and build are specified": {
+			config: SidecarConfig{
+				Image: aws.String("nginx"),
+				Build: BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+			wantedErrorPrefix: `must specify one, not both, of "image" and "build"`,
+		},
+		"valid sidecar built from a local Dockerfile": {
+			config: SidecarConfig{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("proxy/Dockerfile"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1995,6 +2267,32 @@ func TestPublishConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"error if event bus name is invalid": {
+			config: PublishConfig{
+				EventBus: EventBusOrBool{
+					Advanced: EventBus{
+						Name: aws.String("orders bus"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "event_bus": event bus name can only contain`,
+		},
+		"valid with an event bus": {
+			config: PublishConfig{
+				EventBus: EventBusOrBool{
+					Enabled: aws.Bool(true),
+				},
+			},
+		},
+		"valid with an existing event bus": {
+			config: PublishConfig{
+				EventBus: EventBusOrBool{
+					Advanced: EventBus{
+						Name: aws.String("partner/orders.bus"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2054,6 +2352,71 @@ func TestSubscribeConfig_Validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"error if FIFO and standard topics share the events queue": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+					{
+						Name:    aws.String("users"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+			wantedErrorPrefix: `FIFO topic orders and standard topic users cannot deliver messages to the same queue`,
+		},
+		"error if fail to validate events": {
+			config: SubscribeConfig{
+				Events: []EventSubscription{
+					{
+						Name: aws.String("orderPlaced"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "events[0]": `,
+		},
+		"error if a FIFO topic and events share the events queue": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+					},
+				},
+				Events: []EventSubscription{
+					{
+						Name:    aws.String("orderPlaced"),
+						Service: aws.String("api"),
+						Pattern: map[string]interface{}{
+							"detail-type": []interface{}{"OrderPlaced"},
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `FIFO topic orders and event subscriptions cannot deliver messages to the same queue`,
+		},
+		"valid FIFO and standard topics with a dedicated queue": {
+			config: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+						FIFO:    aws.Bool(true),
+						Queue: SQSQueueOrBool{
+							Enabled: aws.Bool(true),
+						},
+					},
+					{
+						Name:    aws.String("users"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2090,6 +2453,134 @@ func TestTopicSubscription_Validate(t *testing.T) {
 			},
 			wanted: errors.New("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
 		},
+		"should return an error if a filter policy attribute is not a list": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				FilterPolicy: map[string]interface{}{
+					"event": "order_placed",
+				},
+			},
+			wanted: errors.New(`validate "filter_policy": attribute "event" must be a list of values`),
+		},
+		"should return an error if a nested filter policy attribute is empty": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				FilterPolicy: map[string]interface{}{
+					"store": map[string]interface{}{
+						"region": []interface{}{},
+					},
+				},
+			},
+			wanted: errors.New(`validate "filter_policy": attribute "store": attribute "region" must match at least one value`),
+		},
+		"valid with a filter policy": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				FilterPolicy: map[string]interface{}{
+					"event": []interface{}{"order_placed", "order_cancelled"},
+					"price": []interface{}{map[string]interface{}{"numeric": []interface{}{">=", 100}}},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventSubscription_Validate(t *testing.T) {
+	mockPattern := map[string]interface{}{
+		"source":      []interface{}{"orders"},
+		"detail-type": []interface{}{"OrderPlaced"},
+	}
+	testCases := map[string]struct {
+		in     EventSubscription
+		wanted error
+	}{
+		"should return an error if name is empty": {
+			in:     EventSubscription{},
+			wanted: errors.New(`"name" must be specified`),
+		},
+		"should return an error if neither service nor bus is specified": {
+			in: EventSubscription{
+				Name: aws.String("orderPlaced"),
+			},
+			wanted: errors.New(`must specify one of "service" and "bus"`),
+		},
+		"should return an error if both service and bus are specified": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("api"),
+				Bus:     aws.String("partner-bus"),
+			},
+			wanted: errors.New(`must specify one, not both, of "service" and "bus"`),
+		},
+		"should return an error if service is in invalid format": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("!!!!!"),
+			},
+			wanted: errors.New("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen"),
+		},
+		"should return an error if bus is in invalid format": {
+			in: EventSubscription{
+				Name: aws.String("orderPlaced"),
+				Bus:  aws.String("partner bus"),
+			},
+			wanted: errors.New(`validate "bus": event bus name can only contain up to 256 letters, numbers, periods, slashes, underscores, and hyphens`),
+		},
+		"should return an error if pattern is empty": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("api"),
+			},
+			wanted: errors.New(`"pattern" must be specified`),
+		},
+		"should return an error if a pattern attribute is not a list": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("api"),
+				Pattern: map[string]interface{}{
+					"source": "orders",
+				},
+			},
+			wanted: errors.New(`validate "pattern": attribute "source" must be a list of values`),
+		},
+		"should return an error if the pattern matches the source of events from a service": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("api"),
+				Pattern: mockPattern,
+			},
+			wanted: errors.New(`"pattern" cannot match "source" when "service" is specified: events are matched against the source of service api`),
+		},
+		"valid with a service": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Service: aws.String("api"),
+				Pattern: map[string]interface{}{
+					"detail-type": []interface{}{"OrderPlaced"},
+				},
+			},
+		},
+		"valid with an existing bus": {
+			in: EventSubscription{
+				Name:    aws.String("orderPlaced"),
+				Bus:     aws.String("partner-bus"),
+				Pattern: mockPattern,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
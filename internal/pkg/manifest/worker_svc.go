@@ -36,6 +36,11 @@ func (s *WorkerService) Publish() []Topic {
 	return s.WorkerServiceConfig.PublishConfig.Topics
 }
 
+// UsesEnvEventBus returns true if the service publishes or subscribes to events on the event bus created by the environment.
+func (s *WorkerService) UsesEnvEventBus() bool {
+	return s.WorkerServiceConfig.PublishConfig.UsesEnvEventBus() || s.WorkerServiceConfig.Subscribe.UsesEnvEventBus()
+}
+
 // WorkerServiceConfig holds the configuration that can be overridden per environments.
 type WorkerServiceConfig struct {
 	ImageConfig      ImageWithHealthcheck `yaml:"image,flow"`
@@ -52,19 +57,46 @@ type WorkerServiceConfig struct {
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
 	Topics []TopicSubscription `yaml:"topics"`
+	Events []EventSubscription `yaml:"events"`
 	Queue  SQSQueue            `yaml:"queue"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Events == nil && s.Queue.IsEmpty()
+}
+
+// UsesEnvEventBus returns true if any of the events is matched on the event bus created by the environment.
+func (s SubscribeConfig) UsesEnvEventBus() bool {
+	for _, event := range s.Events {
+		if event.Bus == nil {
+			return true
+		}
+	}
+	return false
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
 type TopicSubscription struct {
-	Name    *string        `yaml:"name"`
-	Service *string        `yaml:"service"`
-	Queue   SQSQueueOrBool `yaml:"queue"`
+	Name         *string                `yaml:"name"`
+	Service      *string                `yaml:"service"`
+	Queue        SQSQueueOrBool         `yaml:"queue"`
+	FilterPolicy map[string]interface{} `yaml:"filter_policy"`
+	FIFO         *bool                  `yaml:"fifo"`
+}
+
+// UsesSharedQueue returns true if the subscription delivers messages to the service's shared events queue.
+func (t TopicSubscription) UsesSharedQueue() bool {
+	return !aws.BoolValue(t.Queue.Enabled) && t.Queue.Advanced.IsEmpty()
+}
+
+// EventSubscription represents the configurable options for routing EventBridge events into the service's events queue.
+// Events are matched either on the event bus published by another Copilot service or on an existing event bus.
+type EventSubscription struct {
+	Name    *string                `yaml:"name"`
+	Service *string                `yaml:"service"`
+	Bus     *string                `yaml:"bus"`
+	Pattern map[string]interface{} `yaml:"pattern"`
 }
 
 // SQSQueueOrBool contains custom unmarshaling logic for the `queue` field in the manifest.
@@ -140,7 +172,8 @@ func NewWorkerService(props WorkerServiceProps) *WorkerService {
 	// Apply overrides.
 	svc.Name = stringP(props.Name)
 	svc.WorkerServiceConfig.ImageConfig.Image.Location = stringP(props.Image)
-	svc.WorkerServiceConfig.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	svc.WorkerServiceConfig.ImageConfig.Image.Build.BuildArgs = props.dockerBuildArgs()
+	svc.dockerfileRuntime = props.Runtime
 	svc.WorkerServiceConfig.ImageConfig.HealthCheck = props.HealthCheck
 	svc.WorkerServiceConfig.Platform = props.Platform
 	if isWindowsPlatform(props.Platform) {
@@ -176,6 +209,27 @@ func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// SidecarBuildArgs returns the docker build arguments of the service's sidecars that are built from local Dockerfiles
+// keyed by sidecar name, given a workspace root directory.
+func (s *WorkerService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
+// ImageRegistry returns the third-party registry that the service's image is pushed to.
+func (s *WorkerService) ImageRegistry() ImageRegistry {
+	return s.ImageConfig.Image.Registry
+}
+
+// ImageScan returns the vulnerability scan configuration of the service's image.
+func (s *WorkerService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ContainerImage returns the image of the service's main container.
+func (s *WorkerService) ContainerImage() Image {
+	return s.ImageConfig.Image
+}
+
 // EnvFile returns the location of the env file against the ws root directory.
 func (s *WorkerService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
@@ -1253,3 +1253,60 @@ func TestSQSQueueOrBool_UnmarshalYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerSvc_UsesEnvEventBus(t *testing.T) {
+	testCases := map[string]struct {
+		publish   PublishConfig
+		subscribe SubscribeConfig
+
+		wanted bool
+	}{
+		"false without events": {
+			publish: PublishConfig{
+				Topics: []Topic{{Name: aws.String("orders")}},
+			},
+		},
+		"false when publishing to an existing event bus": {
+			publish: PublishConfig{
+				EventBus: EventBusOrBool{
+					Advanced: EventBus{Name: aws.String("partner-bus")},
+				},
+			},
+		},
+		"false when subscribing to events on an existing event bus": {
+			subscribe: SubscribeConfig{
+				Events: []EventSubscription{{Name: aws.String("orders"), Bus: aws.String("partner-bus")}},
+			},
+		},
+		"true when publishing to the environment's event bus": {
+			publish: PublishConfig{
+				EventBus: EventBusOrBool{Enabled: aws.Bool(true)},
+			},
+			wanted: true,
+		},
+		"true when subscribing to events on the environment's event bus": {
+			subscribe: SubscribeConfig{
+				Events: []EventSubscription{{Name: aws.String("orders"), Service: aws.String("api")}},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			svc := &WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					PublishConfig: tc.publish,
+					Subscribe:     tc.subscribe,
+				},
+			}
+
+			// WHEN
+			got := svc.UsesEnvEventBus()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -88,7 +89,7 @@ var (
 	ErrAppRunnerInvalidPlatformWindows = errors.New("Windows is not supported for App Runner services")
 
 	errUnmarshalBuildOpts    = errors.New("unable to unmarshal build field into string or compose-style map")
-	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string or compose-style map")
+	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string, list of strings, or compose-style map")
 	errUnmarshalCountOpts    = errors.New(`unable to unmarshal "count" field to an integer or autoscaling configuration`)
 	errUnmarshalRangeOpts    = errors.New(`unable to unmarshal "range" field`)
 
@@ -96,6 +97,8 @@ var (
 	errUnmarshalEntryPoint = errors.New(`unable to unmarshal "entrypoint" into string or slice of strings`)
 	errUnmarshalAlias      = errors.New(`unable to unmarshal "alias" into string or slice of strings`)
 	errUnmarshalCommand    = errors.New(`unable to unmarshal "command" into string or slice of strings`)
+
+	errUnmarshalEventBusOpts = errors.New(`unable to unmarshal "event_bus" field into bool or map`)
 )
 
 // WorkloadManifest represents a workload manifest.
@@ -109,12 +112,79 @@ type WorkloadProps struct {
 	Name       string
 	Dockerfile string
 	Image      string
+
+	// Optional settings detected in the Dockerfile.
+	BuildTarget string            // Stage to build in a multi-stage Dockerfile.
+	Runtime     DockerfileRuntime // Runtime defaults baked into the image.
+}
+
+// DockerfileRuntime holds the runtime defaults that a Dockerfile bakes into the image, and the build arguments it leaves unset.
+// They are written as commented-out suggestions in a new manifest so that users can find what to override.
+type DockerfileRuntime struct {
+	Variables      map[string]string
+	EntryPoint     []string
+	Command        []string
+	UnsetBuildArgs []string // Names of the build arguments declared without a default value.
+}
+
+// IsEmpty returns true if no runtime defaults were detected.
+func (r DockerfileRuntime) IsEmpty() bool {
+	return len(r.Variables) == 0 && len(r.EntryPoint) == 0 && len(r.Command) == 0 && len(r.UnsetBuildArgs) == 0
+}
+
+func (p WorkloadProps) dockerBuildArgs() DockerBuildArgs {
+	return DockerBuildArgs{
+		Dockerfile: stringP(p.Dockerfile),
+		Target:     stringP(p.BuildTarget),
+	}
 }
 
 // Workload holds the basic data that every workload manifest file needs to have.
 type Workload struct {
 	Name *string `yaml:"name"`
 	Type *string `yaml:"type"` // must be one of the supported manifest types.
+	// Names of the workloads that "copilot deploy --all" deploys before this one.
+	DependsOn []string `yaml:"depends_on,omitempty"`
+
+	dockerfileRuntime DockerfileRuntime // Only set when the manifest is created from a Dockerfile.
+}
+
+func (w Workload) dependsOn() []string {
+	return w.DependsOn
+}
+
+// WorkloadDependencies returns the sorted names of the workloads that must be deployed before wl:
+// the workloads listed under "depends_on" and the services that publish the topics and events that wl subscribes to.
+func WorkloadDependencies(wl interface{}) []string {
+	deps := make(map[string]bool)
+	if mft, ok := wl.(interface{ dependsOn() []string }); ok {
+		for _, name := range mft.dependsOn() {
+			deps[name] = true
+		}
+	}
+	if mft, ok := wl.(*WorkerService); ok {
+		for _, topic := range mft.Subscribe.Topics {
+			if topic.Service != nil {
+				deps[aws.StringValue(topic.Service)] = true
+			}
+		}
+		for _, event := range mft.Subscribe.Events {
+			if event.Service != nil {
+				deps[aws.StringValue(event.Service)] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DockerfileRuntime returns the runtime defaults detected in the Dockerfile the workload was initialized with.
+func (w Workload) DockerfileRuntime() DockerfileRuntime {
+	return w.dockerfileRuntime
 }
 
 // OverrideRule holds the manifest overriding rule for CloudFormation template.
@@ -133,6 +203,41 @@ type Image struct {
 	Credentials  *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn    DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Registry     ImageRegistry     `yaml:"registry"`        // Push the image built from a Dockerfile to a third-party registry instead of ECR.
+	Scan         ImageScan         `yaml:"scan"`            // Scan the image pushed to ECR for vulnerabilities.
+	Signature    ImageSignature    `yaml:"signature"`       // Sign the image built from a Dockerfile, or verify the signature of the image location.
+}
+
+// ImageSignature represents the cosign-compatible signature of the image.
+type ImageSignature struct {
+	Key *string `yaml:"key"` // ARN of the KMS key, or path to the public key when only verifying, of the signature.
+}
+
+// IsEmpty returns true if the struct has all zero members.
+func (s *ImageSignature) IsEmpty() bool {
+	return s.Key == nil
+}
+
+// ImageScan represents the vulnerability scan of the image pushed to the workload's ECR repository.
+type ImageScan struct {
+	OnPush *bool   `yaml:"on_push"` // Scan the image every time it's pushed.
+	FailOn *string `yaml:"fail_on"` // Abort the deployment if the scan finds vulnerabilities of at least this severity.
+}
+
+// IsEmpty returns true if the struct has all zero members.
+func (s *ImageScan) IsEmpty() bool {
+	return s.OnPush == nil && s.FailOn == nil
+}
+
+// ImageRegistry represents a third-party registry, such as Docker Hub, GHCR or a private Harbor, to push images to.
+type ImageRegistry struct {
+	URI         *string `yaml:"uri"`         // URI of the repository to push the image to, e.g. ghcr.io/org/repo.
+	Credentials *string `yaml:"credentials"` // ARN of the secret containing the "username" and "password" of the registry.
+}
+
+// IsEmpty returns true if the struct has all zero members.
+func (r *ImageRegistry) IsEmpty() bool {
+	return r.URI == nil && r.Credentials == nil
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Image
@@ -188,6 +293,15 @@ func (i Image) GetLocation() string {
 	return aws.StringValue(i.Location)
 }
 
+// PullCredentials returns the ARN of the secret used by tasks to pull the image.
+// Images pushed to a third-party registry are pulled with the registry credentials unless "credentials" is set.
+func (i Image) PullCredentials() *string {
+	if i.Credentials != nil {
+		return i.Credentials
+	}
+	return i.Registry.Credentials
+}
+
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
 // Prefer the following hierarchy:
 // 1. Specific dockerfile, specific context
@@ -490,6 +604,7 @@ func (lc *Logging) GetEnableMetadata() *string {
 type SidecarConfig struct {
 	Port          *string              `yaml:"port"`
 	Image         *string              `yaml:"image"`
+	Build         BuildArgsOrString    `yaml:"build"`
 	Essential     *bool                `yaml:"essential"`
 	CredsParam    *string              `yaml:"credentialsParameter"`
 	Variables     map[string]string    `yaml:"variables"`
@@ -501,6 +616,30 @@ type SidecarConfig struct {
 	ImageOverride `yaml:",inline"`
 }
 
+// BuildRequired returns true if the sidecar image is built from a local Dockerfile.
+func (s *SidecarConfig) BuildRequired() bool {
+	return !s.Build.isEmpty()
+}
+
+// BuildConfig populates a docker.BuildArguments struct for the sidecar image, following the same hierarchy
+// as the image of the main container.
+func (s *SidecarConfig) BuildConfig(rootDirectory string) *DockerBuildArgs {
+	image := Image{Build: s.Build}
+	return image.BuildConfig(rootDirectory)
+}
+
+// sidecarBuildArgs returns the docker build arguments of the sidecars built from local Dockerfiles keyed by sidecar name.
+func sidecarBuildArgs(sidecars map[string]*SidecarConfig, wsRoot string) map[string]*DockerBuildArgs {
+	args := make(map[string]*DockerBuildArgs)
+	for name, sidecar := range sidecars {
+		if sidecar == nil || !sidecar.BuildRequired() {
+			continue
+		}
+		args[name] = sidecar.BuildConfig(wsRoot)
+	}
+	return args
+}
+
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
 	CPU            *int                 `yaml:"cpu"`
@@ -512,6 +651,7 @@ type TaskConfig struct {
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]string    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
+	IAM            IAM                  `yaml:"iam"`
 }
 
 // ContainerPlatform returns the platform for the service.
@@ -525,6 +665,19 @@ func (t *TaskConfig) ContainerPlatform() string {
 	return platformString(t.Platform.OS(), t.Platform.Arch())
 }
 
+// ContainerPlatforms returns the platforms of a multi-architecture image for the service.
+// It returns nil if the image is built for a single platform.
+func (t *TaskConfig) ContainerPlatforms() []string {
+	if !t.Platform.IsMultiPlatform() {
+		return nil
+	}
+	platforms := make([]string, len(t.Platform.PlatformList))
+	for i, platform := range t.Platform.PlatformList {
+		platforms[i] = strings.ToLower(string(platform))
+	}
+	return platforms
+}
+
 // IsWindows returns whether or not the service is building with a Windows OS.
 func (t TaskConfig) IsWindows() bool {
 	return isWindowsPlatform(t.Platform)
@@ -537,12 +690,64 @@ func (t TaskConfig) IsARM() bool {
 
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
-	Topics []Topic `yaml:"topics"`
+	Topics   []Topic        `yaml:"topics"`
+	EventBus EventBusOrBool `yaml:"event_bus"`
+}
+
+// UsesEnvEventBus returns true if events are published to the event bus created by the environment.
+func (p PublishConfig) UsesEnvEventBus() bool {
+	return p.EventBus.Advanced.IsEmpty() && aws.BoolValue(p.EventBus.Enabled)
+}
+
+// EventBusOrBool contains custom unmarshaling logic for the `event_bus` field in the manifest.
+// If specified as true, an EventBridge event bus is created for the workload; if specified as a map,
+// the workload publishes to an existing event bus.
+type EventBusOrBool struct {
+	Advanced EventBus
+	Enabled  *bool
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (b *EventBusOrBool) IsEmpty() bool {
+	return b.Advanced.IsEmpty() && b.Enabled == nil
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows EventBus to be specified as a
+// bool or a struct alternately.
+func (b *EventBusOrBool) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&b.Advanced); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !b.Advanced.IsEmpty() {
+		// Unmarshaled successfully to b.Advanced, unset b.Enabled, and return.
+		b.Enabled = nil
+		return nil
+	}
+	if err := value.Decode(&b.Enabled); err != nil {
+		return errUnmarshalEventBusOpts
+	}
+	return nil
+}
+
+// EventBus represents an existing EventBridge event bus to publish events to.
+type EventBus struct {
+	Name *string `yaml:"name"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (b *EventBus) IsEmpty() bool {
+	return b.Name == nil
 }
 
 // Topic represents the configurable options for setting up a SNS Topic.
 type Topic struct {
 	Name *string `yaml:"name"`
+	FIFO *bool   `yaml:"fifo"`
 }
 
 // NetworkConfig represents options for network connection to AWS resources within a VPC.
@@ -670,16 +875,25 @@ func (hc *ContainerHealthCheck) ApplyIfNotSet(other *ContainerHealthCheck) {
 }
 
 // PlatformArgsOrString is a custom type which supports unmarshaling yaml which
-// can either be of type string or type PlatformArgs.
+// can either be of type string, a list of strings, or type PlatformArgs.
 type PlatformArgsOrString struct {
 	*PlatformString
 	PlatformArgs PlatformArgs
+	PlatformList []PlatformString // Platforms of a multi-architecture image. Tasks run on the first platform.
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the PlatformArgsOrString
 // struct, allowing it to perform more complex unmarshaling behavior.
 // This method implements the yaml.Unmarshaler (v3) interface.
 func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		if err := value.Decode(&p.PlatformList); err != nil {
+			return errUnmarshalPlatformOpts
+		}
+		p.PlatformString = nil
+		p.PlatformArgs = PlatformArgs{}
+		return nil
+	}
 	if err := value.Decode(&p.PlatformArgs); err != nil {
 		switch err.(type) {
 		case *yaml.TypeError:
@@ -701,7 +915,7 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 
 // OS returns the operating system family.
 func (p *PlatformArgsOrString) OS() string {
-	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
+	if p := p.runtimePlatformString(); p != "" {
 		args := strings.Split(p, "/")
 		return strings.ToLower(args[0])
 	}
@@ -710,13 +924,29 @@ func (p *PlatformArgsOrString) OS() string {
 
 // Arch returns the architecture of PlatformArgsOrString.
 func (p *PlatformArgsOrString) Arch() string {
-	if p := aws.StringValue((*string)(p.PlatformString)); p != "" {
+	if p := p.runtimePlatformString(); p != "" {
 		args := strings.Split(p, "/")
+		if len(args) < 2 {
+			return ""
+		}
 		return strings.ToLower(args[1])
 	}
 	return strings.ToLower(aws.StringValue(p.PlatformArgs.Arch))
 }
 
+// IsMultiPlatform returns true if the image is built for more than one platform.
+func (p *PlatformArgsOrString) IsMultiPlatform() bool {
+	return len(p.PlatformList) > 1
+}
+
+// runtimePlatformString returns the platform string that tasks run on, if the platform is specified as a string or a list.
+func (p *PlatformArgsOrString) runtimePlatformString() string {
+	if len(p.PlatformList) > 0 {
+		return string(p.PlatformList[0])
+	}
+	return aws.StringValue((*string)(p.PlatformString))
+}
+
 // PlatformArgs represents the specifics of a target OS.
 type PlatformArgs struct {
 	OSFamily *string `yaml:"osfamily,omitempty"`
@@ -733,7 +963,7 @@ func (p *PlatformArgs) String() string {
 
 // IsEmpty returns if the platform field is empty.
 func (p *PlatformArgsOrString) IsEmpty() bool {
-	return p.PlatformString == nil && p.PlatformArgs.isEmpty()
+	return p.PlatformString == nil && p.PlatformArgs.isEmpty() && len(p.PlatformList) == 0
 }
 
 func (p *PlatformArgs) isEmpty() bool {
@@ -790,6 +1020,78 @@ func requiresBuild(image Image) (bool, error) {
 	return false, nil
 }
 
+// DockerfileBuildSidecars returns the sorted names of the workload's sidecars whose images are built from local Dockerfiles.
+func DockerfileBuildSidecars(wl interface{}) []string {
+	mf, ok := wl.(interface {
+		SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs
+	})
+	if !ok {
+		return nil
+	}
+	var names []string
+	for name := range mf.SidecarBuildArgs("") {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThirdPartyImageRegistry returns the third-party registry that the workload's image is pushed to,
+// or nil if the image is pushed to the workload's ECR repository.
+func ThirdPartyImageRegistry(wl interface{}) *ImageRegistry {
+	mf, ok := wl.(interface {
+		ImageRegistry() ImageRegistry
+	})
+	if !ok {
+		return nil
+	}
+	registry := mf.ImageRegistry()
+	if registry.IsEmpty() {
+		return nil
+	}
+	return &registry
+}
+
+// ImageScanConfig returns the vulnerability scan configuration of the workload's image,
+// or nil if the image isn't scanned on push.
+func ImageScanConfig(wl interface{}) *ImageScan {
+	mf, ok := wl.(interface {
+		ImageScan() ImageScan
+	})
+	if !ok {
+		return nil
+	}
+	scan := mf.ImageScan()
+	if !aws.BoolValue(scan.OnPush) {
+		return nil
+	}
+	return &scan
+}
+
+// ImageSignatureKey returns the key that signs or verifies the workload's image,
+// or an empty string if the image isn't signed.
+func ImageSignatureKey(wl interface{}) string {
+	mf, ok := wl.(interface {
+		ContainerImage() Image
+	})
+	if !ok {
+		return ""
+	}
+	return aws.StringValue(mf.ContainerImage().Signature.Key)
+}
+
+// ImageLocation returns the location of the workload's image,
+// or an empty string if the image is built from a Dockerfile.
+func ImageLocation(wl interface{}) string {
+	mf, ok := wl.(interface {
+		ContainerImage() Image
+	})
+	if !ok {
+		return ""
+	}
+	return mf.ContainerImage().GetLocation()
+}
+
 func dockerfileBuildRequired(workloadType string, svc interface{}) (bool, error) {
 	type manifest interface {
 		BuildRequired() (bool, error)
@@ -337,6 +337,12 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlatformOpts,
 		},
+		"success with a list of platforms": {
+			inContent: []byte(`platform: [linux/arm64, linux/amd64]`),
+			wantedStruct: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/arm64", "linux/amd64"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -349,6 +355,7 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.PlatformString, p.Platform.PlatformString)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.OSFamily, p.Platform.PlatformArgs.OSFamily)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.Arch, p.Platform.PlatformArgs.Arch)
+				require.Equal(t, tc.wantedStruct.PlatformList, p.Platform.PlatformList)
 			}
 		})
 	}
@@ -360,6 +367,12 @@ func TestPlatformArgsOrString_OS(t *testing.T) {
 		in     *PlatformArgsOrString
 		wanted string
 	}{
+		"should return the os of the first platform in a list": {
+			in: &PlatformArgsOrString{
+				PlatformList: []PlatformString{"Linux/arm64", "linux/amd64"},
+			},
+			wanted: "linux",
+		},
 		"should return os when platform is of string format 'os/arch'": {
 			in: &PlatformArgsOrString{
 				PlatformString: &linux,
@@ -546,6 +559,50 @@ count: 1`),
 	}
 }
 
+func TestEventBusOrBool_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct EventBusOrBool
+		wantedError  error
+	}{
+		"with boolean": {
+			inContent: []byte(`event_bus: true`),
+
+			wantedStruct: EventBusOrBool{
+				Enabled: aws.Bool(true),
+			},
+		},
+		"with an existing event bus": {
+			inContent: []byte(`event_bus:
+  name: partner-bus`),
+
+			wantedStruct: EventBusOrBool{
+				Advanced: EventBus{
+					Name: aws.String("partner-bus"),
+				},
+			},
+		},
+		"invalid type": {
+			inContent: []byte(`event_bus: 10`),
+
+			wantedError: errUnmarshalEventBusOpts,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var p PublishConfig
+			err := yaml.Unmarshal(tc.inContent, &p)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, p.EventBus)
+			}
+		})
+	}
+}
+
 func TestBuildConfig(t *testing.T) {
 	mockWsRoot := "/root/dir"
 	testCases := map[string]struct {
@@ -652,6 +709,277 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestBackendService_SidecarBuildArgs(t *testing.T) {
+	mockWsRoot := "/root/dir"
+	svc := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			Sidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image: aws.String("public.ecr.aws/nginx/nginx:latest"),
+				},
+				"logshipper": {
+					Build: BuildArgsOrString{
+						BuildString: aws.String("sidecars/logshipper/Dockerfile"),
+					},
+				},
+				"authproxy": {
+					Build: BuildArgsOrString{
+						BuildArgs: DockerBuildArgs{
+							Context: aws.String("sidecars/authproxy"),
+							Target:  aws.String("prod"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := svc.SidecarBuildArgs(mockWsRoot)
+
+	require.Equal(t, map[string]*DockerBuildArgs{
+		"logshipper": {
+			Dockerfile: aws.String(filepath.Join(mockWsRoot, "sidecars", "logshipper", "Dockerfile")),
+			Context:    aws.String(filepath.Join(mockWsRoot, "sidecars", "logshipper")),
+		},
+		"authproxy": {
+			Dockerfile: aws.String(filepath.Join(mockWsRoot, "sidecars", "authproxy", "Dockerfile")),
+			Context:    aws.String(filepath.Join(mockWsRoot, "sidecars", "authproxy")),
+			Target:     aws.String("prod"),
+		},
+	}, got)
+	require.Equal(t, []string{"authproxy", "logshipper"}, DockerfileBuildSidecars(&svc))
+}
+
+func TestImage_PullCredentials(t *testing.T) {
+	testCases := map[string]struct {
+		in     Image
+		wanted *string
+	}{
+		"nil without credentials": {
+			in: Image{
+				Location: aws.String("nginx"),
+			},
+		},
+		"uses the registry credentials to pull images pushed to a third-party registry": {
+			in: Image{
+				Registry: ImageRegistry{
+					URI:         aws.String("ghcr.io/org/repo"),
+					Credentials: aws.String("registrySecretARN"),
+				},
+			},
+			wanted: aws.String("registrySecretARN"),
+		},
+		"prefers the image credentials": {
+			in: Image{
+				Credentials: aws.String("pullSecretARN"),
+				Registry: ImageRegistry{
+					URI:         aws.String("ghcr.io/org/repo"),
+					Credentials: aws.String("registrySecretARN"),
+				},
+			},
+			wanted: aws.String("pullSecretARN"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.PullCredentials())
+		})
+	}
+}
+
+func TestThirdPartyImageRegistry(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted *ImageRegistry
+	}{
+		"nil if the image is pushed to ECR": {
+			in: &WorkerService{},
+		},
+		"nil if the workload doesn't support third-party registries": {
+			in: &RequestDrivenWebService{},
+		},
+		"returns the registry of the image": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: ImageWithHealthcheck{
+						Image: Image{
+							Registry: ImageRegistry{
+								URI:         aws.String("ghcr.io/org/repo"),
+								Credentials: aws.String("mockSecretARN"),
+							},
+						},
+					},
+				},
+			},
+			wanted: &ImageRegistry{
+				URI:         aws.String("ghcr.io/org/repo"),
+				Credentials: aws.String("mockSecretARN"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ThirdPartyImageRegistry(tc.in))
+		})
+	}
+}
+
+func TestImageScanConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted *ImageScan
+	}{
+		"nil if the image isn't scanned": {
+			in: &BackendService{},
+		},
+		"nil if scan on push is disabled": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: ImageWithHealthcheck{
+						Image: Image{
+							Scan: ImageScan{
+								OnPush: aws.Bool(false),
+							},
+						},
+					},
+				},
+			},
+		},
+		"returns the scan configuration of the image": {
+			in: &RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Scan: ImageScan{
+								OnPush: aws.Bool(true),
+								FailOn: aws.String("HIGH"),
+							},
+						},
+					},
+				},
+			},
+			wanted: &ImageScan{
+				OnPush: aws.Bool(true),
+				FailOn: aws.String("HIGH"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ImageScanConfig(tc.in))
+		})
+	}
+}
+
+func TestImageSignatureKey(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted string
+	}{
+		"empty if the image isn't signed": {
+			in: &WorkerService{},
+		},
+		"returns the key of the signature": {
+			in: &ScheduledJob{
+				ScheduledJobConfig: ScheduledJobConfig{
+					ImageConfig: ImageWithHealthcheck{
+						Image: Image{
+							Signature: ImageSignature{
+								Key: aws.String("cosign.pub"),
+							},
+						},
+					},
+				},
+			},
+			wanted: "cosign.pub",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ImageSignatureKey(tc.in))
+		})
+	}
+}
+
+func TestImageLocation(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted string
+	}{
+		"empty if the image is built": {
+			in: &BackendService{},
+		},
+		"returns the location of the image": {
+			in: &RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Location: aws.String("public.ecr.aws/nginx/nginx@sha256:abc"),
+						},
+					},
+				},
+			},
+			wanted: "public.ecr.aws/nginx/nginx@sha256:abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ImageLocation(tc.in))
+		})
+	}
+}
+
+func TestWorkloadDependencies(t *testing.T) {
+	testCases := map[string]struct {
+		in     interface{}
+		wanted []string
+	}{
+		"no dependencies": {
+			in:     &BackendService{},
+			wanted: []string{},
+		},
+		"workloads listed under depends_on": {
+			in: &ScheduledJob{
+				Workload: Workload{
+					DependsOn: []string{"db-migrations", "api"},
+				},
+			},
+			wanted: []string{"api", "db-migrations"},
+		},
+		"services that publish the subscribed topics and events": {
+			in: &WorkerService{
+				Workload: Workload{
+					DependsOn: []string{"api"},
+				},
+				WorkerServiceConfig: WorkerServiceConfig{
+					Subscribe: SubscribeConfig{
+						Topics: []TopicSubscription{
+							{Name: aws.String("orders"), Service: aws.String("api")},
+							{Name: aws.String("payments"), Service: aws.String("billing")},
+						},
+						Events: []EventSubscription{
+							{Name: aws.String("shipped"), Service: aws.String("shipping")},
+							{Name: aws.String("partner"), Bus: aws.String("partners")},
+						},
+					},
+				},
+			},
+			wanted: []string{"api", "billing", "shipping"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, WorkloadDependencies(tc.in))
+		})
+	}
+}
+
 func TestLogging_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     Logging
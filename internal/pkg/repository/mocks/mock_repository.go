@@ -48,6 +48,40 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(args interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), args)
 }
 
+// BuildxPush mocks base method.
+func (m *MockContainerLoginBuildPusher) BuildxPush(args *dockerengine.BuildArguments) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildxPush", args)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildxPush indicates an expected call of BuildxPush.
+func (mr *MockContainerLoginBuildPusherMockRecorder) BuildxPush(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildxPush", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).BuildxPush), args)
+}
+
+// CopyImage mocks base method.
+func (m *MockContainerLoginBuildPusher) CopyImage(src string, dsts ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{src}
+	for _, a := range dsts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyImage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockContainerLoginBuildPusherMockRecorder) CopyImage(src interface{}, dsts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{src}, dsts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).CopyImage), varargs...)
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
@@ -135,6 +169,21 @@ func (mr *MockRegistryMockRecorder) Auth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockRegistry)(nil).Auth))
 }
 
+// ImageDigest mocks base method.
+func (m *MockRegistry) ImageDigest(repoName, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", repoName, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockRegistryMockRecorder) ImageDigest(repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockRegistry)(nil).ImageDigest), repoName, tag)
+}
+
 // RepositoryURI mocks base method.
 func (m *MockRegistry) RepositoryURI(name string) (string, error) {
 	m.ctrl.T.Helper()
@@ -149,3 +198,22 @@ func (mr *MockRegistryMockRecorder) RepositoryURI(name interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryURI", reflect.TypeOf((*MockRegistry)(nil).RepositoryURI), name)
 }
+
+// TagImage mocks base method.
+func (m *MockRegistry) TagImage(repoName, digest string, tags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{repoName, digest}
+	for _, a := range tags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagImage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagImage indicates an expected call of TagImage.
+func (mr *MockRegistryMockRecorder) TagImage(repoName, digest interface{}, tags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{repoName, digest}, tags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagImage", reflect.TypeOf((*MockRegistry)(nil).TagImage), varargs...)
+}
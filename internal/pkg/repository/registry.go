@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SecretGetter gets the value of a secret.
+type SecretGetter interface {
+	GetSecretValue(secretName string) (string, error)
+}
+
+// ThirdPartyRegistry is a Registry outside of ECR, such as Docker Hub, GHCR or a private Harbor.
+// It authenticates with the username and password stored in a Secrets Manager secret, which is the same format
+// that ECS expects for the credentials of private registries.
+type ThirdPartyRegistry struct {
+	uri         string
+	credentials string
+	secrets     SecretGetter
+}
+
+// NewThirdPartyRegistry returns a ThirdPartyRegistry that pushes images to the repository uri with the credentials
+// stored in the secret.
+func NewThirdPartyRegistry(uri, credentials string, secrets SecretGetter) *ThirdPartyRegistry {
+	return &ThirdPartyRegistry{
+		uri:         uri,
+		credentials: credentials,
+		secrets:     secrets,
+	}
+}
+
+// RepositoryURI returns the uri of the repository configured for the registry regardless of the name.
+func (r *ThirdPartyRegistry) RepositoryURI(_ string) (string, error) {
+	return r.uri, nil
+}
+
+// Auth returns the username and password stored in the credentials secret.
+func (r *ThirdPartyRegistry) Auth() (string, string, error) {
+	value, err := r.secrets.GetSecretValue(r.credentials)
+	if err != nil {
+		return "", "", err
+	}
+	var creds struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := json.Unmarshal([]byte(value), &creds); err != nil {
+		return "", "", fmt.Errorf("unmarshal credentials of registry %s: %w", r.uri, err)
+	}
+	if creds.Username == "" || creds.Password == "" {
+		return "", "", fmt.Errorf(`secret %s must contain a "username" and a "password"`, r.credentials)
+	}
+	return creds.Username, creds.Password, nil
+}
+
+// ImageDigest always returns an empty string: third-party registries opt out of skipping the build of images
+// that were already built from the same inputs, since looking up a tag requires a registry-specific API.
+// As a result, images pushed to third-party registries are rebuilt on every deployment.
+func (r *ThirdPartyRegistry) ImageDigest(_, _ string) (string, error) {
+	return "", nil
+}
+
+// TagImage returns an error since third-party registries can't tag images without pulling them.
+func (r *ThirdPartyRegistry) TagImage(_, digest string, _ ...string) error {
+	return fmt.Errorf("tag image %s in registry %s: not supported for third-party registries", digest, r.uri)
+}
@@ -0,0 +1,106 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/repository/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThirdPartyRegistry_Auth(t *testing.T) {
+	const (
+		mockURI    = "ghcr.io/org/repo"
+		mockSecret = "arn:aws:secretsmanager:us-west-2:123456789012:secret:ghcr-creds"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockSecretGetter)
+
+		wantedUsername string
+		wantedPassword string
+		wantedError    error
+	}{
+		"error if fail to get the secret": {
+			setupMocks: func(m *mocks.MockSecretGetter) {
+				m.EXPECT().GetSecretValue(mockSecret).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if the secret isn't json": {
+			setupMocks: func(m *mocks.MockSecretGetter) {
+				m.EXPECT().GetSecretValue(mockSecret).Return("H0NK", nil)
+			},
+			wantedError: errors.New("unmarshal credentials of registry ghcr.io/org/repo: invalid character 'H' looking for beginning of value"),
+		},
+		"error if the secret doesn't have a password": {
+			setupMocks: func(m *mocks.MockSecretGetter) {
+				m.EXPECT().GetSecretValue(mockSecret).Return(`{"username":"goose"}`, nil)
+			},
+			wantedError: errors.New(`secret arn:aws:secretsmanager:us-west-2:123456789012:secret:ghcr-creds must contain a "username" and a "password"`),
+		},
+		"success": {
+			setupMocks: func(m *mocks.MockSecretGetter) {
+				m.EXPECT().GetSecretValue(mockSecret).Return(`{"username":"goose","password":"H0NK"}`, nil)
+			},
+			wantedUsername: "goose",
+			wantedPassword: "H0NK",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSecrets := mocks.NewMockSecretGetter(ctrl)
+			tc.setupMocks(mockSecrets)
+			registry := NewThirdPartyRegistry(mockURI, mockSecret, mockSecrets)
+
+			// WHEN
+			username, password, err := registry.Auth()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedUsername, username)
+			require.Equal(t, tc.wantedPassword, password)
+		})
+	}
+}
+
+func TestRepository_BuildAndPush_ThirdPartyRegistry(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSecrets := mocks.NewMockSecretGetter(ctrl)
+	mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+	args := &dockerengine.BuildArguments{
+		Dockerfile: "path/to/dockerfile",
+		Tags:       []string{"v1"},
+	}
+	mockSecrets.EXPECT().GetSecretValue("mockSecret").Return(`{"username":"goose","password":"H0NK"}`, nil)
+	mockDocker.EXPECT().Build(args).Return(nil)
+	// The ECR credential helper is ignored for third-party registries.
+	mockDocker.EXPECT().IsEcrCredentialHelperEnabled(gomock.Any()).Return(true).AnyTimes()
+	mockDocker.EXPECT().Login("ghcr.io/org/repo", "goose", "H0NK").Return(nil)
+	mockDocker.EXPECT().Push("ghcr.io/org/repo", "v1").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil)
+
+	repo, err := New("my-repo", NewThirdPartyRegistry("ghcr.io/org/repo", "mockSecret", mockSecrets))
+	require.NoError(t, err)
+
+	// WHEN
+	digest, err := repo.BuildAndPush(mockDocker, args)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", digest)
+	require.Equal(t, "ghcr.io/org/repo", repo.URI())
+}
@@ -13,8 +13,10 @@ import (
 // ContainerLoginBuildPusher provides support for logging in to repositories, building images and pushing images to repositories.
 type ContainerLoginBuildPusher interface {
 	Build(args *dockerengine.BuildArguments) error
+	BuildxPush(args *dockerengine.BuildArguments) (digest string, err error)
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
+	CopyImage(src string, dsts ...string) error
 	IsEcrCredentialHelperEnabled(uri string) bool
 }
 
@@ -22,6 +24,8 @@ type ContainerLoginBuildPusher interface {
 type Registry interface {
 	RepositoryURI(name string) (string, error)
 	Auth() (string, string, error)
+	ImageDigest(repoName, tag string) (string, error) // Empty if the image isn't found or the registry can't look up tags.
+	TagImage(repoName, digest string, tags ...string) error
 }
 
 // Repository builds and pushes images to a repository.
@@ -47,33 +51,96 @@ func New(name string, registry Registry) (*Repository, error) {
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// If the image is built for multiple platforms, it returns the digest of the manifest list.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (digest string, err error) {
 	if args.URI == "" {
 		args.URI = r.uri
 	}
+	if len(args.Platforms) > 1 {
+		// Multi-architecture images are pushed while they are built, so log in beforehand.
+		if err := r.login(docker, args.URI); err != nil {
+			return "", err
+		}
+		digest, err = docker.BuildxPush(args)
+		if err != nil {
+			return "", fmt.Errorf("build and push Dockerfile at %s to repo %s: %w", args.Dockerfile, r.name, err)
+		}
+		return digest, nil
+	}
 	if err := docker.Build(args); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
+	}
+	digest, err = docker.Push(args.URI, args.Tags...)
+	if err != nil {
+		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
+	}
+	return digest, nil
+}
 
-	// Perform docker login only if credStore attribute value != ecr-login
-	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
-		username, password, err := r.registry.Auth()
-		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
-		}
+// Login logs in to the repository so that its images can be read by other commands, such as when they're copied.
+func (r *Repository) Login(docker ContainerLoginBuildPusher) error {
+	return r.login(docker, r.uri)
+}
 
-		if err := docker.Login(args.URI, username, password); err != nil {
-			return "", fmt.Errorf("login to repo %s: %w", r.name, err)
-		}
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
+	// Perform docker login only if credStore attribute value != ecr-login
+	// The ECR credential helper doesn't authenticate to third-party registries.
+	if _, thirdParty := r.registry.(*ThirdPartyRegistry); !thirdParty && docker.IsEcrCredentialHelperEnabled(uri) {
+		return nil
 	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
 
-	digest, err = docker.Push(args.URI, args.Tags...)
+// Digest returns the digest of the image tagged with tag in the repository.
+// If no image in the repository has the tag, it returns an empty string.
+func (r *Repository) Digest(tag string) (string, error) {
+	digest, err := r.registry.ImageDigest(r.name, tag)
 	if err != nil {
-		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
+		return "", fmt.Errorf("get digest of image with tag %s in repo %s: %w", tag, r.name, err)
 	}
 	return digest, nil
 }
 
+// Tag adds the tags that BuildAndPush pushes for the build arguments to the image with the digest in the repository:
+// the tag of the image URI, or "latest" if it doesn't have one, followed by the additional tags.
+func (r *Repository) Tag(digest string, args *dockerengine.BuildArguments) error {
+	_, latest := dockerengine.SplitTag(args.URI)
+	if err := r.registry.TagImage(r.name, digest, append([]string{latest}, args.Tags...)...); err != nil {
+		return fmt.Errorf("tag image %s in repo %s: %w", digest, r.name, err)
+	}
+	return nil
+}
+
+// Copy copies the image src, referenced by digest in another repository, to the repository with the tags that BuildAndPush
+// pushes for the build arguments. The image keeps its digest, so signatures and scan findings of src apply to the copy.
+func (r *Repository) Copy(docker ContainerLoginBuildPusher, src string, args *dockerengine.BuildArguments) error {
+	if args.URI == "" {
+		args.URI = r.uri
+	}
+	if err := r.login(docker, args.URI); err != nil {
+		return err
+	}
+	uri, latest := dockerengine.SplitTag(args.URI)
+	var images []string
+	for _, tag := range append([]string{latest}, args.Tags...) {
+		images = append(images, fmt.Sprintf("%s:%s", uri, tag))
+	}
+	if err := docker.CopyImage(src, images...); err != nil {
+		return fmt.Errorf("copy image %s to repo %s: %w", src, r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() string {
 	return r.uri
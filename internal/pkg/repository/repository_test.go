@@ -33,6 +33,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 	testCases := map[string]struct {
 		inRepoName       string
 		inDockerfilePath string
+		inPlatforms      []string
 		inMockDocker     func(m *mocks.MockContainerLoginBuildPusher)
 
 		mockRegistry func(m *mocks.MockRegistry)
@@ -95,6 +96,36 @@ func TestRepository_BuildAndPush(t *testing.T) {
 			},
 			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
 		},
+		"failed to build and push a multi-platform image": {
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(true)
+				m.EXPECT().BuildxPush(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: fmt.Errorf("build and push Dockerfile at %s to repo my-repo: some error", inDockerfilePath),
+		},
+		"success with a multi-platform image": {
+			inPlatforms: []string{"linux/amd64", "linux/arm64"},
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil).Times(1)
+			},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(false)
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil).Times(1)
+				m.EXPECT().BuildxPush(&dockerengine.BuildArguments{
+					URI:        mockRepoURI,
+					Dockerfile: inDockerfilePath,
+					Context:    filepath.Dir(inDockerfilePath),
+					Tags:       []string{mockTag1, mockTag2, mockTag3},
+					Platforms:  []string{"linux/amd64", "linux/arm64"},
+				}).Return("sha256:a7f5d2b8e9c14f8e4a3c5b6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f", nil)
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:a7f5d2b8e9c14f8e4a3c5b6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f",
+		},
 		"success": {
 			mockRegistry: func(m *mocks.MockRegistry) {
 				m.EXPECT().Auth().Return("my-name", "my-pwd", nil).Times(1)
@@ -134,6 +165,7 @@ func TestRepository_BuildAndPush(t *testing.T) {
 				Dockerfile: inDockerfilePath,
 				Context:    filepath.Dir(inDockerfilePath),
 				Tags:       []string{mockTag1, mockTag2, mockTag3},
+				Platforms:  tc.inPlatforms,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
@@ -144,3 +176,171 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_Digest(t *testing.T) {
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedDigest string
+		wantedError  error
+	}{
+		"wrap error from the registry": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().ImageDigest("my-repo", "content-hash").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get digest of image with tag content-hash in repo my-repo: some error"),
+		},
+		"return the digest of the tagged image": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().ImageDigest("my-repo", "content-hash").Return("sha256:digest", nil)
+			},
+			wantedDigest: "sha256:digest",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+			}
+
+			digest, err := repo.Digest("content-hash")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
+
+func TestRepository_Tag(t *testing.T) {
+	testCases := map[string]struct {
+		inURI        string
+		mockRegistry func(m *mocks.MockRegistry)
+
+		wantedError error
+	}{
+		"wrap error from the registry": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().TagImage("my-repo", "sha256:digest", "latest", "v1").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("tag image sha256:digest in repo my-repo: some error"),
+		},
+		"add the latest tag and the input tags": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().TagImage("my-repo", "sha256:digest", "latest", "v1").Return(nil)
+			},
+		},
+		"add the tag of the uri instead of latest": {
+			inURI: "mockRepoURI:logshipper-latest",
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().TagImage("my-repo", "sha256:digest", "logshipper-latest", "v1").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			tc.mockRegistry(mockRegistry)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+			}
+
+			err := repo.Tag("sha256:digest", &dockerengine.BuildArguments{
+				URI:  tc.inURI,
+				Tags: []string{"v1"},
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRepository_Copy(t *testing.T) {
+	const mockSrc = "mockSrcURI@sha256:digest"
+	testCases := map[string]struct {
+		inURI        string
+		mockRegistry func(m *mocks.MockRegistry)
+		mockDocker   func(m *mocks.MockContainerLoginBuildPusher)
+
+		wantedError error
+	}{
+		"wrap error from login": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(false)
+				m.EXPECT().Login("mockRepoURI", "my-name", "my-pwd").Return(errors.New("some error"))
+				m.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("login to repo my-repo: some error"),
+		},
+		"wrap error from the copy": {
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(true)
+				m.EXPECT().CopyImage(mockSrc, "mockRepoURI:latest", "mockRepoURI:v1").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("copy image mockSrcURI@sha256:digest to repo my-repo: some error"),
+		},
+		"copy with the latest tag and the input tags": {
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(true)
+				m.EXPECT().CopyImage(mockSrc, "mockRepoURI:latest", "mockRepoURI:v1").Return(nil)
+			},
+		},
+		"copy with the tag of the uri instead of latest": {
+			inURI:        "mockRepoURI:logshipper-latest",
+			mockRegistry: func(m *mocks.MockRegistry) {},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI:logshipper-latest").Return(true)
+				m.EXPECT().CopyImage(mockSrc, "mockRepoURI:logshipper-latest", "mockRepoURI:v1").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			tc.mockRegistry(mockRegistry)
+			tc.mockDocker(mockDocker)
+			repo := &Repository{
+				name:     "my-repo",
+				uri:      "mockRepoURI",
+				registry: mockRegistry,
+			}
+
+			err := repo.Copy(mockDocker, mockSrc, &dockerengine.BuildArguments{
+				URI:  tc.inURI,
+				Tags: []string{"v1"},
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// StatusPoller is the interface to retrieve the latest status snapshot of a resource.
+type StatusPoller interface {
+	Poll() (StatusSnapshot, error)
+}
+
+// StatusSnapshot is a point-in-time view of the status of a resource like a pipeline or a service.
+type StatusSnapshot struct {
+	Human       string   // Human readable representation of the status.
+	JSON        string   // JSON representation of the status.
+	Transitions []string // Notable changes since the previous snapshot.
+	Settled     bool     // True if the resource reached a terminal state and no more snapshots are expected.
+	Failed      bool     // True if the terminal state that the resource reached is a failure.
+	Reason      string   // Explanation of why the resource is considered failed.
+}
+
+// StatusStreamer is a Streamer for status snapshots until the resource reaches a terminal state.
+type StatusStreamer struct {
+	client StatusPoller
+	clock  clock
+	rand   func(n int) int
+
+	subscribers   []chan StatusSnapshot
+	once          sync.Once
+	done          chan struct{}
+	isDone        bool
+	last          StatusSnapshot
+	eventsToFlush []StatusSnapshot
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewStatusStreamer creates a new StatusStreamer that streams status snapshots until the resource settles.
+func NewStatusStreamer(poller StatusPoller) *StatusStreamer {
+	return &StatusStreamer{
+		client: poller,
+		clock:  realClock{},
+		rand:   rand.Intn,
+		done:   make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive status snapshots from the StatusStreamer.
+func (s *StatusStreamer) Subscribe() <-chan StatusSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan StatusSnapshot)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the latest status snapshot.
+// If the snapshot is settled, the streamer is marked as done.
+// If an error occurs while polling, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *StatusStreamer) Fetch() (next time.Time, err error) {
+	snapshot, err := s.client.Poll()
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("poll status: %w", err)
+	}
+	s.retries = 0
+	s.last = snapshot
+	s.eventsToFlush = append(s.eventsToFlush, snapshot)
+	if snapshot.Settled {
+		s.once.Do(func() {
+			close(s.done)
+		})
+	}
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new snapshots to the streamer's subscribers.
+func (s *StatusStreamer) Notify() {
+	s.mu.Lock()
+	var subs []chan StatusSnapshot
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *StatusStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when the resource reached a terminal state.
+func (s *StatusStreamer) Done() <-chan struct{} {
+	return s.done
+}
+
+// Last returns the latest snapshot fetched by the streamer.
+func (s *StatusStreamer) Last() StatusSnapshot {
+	return s.last
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockStatusPoller struct {
+	out StatusSnapshot
+	err error
+}
+
+func (m mockStatusPoller) Poll() (StatusSnapshot, error) {
+	return m.out, m.err
+}
+
+func TestStatusStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if status streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &StatusStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished status streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &StatusStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestStatusStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on poll failure", func(t *testing.T) {
+		// GIVEN
+		streamer := NewStatusStreamer(mockStatusPoller{
+			err: errors.New("some error"),
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "poll status: some error")
+	})
+	t.Run("stores snapshots and keeps streaming while the resource is not settled", func(t *testing.T) {
+		// GIVEN
+		streamer := NewStatusStreamer(mockStatusPoller{
+			out: StatusSnapshot{
+				Human: "in progress",
+			},
+		})
+		streamer.clock = fakeClock{fakeNow: time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)}
+		streamer.rand = func(n int) int { return n }
+
+		// WHEN
+		next, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2020, time.November, 23, 18, 0, 4, 0, time.UTC), next)
+		require.Equal(t, []StatusSnapshot{{Human: "in progress"}}, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "streamer should not be done")
+		default:
+		}
+	})
+	t.Run("closes the Done channel once the resource settled", func(t *testing.T) {
+		// GIVEN
+		streamer := NewStatusStreamer(mockStatusPoller{
+			out: StatusSnapshot{
+				Human:   "failed",
+				Settled: true,
+				Failed:  true,
+				Reason:  "stage Build failed",
+			},
+		})
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		<-streamer.Done()
+		require.True(t, streamer.Last().Failed)
+		require.Equal(t, "stage Build failed", streamer.Last().Reason)
+	})
+}
+
+func TestStatusStreamer_Notify(t *testing.T) {
+	// GIVEN
+	sub := make(chan StatusSnapshot, 2)
+	streamer := &StatusStreamer{
+		subscribers: []chan StatusSnapshot{sub},
+		eventsToFlush: []StatusSnapshot{
+			{Human: "first"},
+			{Human: "second"},
+		},
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub)
+
+	// THEN
+	var got []string
+	for ev := range sub {
+		got = append(got, ev.Human)
+	}
+	require.Equal(t, []string{"first", "second"}, got)
+	require.Nil(t, streamer.eventsToFlush, "expected events to be flushed")
+}
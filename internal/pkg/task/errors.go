@@ -6,6 +6,7 @@ package task
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -14,6 +15,8 @@ var (
 	errVPCGetterNil     = errors.New("vpc getter is not set")
 	errClusterGetterNil = errors.New("cluster getter is not set")
 	errStarterNil       = errors.New("starter is not set")
+
+	errWorkloadDescriberNil = errors.New("workload describer is not set")
 )
 
 type errRunTask struct {
@@ -32,3 +35,12 @@ type errGetDefaultCluster struct {
 func (e *errGetDefaultCluster) Error() string {
 	return fmt.Sprintf("get default cluster: %v", e.parentErr)
 }
+
+// ErrTimeout is returned when tasks did not stop within the timeout and were stopped.
+type ErrTimeout struct {
+	Timeout time.Duration
+}
+
+func (e *ErrTimeout) Error() string {
+	return fmt.Sprintf("tasks did not complete within %s and were stopped", e.Timeout)
+}
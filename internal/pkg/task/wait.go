@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+const (
+	waitPollInterval = 6 * time.Second
+
+	fmtTimeoutStopReason = "Stopped by Copilot because the task did not complete within %s"
+)
+
+// StoppedTasksDescriber wraps the method of describing tasks.
+type StoppedTasksDescriber interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
+}
+
+// Stopper wraps the method of stopping tasks.
+type Stopper interface {
+	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
+}
+
+// Result is the outcome of a stopped task.
+type Result struct {
+	TaskARN       string
+	StoppedReason string
+	Containers    []ContainerResult
+}
+
+// ContainerResult is the outcome of a container in a stopped task.
+type ContainerResult struct {
+	Name     string
+	ExitCode *int64 // Nil if the container never ran.
+	Reason   string
+}
+
+// ExitCode returns the exit code of the main container of the task, which is named after the task group.
+// If the main container never ran, returns 1 so that the task is still reported as failed.
+// If the task has no main container, returns the first non-zero exit code of the containers that ran instead.
+func (r *Result) ExitCode(mainContainer string) int {
+	for _, c := range r.Containers {
+		if c.Name != mainContainer {
+			continue
+		}
+		if c.ExitCode == nil {
+			return 1
+		}
+		return int(aws.Int64Value(c.ExitCode))
+	}
+	ran := false
+	for _, c := range r.Containers {
+		if c.ExitCode == nil {
+			// A sidecar that never ran doesn't fail the task on its own.
+			continue
+		}
+		ran = true
+		if code := int(aws.Int64Value(c.ExitCode)); code != 0 {
+			return code
+		}
+	}
+	if !ran {
+		return 1
+	}
+	return 0
+}
+
+// Waiter waits for tasks to stop.
+type Waiter struct {
+	Describer StoppedTasksDescriber
+	Stopper   Stopper
+
+	// Optional. If set, tasks still running after Timeout are stopped.
+	Timeout time.Duration
+
+	// Replaced in tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewWaiter returns a Waiter that stops tasks running longer than timeout.
+// If timeout is zero, tasks are waited on indefinitely.
+func NewWaiter(describer StoppedTasksDescriber, stopper Stopper, timeout time.Duration) *Waiter {
+	return &Waiter{
+		Describer: describer,
+		Stopper:   stopper,
+		Timeout:   timeout,
+		now:       time.Now,
+		sleep:     time.Sleep,
+	}
+}
+
+// Wait polls the tasks until all of them have stopped and returns their results.
+// If the timeout elapses first, the running tasks are stopped and a *ErrTimeout is returned along with the results.
+func (w *Waiter) Wait(tasks []*Task) ([]*Result, error) {
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	// NOTE: all tasks are run in the same cluster.
+	cluster := tasks[0].ClusterARN
+
+	deadline := w.now().Add(w.Timeout)
+	timedOut := false
+	for {
+		described, err := w.Describer.DescribeTasks(cluster, taskARNs)
+		if err != nil {
+			return nil, fmt.Errorf("describe tasks: %w", err)
+		}
+		var running []string
+		for _, t := range described {
+			if aws.StringValue(t.LastStatus) != ecs.DesiredStatusStopped {
+				running = append(running, aws.StringValue(t.TaskArn))
+			}
+		}
+		if len(running) == 0 {
+			results := resultsFromTasks(described)
+			if timedOut {
+				return results, &ErrTimeout{Timeout: w.Timeout}
+			}
+			return results, nil
+		}
+		if w.Timeout > 0 && !timedOut && !w.now().Before(deadline) {
+			if err := w.Stopper.StopTasks(running, ecs.WithStopTaskCluster(cluster),
+				ecs.WithStopTaskReason(fmt.Sprintf(fmtTimeoutStopReason, w.Timeout))); err != nil {
+				return nil, fmt.Errorf("stop tasks after timeout: %w", err)
+			}
+			timedOut = true
+		}
+		w.sleep(waitPollInterval)
+	}
+}
+
+func resultsFromTasks(tasks []*ecs.Task) []*Result {
+	results := make([]*Result, len(tasks))
+	for i, t := range tasks {
+		result := &Result{
+			TaskARN:       aws.StringValue(t.TaskArn),
+			StoppedReason: aws.StringValue(t.StoppedReason),
+		}
+		for _, c := range t.Containers {
+			result.Containers = append(result.Containers, ContainerResult{
+				Name:     aws.StringValue(c.Name),
+				ExitCode: c.ExitCode,
+				Reason:   aws.StringValue(c.Reason),
+			})
+		}
+		results[i] = result
+	}
+	return results
+}
@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaiter_Wait(t *testing.T) {
+	const (
+		cluster = "cluster-1"
+		taskARN = "arn:aws:ecs:us-west-2:123456789:task/cluster-1/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	inTasks := []*Task{
+		{
+			TaskARN:    taskARN,
+			ClusterARN: cluster,
+		},
+	}
+	runningTask := &ecs.Task{
+		TaskArn:    aws.String(taskARN),
+		LastStatus: aws.String("RUNNING"),
+	}
+	stoppedTask := &ecs.Task{
+		TaskArn:       aws.String(taskARN),
+		LastStatus:    aws.String("STOPPED"),
+		StoppedReason: aws.String("Essential container in task exited"),
+		Containers: []*awsecs.Container{
+			{
+				Name:     aws.String("db-migrate"),
+				ExitCode: aws.Int64(3),
+			},
+		},
+	}
+	wantedResults := []*Result{
+		{
+			TaskARN:       taskARN,
+			StoppedReason: "Essential container in task exited",
+			Containers: []ContainerResult{
+				{
+					Name:     "db-migrate",
+					ExitCode: aws.Int64(3),
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		timeout    time.Duration
+		setupMocks func(describer *mocks.MockStoppedTasksDescriber, stopper *mocks.MockStopper)
+
+		wantedResults []*Result
+		wantedError   error
+	}{
+		"wraps the error from describing tasks": {
+			setupMocks: func(describer *mocks.MockStoppedTasksDescriber, _ *mocks.MockStopper) {
+				describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tasks: some error"),
+		},
+		"polls until all tasks are stopped": {
+			setupMocks: func(describer *mocks.MockStoppedTasksDescriber, _ *mocks.MockStopper) {
+				gomock.InOrder(
+					describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return([]*ecs.Task{runningTask}, nil),
+					describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return([]*ecs.Task{stoppedTask}, nil),
+				)
+			},
+			wantedResults: wantedResults,
+		},
+		"stops tasks that exceed the timeout": {
+			timeout: time.Minute,
+			setupMocks: func(describer *mocks.MockStoppedTasksDescriber, stopper *mocks.MockStopper) {
+				gomock.InOrder(
+					describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return([]*ecs.Task{runningTask}, nil),
+					describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return([]*ecs.Task{runningTask}, nil),
+					stopper.EXPECT().StopTasks([]string{taskARN}, gomock.Any(), gomock.Any()).Return(nil),
+					describer.EXPECT().DescribeTasks(cluster, []string{taskARN}).Return([]*ecs.Task{stoppedTask}, nil),
+				)
+			},
+			wantedResults: wantedResults,
+			wantedError:   &ErrTimeout{Timeout: time.Minute},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			describer := mocks.NewMockStoppedTasksDescriber(ctrl)
+			stopper := mocks.NewMockStopper(ctrl)
+			tc.setupMocks(describer, stopper)
+
+			now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+			waiter := &Waiter{
+				Describer: describer,
+				Stopper:   stopper,
+				Timeout:   tc.timeout,
+				now: func() time.Time {
+					return now
+				},
+				sleep: func(d time.Duration) {
+					now = now.Add(d * 10)
+				},
+			}
+
+			// WHEN
+			results, err := waiter.Wait(inTasks)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedResults, results)
+		})
+	}
+}
+
+func TestResult_ExitCode(t *testing.T) {
+	testCases := map[string]struct {
+		in     Result
+		wanted int
+	}{
+		"exit code of the main container": {
+			in: Result{Containers: []ContainerResult{
+				{Name: "firelens_log_router", ExitCode: aws.Int64(137)},
+				{Name: "db-migrate", ExitCode: aws.Int64(3)},
+			}},
+			wanted: 3,
+		},
+		"zero if the main container succeeded and a sidecar never ran": {
+			in: Result{Containers: []ContainerResult{
+				{Name: "db-migrate", ExitCode: aws.Int64(0)},
+				{Name: "firelens_log_router", Reason: "Essential container in task exited"},
+			}},
+			wanted: 0,
+		},
+		"one if the main container never ran": {
+			in:     Result{Containers: []ContainerResult{{Name: "db-migrate", Reason: "CannotPullContainerError"}}},
+			wanted: 1,
+		},
+		"zero if there is no main container and all containers succeeded": {
+			in:     Result{Containers: []ContainerResult{{ExitCode: aws.Int64(0)}, {ExitCode: aws.Int64(0)}}},
+			wanted: 0,
+		},
+		"first non-zero exit code if there is no main container": {
+			in:     Result{Containers: []ContainerResult{{ExitCode: aws.Int64(0)}, {Reason: "CannotPullContainerError"}, {ExitCode: aws.Int64(137)}}},
+			wanted: 137,
+		},
+		"one if there is no main container and no container ran": {
+			in:     Result{Containers: []ContainerResult{{Reason: "CannotPullContainerError"}}},
+			wanted: 1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.ExitCode("db-migrate"))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+// WorkloadDescriber wraps the methods to retrieve the ECS configuration of a deployed workload.
+type WorkloadDescriber interface {
+	ClusterARN(app, env string) (string, error)
+	TaskDefinition(app, env, wkld string) (*ecs.TaskDefinition, error)
+	NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error)
+	NetworkConfigurationForJob(app, env, job string) (*ecs.NetworkConfiguration, error)
+}
+
+// WorkloadRunner can run an Amazon ECS task with the latest task definition of a deployed service or job.
+// The task inherits the workload's roles, secrets, sidecars, storage and network configuration.
+type WorkloadRunner struct {
+	// Count of the tasks to be launched.
+	Count int
+
+	// App, Env and Workload whose task definition is used to launch the tasks.
+	App      string
+	Env      string
+	Workload string
+	// IsJob is true if the workload is a job, otherwise it's a service.
+	IsJob bool
+
+	// Command overrides the command of the workload's main container. Optional.
+	Command []string
+
+	// Interfaces to interact with dependencies. Must not be nil.
+	Describer WorkloadDescriber
+	Starter   Runner
+}
+
+// Run runs tasks using the task definition of the workload, and returns the tasks.
+func (r *WorkloadRunner) Run() ([]*Task, error) {
+	if r.Describer == nil {
+		return nil, errWorkloadDescriberNil
+	}
+	if r.Starter == nil {
+		return nil, errStarterNil
+	}
+
+	cluster, err := r.Describer.ClusterARN(r.App, r.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get cluster for environment %s: %w", r.Env, err)
+	}
+	taskDef, err := r.Describer.TaskDefinition(r.App, r.Env, r.Workload)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition of %s: %w", r.Workload, err)
+	}
+	network, err := r.networkConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("get network configuration of %s: %w", r.Workload, err)
+	}
+
+	platformVersion := "LATEST"
+	enableExec := true
+	if platform := taskDef.Platform(); platform != nil && IsValidWindowsOS(platform.OperatingSystem) {
+		platformVersion = "1.0.0"
+		enableExec = false
+	}
+
+	var overrides []*ecs.ContainerOverride
+	if len(r.Command) > 0 {
+		// The main container of a Copilot workload is named after the workload.
+		if _, err := taskDef.Command(r.Workload); err != nil {
+			return nil, fmt.Errorf("override command of %s: %w", r.Workload, err)
+		}
+		overrides = append(overrides, &ecs.ContainerOverride{
+			Name:    r.Workload,
+			Command: r.Command,
+		})
+	}
+
+	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
+		Cluster:            cluster,
+		Count:              r.Count,
+		Subnets:            network.Subnets,
+		SecurityGroups:     network.SecurityGroups,
+		AssignPublicIP:     network.AssignPublicIp,
+		TaskFamilyName:     aws.StringValue(taskDef.TaskDefinitionArn),
+		StartedBy:          startedBy,
+		PlatformVersion:    platformVersion,
+		EnableExec:         enableExec,
+		ContainerOverrides: overrides,
+	})
+	if err != nil {
+		return nil, &errRunTask{
+			groupName: r.Workload,
+			parentErr: err,
+		}
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+func (r *WorkloadRunner) networkConfiguration() (*ecs.NetworkConfiguration, error) {
+	if r.IsJob {
+		return r.Describer.NetworkConfigurationForJob(r.App, r.Env, r.Workload)
+	}
+	return r.Describer.NetworkConfiguration(r.App, r.Env, r.Workload)
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadRunner_Run(t *testing.T) {
+	const (
+		inApp      = "my-app"
+		inEnv      = "test"
+		inWkld     = "api"
+		taskDefARN = "arn:aws:ecs:us-west-2:123456789:task-definition/my-app-test-api:3"
+	)
+	taskDef := &ecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(taskDefARN),
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			{
+				Name: aws.String("api"),
+			},
+			{
+				Name: aws.String("nginx"),
+			},
+		},
+	}
+	network := &ecs.NetworkConfiguration{
+		AssignPublicIp: "DISABLED",
+		Subnets:        []string{"subnet-1", "subnet-2"},
+		SecurityGroups: []string{"sg-1"},
+	}
+
+	testCases := map[string]struct {
+		isJob      bool
+		command    []string
+		setupMocks func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner)
+
+		wantedError error
+		wantedTasks []*Task
+	}{
+		"error getting the cluster": {
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get cluster for environment test: some error"),
+		},
+		"error getting the task definition": {
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition of api: some error"),
+		},
+		"error getting the network configuration of a job": {
+			isJob: true,
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(taskDef, nil)
+				d.EXPECT().NetworkConfigurationForJob(inApp, inEnv, inWkld).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get network configuration of api: some error"),
+		},
+		"error if the main container does not exist": {
+			command: []string{"rake", "db:migrate"},
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String(taskDefARN),
+				}, nil)
+				d.EXPECT().NetworkConfiguration(inApp, inEnv, inWkld).Return(network, nil)
+			},
+			wantedError: errors.New("override command of api: container api not found"),
+		},
+		"error running the tasks": {
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(taskDef, nil)
+				d.EXPECT().NetworkConfiguration(inApp, inEnv, inWkld).Return(network, nil)
+				r.EXPECT().RunTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("run task api: some error"),
+		},
+		"run tasks with the workload's task definition and a command override": {
+			command: []string{"rake", "db:migrate"},
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(taskDef, nil)
+				d.EXPECT().NetworkConfiguration(inApp, inEnv, inWkld).Return(network, nil)
+				r.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-1", "subnet-2"},
+					SecurityGroups:  []string{"sg-1"},
+					AssignPublicIP:  "DISABLED",
+					TaskFamilyName:  taskDefARN,
+					StartedBy:       startedBy,
+					PlatformVersion: "LATEST",
+					EnableExec:      true,
+					ContainerOverrides: []*ecs.ContainerOverride{
+						{
+							Name:    "api",
+							Command: []string{"rake", "db:migrate"},
+						},
+					},
+				}).Return([]*ecs.Task{
+					{
+						TaskArn:    aws.String("task-1"),
+						ClusterArn: aws.String("cluster-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN:    "task-1",
+					ClusterARN: "cluster-1",
+				},
+			},
+		},
+		"run tasks of a windows job without overrides": {
+			isJob: true,
+			setupMocks: func(d *mocks.MockWorkloadDescriber, r *mocks.MockRunner) {
+				d.EXPECT().ClusterARN(inApp, inEnv).Return("cluster-1", nil)
+				d.EXPECT().TaskDefinition(inApp, inEnv, inWkld).Return(&ecs.TaskDefinition{
+					TaskDefinitionArn: aws.String(taskDefARN),
+					RuntimePlatform: &awsecs.RuntimePlatform{
+						OperatingSystemFamily: aws.String(osWindowsServerCore),
+					},
+				}, nil)
+				d.EXPECT().NetworkConfigurationForJob(inApp, inEnv, inWkld).Return(network, nil)
+				r.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:         "cluster-1",
+					Count:           1,
+					Subnets:         []string{"subnet-1", "subnet-2"},
+					SecurityGroups:  []string{"sg-1"},
+					AssignPublicIP:  "DISABLED",
+					TaskFamilyName:  taskDefARN,
+					StartedBy:       startedBy,
+					PlatformVersion: "1.0.0",
+					EnableExec:      false,
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			describer := mocks.NewMockWorkloadDescriber(ctrl)
+			starter := mocks.NewMockRunner(ctrl)
+			tc.setupMocks(describer, starter)
+
+			runner := &WorkloadRunner{
+				Count:     1,
+				App:       inApp,
+				Env:       inEnv,
+				Workload:  inWkld,
+				IsJob:     tc.isJob,
+				Command:   tc.command,
+				Describer: describer,
+				Starter:   starter,
+			}
+
+			tasks, err := runner.Run()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTasks, tasks)
+		})
+	}
+}
@@ -25,6 +25,10 @@ var (
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"vpc-endpoints",
+		"web-acl",
+		"alb-access-logs",
+		"pull-through-cache",
 	}
 )
 
@@ -41,10 +45,24 @@ type EnvOpts struct {
 
 	ImportVPC *config.ImportVPC
 	VPCConfig *config.AdjustVPC
+	Addons    *EnvAddonsOpts // Optional. The addons nested stack shared by all workloads in the environment.
+
+	VPCEndpoints   bool     // Create VPC endpoints to AWS services for private subnets instead of NAT gateways.
+	ImportCertARNs []string // ACM certificates for the HTTPS listener instead of a certificate validated with the app's domain.
+
+	PublicLoadBalancer    *config.PublicLoadBalancer    // Optional. Web ACL and access logs of the public load balancer.
+	PullThroughCacheRules []config.PullThroughCacheRule // Optional. ECR pull through cache rules for upstream registries.
 
 	LatestVersion string
 }
 
+// EnvAddonsOpts holds configuration for the addons nested stack of an environment.
+type EnvAddonsOpts struct {
+	URL         string   // S3 object URL for the addons template.
+	ExtraParams string   // Additional user defined Parameters for the addons stack.
+	Outputs     []string // Logical IDs of the outputs to export from the environment stack.
+}
+
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
 func (t *Template) ParseEnv(data *EnvOpts, options ...ParseOption) (*Content, error) {
 	tpl, err := t.parse("base", envCFTemplatePath, options...)
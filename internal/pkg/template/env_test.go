@@ -22,6 +22,10 @@ func TestTemplate_ParseEnv(t *testing.T) {
 				"templates/environment/partials/lambdas.yml":                  []byte("lambdas"),
 				"templates/environment/partials/vpc-resources.yml":            []byte("vpc-resources"),
 				"templates/environment/partials/nat-gateways.yml":             []byte("nat-gateways"),
+				"templates/environment/partials/vpc-endpoints.yml":            []byte("vpc-endpoints"),
+				"templates/environment/partials/web-acl.yml":                  []byte("web-acl"),
+				"templates/environment/partials/alb-access-logs.yml":          []byte("alb-access-logs"),
+				"templates/environment/partials/pull-through-cache.yml":       []byte("pull-through-cache"),
 			},
 		},
 	}
@@ -135,6 +135,19 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"renders a valid template with HTTP requests forwarded instead of redirected to HTTPS": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck:          defaultHttpHealthCheck,
+				Aliases:                  []string{"api.example.com"},
+				AllowedSourceIps:         []string{"10.0.0.0/24"},
+				SkipHTTPSRedirect:        true,
+				ServiceDiscoveryEndpoint: "test.app.local",
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+			},
+		},
 		"renders a valid template with addons with no outputs": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
@@ -53,7 +53,8 @@ const (
 
 // Constants for ARN options.
 const (
-	snsARNPattern = "arn:%s:sns:%s:%s:%s-%s-%s-%s"
+	snsARNPattern      = "arn:%s:sns:%s:%s:%s-%s-%s-%s"
+	snsFIFOTopicSuffix = ".fifo"
 )
 
 var (
@@ -105,6 +106,12 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// WorkloadEnvAddonsOpts holds references to outputs exported by the addons stack of an environment.
+type WorkloadEnvAddonsOpts struct {
+	Variables     map[string]string // Environment variable names mapped to the output they reference.
+	PolicyOutputs []string          // Outputs that refer to IAM managed policies to attach to the task role.
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -267,6 +274,19 @@ type AutoscalingQueueDelayOpts struct {
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// IAMRoleOpts holds the permissions granted to an IAM role of the workload through its manifest.
+type IAMRoleOpts struct {
+	ManagedPolicyARNs []string
+	Statements        []IAMPolicyStatement
+}
+
+// IAMPolicyStatement holds a statement of the inline policy attached to an IAM role.
+type IAMPolicyStatement struct {
+	Effect    string
+	Actions   []string
+	Resources []string
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
@@ -275,12 +295,19 @@ type StateMachineOpts struct {
 
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
-	Topics []*Topic
+	Topics   []*Topic
+	EventBus *EventBus
+}
+
+// EventBus holds information needed to render the EventBridge event bus that the workload publishes events to.
+type EventBus struct {
+	Name *string // Name of an existing event bus. If nil, the workload publishes to the environment's event bus.
 }
 
 // Topic holds information needed to render a SNSTopic in a container definition.
 type Topic struct {
 	Name *string
+	FIFO bool
 
 	Region    string
 	Partition string
@@ -293,9 +320,20 @@ type Topic struct {
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
 	Topics []*TopicSubscription
+	Events []*EventSubscription
 	Queue  *SQSQueue
 }
 
+// IsQueueFIFO returns true if the service's events queue receives messages from FIFO topics.
+func (s *SubscribeOpts) IsQueueFIFO() bool {
+	for _, t := range s.Topics {
+		if t.Queue == nil && t.FIFO {
+			return true
+		}
+	}
+	return false
+}
+
 // HasTopicQueues returns true if any individual subscription has a dedicated queue.
 func (s *SubscribeOpts) HasTopicQueues() bool {
 	for _, t := range s.Topics {
@@ -308,9 +346,18 @@ func (s *SubscribeOpts) HasTopicQueues() bool {
 
 // TopicSubscription holds information needed to render a SNS Topic Subscription in a container definition.
 type TopicSubscription struct {
+	Name         *string
+	Service      *string
+	Queue        *SQSQueue
+	FilterPolicy *string // JSON-encoded SNS subscription filter policy.
+	FIFO         bool
+}
+
+// EventSubscription holds information needed to render an EventBridge rule that routes events into the events queue.
+type EventSubscription struct {
 	Name    *string
-	Service *string
-	Queue   *SQSQueue
+	Bus     *string // Name of an existing event bus. If nil, the rule is created on the environment's event bus.
+	Pattern *string // JSON-encoded EventBridge event pattern.
 }
 
 // SQSQueue holds information needed to render a SQS Queue in a container definition.
@@ -373,6 +420,7 @@ type WorkloadOpts struct {
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
+	EnvAddons                *WorkloadEnvAddonsOpts   // Outputs imported from the environment's addons stack.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
 	Autoscaling              *AutoscalingOpts
@@ -381,6 +429,9 @@ type WorkloadOpts struct {
 	Storage                  *StorageOpts
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	TaskRole                 *IAMRoleOpts // Permissions from the manifest in addition to the ones granted by Copilot.
+	ExecutionRole            *IAMRoleOpts
+	PullThroughCache         bool // True if an image is pulled through a pull through cache rule of the environment.
 	Platform                 RuntimePlatformOpts
 	EntryPoint               []string
 	Command                  []string
@@ -397,6 +448,7 @@ type WorkloadOpts struct {
 	HTTPHealthCheck     HTTPHealthCheckOpts
 	DeregistrationDelay *int64
 	AllowedSourceIps    []string
+	SkipHTTPSRedirect   bool // True means HTTP requests are forwarded to the service instead of redirected to HTTPS.
 	NLB                 *NetworkLoadBalancer
 
 	// Lambda functions.
@@ -493,6 +545,7 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":         ToSnakeCaseFunc,
 			"hasSecrets":          hasSecrets,
+			"hasManagedPolicies":  hasManagedPolicies,
 			"fmtSlice":            FmtSliceFunc,
 			"quoteSlice":          QuoteSliceFunc,
 			"randomUUID":          randomUUIDFunc,
@@ -518,6 +571,19 @@ func hasSecrets(opts WorkloadOpts) bool {
 	return false
 }
 
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.TaskRole != nil && (len(opts.TaskRole.ManagedPolicyARNs) > 0) {
+		return true
+	}
+	return false
+}
+
 func randomUUIDFunc() (string, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -552,5 +618,9 @@ func contains(list []string, s string) bool {
 
 // ARN determines the arn for a topic using the SNSTopic name and account information
 func (t Topic) ARN() string {
-	return fmt.Sprintf(snsARNPattern, t.Partition, t.Region, t.AccountID, t.App, t.Env, t.Svc, aws.StringValue(t.Name))
+	arn := fmt.Sprintf(snsARNPattern, t.Partition, t.Region, t.AccountID, t.App, t.Env, t.Svc, aws.StringValue(t.Name))
+	if t.FIFO {
+		arn += snsFIFOTopicSuffix
+	}
+	return arn
 }
@@ -151,6 +151,56 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no managed policies": {
+			in:     WorkloadOpts{},
+			wanted: false,
+		},
+		"nested stack has managed policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					PolicyOutputs: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"environment addons have managed policies": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					PolicyOutputs: []string{"SharedTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"task role has managed policies from the manifest": {
+			in: WorkloadOpts{
+				TaskRole: &IAMRoleOpts{
+					ManagedPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				},
+			},
+			wanted: true,
+		},
+		"environment addons only have variables": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					Variables: map[string]string{"TABLE": "SharedTableName"},
+				},
+			},
+			wanted: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -0,0 +1,286 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"golang.org/x/sync/errgroup"
+)
+
+// Event types written to an EventWriter.
+const (
+	StackResourceEventType = "stackResource" // A CloudFormation resource changed status.
+	ECSDeploymentEventType = "ecsDeployment" // The rollout of an ECS service deployment progressed.
+	ResultEventType        = "result"        // A command finished.
+)
+
+// Statuses of a ResultEventType event.
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+const ecsServiceResourceType = "AWS::ECS::Service"
+
+// Event is a deployment event. Fields that don't apply to the type of the event are empty.
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Fields of StackResourceEventType events.
+	Stack                string `json:"stack,omitempty"`
+	LogicalResourceID    string `json:"logicalResourceId,omitempty"`
+	PhysicalResourceID   string `json:"physicalResourceId,omitempty"`
+	ResourceType         string `json:"resourceType,omitempty"`
+	ResourceStatus       string `json:"resourceStatus,omitempty"`
+	ResourceStatusReason string `json:"resourceStatusReason,omitempty"`
+
+	// Fields of ECSDeploymentEventType events.
+	Cluster       string                `json:"cluster,omitempty"`
+	Service       string                `json:"service,omitempty"`
+	Deployments   []ECSDeploymentStatus `json:"deployments,omitempty"`
+	FailureEvents []string              `json:"failureEvents,omitempty"`
+
+	// Fields of ResultEventType events.
+	App      string `json:"app,omitempty"`
+	Env      string `json:"env,omitempty"`
+	Workload string `json:"workload,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ECSDeploymentStatus is the rollout status of an ECS service deployment.
+type ECSDeploymentStatus struct {
+	Status          string `json:"status"`
+	TaskDefRevision string `json:"taskDefRevision"`
+	DesiredCount    int    `json:"desiredCount"`
+	RunningCount    int    `json:"runningCount"`
+	FailedCount     int    `json:"failedCount"`
+	PendingCount    int    `json:"pendingCount"`
+	RolloutState    string `json:"rolloutState,omitempty"`
+}
+
+// ResultEvent returns the ResultEventType event of a command that returned err.
+func ResultEvent(err error) Event {
+	ev := Event{
+		Type:   ResultEventType,
+		Status: ResultSucceeded,
+	}
+	if err != nil {
+		ev.Status = ResultFailed
+		ev.Error = err.Error()
+	}
+	return ev
+}
+
+// EventWriter is a FileWriter that writes deployment events instead of rendering components.
+type EventWriter interface {
+	FileWriter
+	WriteEvent(ev Event) error
+}
+
+// JSONEventWriter is an EventWriter that writes each event as a line of JSON.
+// Text written with Write, such as spinner frames, is discarded so that the output only contains events.
+type JSONEventWriter struct {
+	enc *json.Encoder
+	now func() time.Time
+	mu  sync.Mutex
+}
+
+// NewJSONEventWriter returns a JSONEventWriter that writes newline-delimited JSON events to w.
+func NewJSONEventWriter(w io.Writer) *JSONEventWriter {
+	return &JSONEventWriter{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Write discards p.
+func (w *JSONEventWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+// Fd returns a dummy file descriptor as the writer is never a terminal.
+func (w *JSONEventWriter) Fd() uintptr {
+	return 0
+}
+
+// WriteEvent writes ev as a line of JSON. If ev has no timestamp, the current time is used.
+// WriteEvent is safe to call from multiple goroutines.
+func (w *JSONEventWriter) WriteEvent(ev Event) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if ev.Timestamp.IsZero() {
+		ev.Timestamp = w.now()
+	}
+	return w.enc.Encode(ev)
+}
+
+// StatusLines renders the events of deployments that run at the same time as lines of status in the same output.
+// Unlike the components drawn by Render, the lines never move the cursor, so deployments don't overwrite each other's progress.
+type StatusLines struct {
+	out FileWriter
+	mu  sync.Mutex
+}
+
+// NewStatusLines returns StatusLines that write to out.
+func NewStatusLines(out FileWriter) *StatusLines {
+	return &StatusLines{
+		out: out,
+	}
+}
+
+// Writer returns an EventWriter that renders each event as a line of status prefixed with label.
+func (s *StatusLines) Writer(label string) *StatusLineWriter {
+	return &StatusLineWriter{
+		lines: s,
+		label: label,
+	}
+}
+
+// StatusLineWriter is an EventWriter that renders events as lines of status of a single deployment.
+// Text written with Write, such as spinner frames, is discarded.
+type StatusLineWriter struct {
+	lines *StatusLines
+	label string
+}
+
+// WithLabel returns a StatusLineWriter that writes to the same lines of status prefixed with label instead.
+func (w *StatusLineWriter) WithLabel(label string) *StatusLineWriter {
+	return w.lines.Writer(label)
+}
+
+// Write discards p.
+func (w *StatusLineWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+// Fd returns the file descriptor of the output of the status lines.
+func (w *StatusLineWriter) Fd() uintptr {
+	return w.lines.out.Fd()
+}
+
+// WriteEvent writes the lines of status of ev. Result events are left for the caller to report.
+// WriteEvent is safe to call from multiple goroutines.
+func (w *StatusLineWriter) WriteEvent(ev Event) error {
+	var lines []string
+	switch ev.Type {
+	case StackResourceEventType:
+		line := fmt.Sprintf("%s (%s) %s", ev.LogicalResourceID, ev.ResourceType, prettifyRolloutStatus(ev.ResourceStatus))
+		if ev.ResourceStatusReason != "" && cloudformation.StackStatus(ev.ResourceStatus).Failure() {
+			line = fmt.Sprintf("%s: %s", line, ev.ResourceStatusReason)
+		}
+		lines = append(lines, line)
+	case ECSDeploymentEventType:
+		for _, d := range ev.Deployments {
+			lines = append(lines, fmt.Sprintf("ECS service %s deployment %s %s: %d/%d running, %d pending, %d failed",
+				ev.Service, strings.ToLower(d.Status), prettifyRolloutStatus(d.RolloutState), d.RunningCount, d.DesiredCount, d.PendingCount, d.FailedCount))
+		}
+		for _, failure := range ev.FailureEvents {
+			lines = append(lines, fmt.Sprintf("ECS service %s: %s", ev.Service, failure))
+		}
+	}
+	w.lines.mu.Lock()
+	defer w.lines.mu.Unlock()
+	for _, line := range lines {
+		if _, err := fmt.Fprintf(w.lines.out, "%s: %s\n", w.label, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StackEventWriterOpts is the configuration to write the events of a stack.
+type StackEventWriterOpts struct {
+	ECSDescriber stream.ECSServiceDescriber // Describes the ECS services of the stack to write their deployments.
+	Group        *errgroup.Group            // Group that runs the goroutines writing events.
+	Ctx          context.Context            // Context associated with the group.
+}
+
+// WriteStackEvents subscribes to the events of a stack and writes them to w from a goroutine in opts.Group until the streamer is closed.
+// Once an ECS service of the stack is created or updated, the rollout of its deployment is written as well.
+func WriteStackEvents(w EventWriter, streamer StackSubscriber, stackName string, opts StackEventWriterOpts) {
+	events := streamer.Subscribe()
+	opts.Group.Go(func() error {
+		var writeErr error
+		// Keep consuming events after a write error, otherwise the streamer blocks on notifying us.
+		for ev := range events {
+			if writeErr == nil {
+				writeErr = w.WriteEvent(Event{
+					Type:                 StackResourceEventType,
+					Timestamp:            ev.Timestamp,
+					Stack:                stackName,
+					LogicalResourceID:    ev.LogicalResourceID,
+					PhysicalResourceID:   ev.PhysicalResourceID,
+					ResourceType:         ev.ResourceType,
+					ResourceStatus:       ev.ResourceStatus,
+					ResourceStatusReason: ev.ResourceStatusReason,
+				})
+			}
+			if ev.ResourceType != ecsServiceResourceType || !cloudformation.StackStatus(ev.ResourceStatus).UpsertInProgress() {
+				continue
+			}
+			if ev.PhysicalResourceID == "" {
+				// New service creates receive two "CREATE_IN_PROGRESS" events.
+				// The first event doesn't have a service name yet, the second one has.
+				continue
+			}
+			writeECSDeploymentEvents(w, ev.PhysicalResourceID, ev.Timestamp, opts)
+		}
+		return writeErr
+	})
+}
+
+// writeECSDeploymentEvents streams the deployments of an ECS service, and writes them to w whenever the rollout progresses.
+func writeECSDeploymentEvents(w EventWriter, serviceARN string, startTime time.Time, opts StackEventWriterOpts) {
+	cluster, service := parseServiceARN(serviceARN)
+	streamer := stream.NewECSDeploymentStreamer(opts.ECSDescriber, cluster, service, startTime)
+	events := streamer.Subscribe()
+	opts.Group.Go(func() error {
+		var writeErr error
+		var prev []ECSDeploymentStatus
+		for ev := range events {
+			deployments := make([]ECSDeploymentStatus, len(ev.Deployments))
+			for i, d := range ev.Deployments {
+				deployments[i] = ECSDeploymentStatus{
+					Status:          d.Status,
+					TaskDefRevision: d.TaskDefRevision,
+					DesiredCount:    d.DesiredCount,
+					RunningCount:    d.RunningCount,
+					FailedCount:     d.FailedCount,
+					PendingCount:    d.PendingCount,
+					RolloutState:    d.RolloutState,
+				}
+			}
+			if reflect.DeepEqual(prev, deployments) && len(ev.LatestFailureEvents) == 0 {
+				// The service is described periodically, only write the changes.
+				continue
+			}
+			prev = deployments
+			if writeErr == nil {
+				writeErr = w.WriteEvent(Event{
+					Type:          ECSDeploymentEventType,
+					Cluster:       cluster,
+					Service:       service,
+					Deployments:   deployments,
+					FailureEvents: ev.LatestFailureEvents,
+				})
+			}
+		}
+		return writeErr
+	})
+	opts.Group.Go(func() error {
+		return stream.Stream(opts.Ctx, streamer)
+	})
+}
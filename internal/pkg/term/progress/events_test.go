@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+type fakeStackSubscriber struct {
+	events []stream.StackEvent
+}
+
+func (s *fakeStackSubscriber) Subscribe() <-chan stream.StackEvent {
+	ch := make(chan stream.StackEvent)
+	go func() {
+		for _, ev := range s.events {
+			ch <- ev
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+type fakeECSServiceDescriber struct {
+	services []*ecs.Service
+	calls    int
+}
+
+func (d *fakeECSServiceDescriber) Service(_, _ string) (*ecs.Service, error) {
+	svc := d.services[d.calls]
+	if d.calls < len(d.services)-1 {
+		d.calls++
+	}
+	return svc, nil
+}
+
+func TestJSONEventWriter_WriteEvent(t *testing.T) {
+	// GIVEN
+	buf := new(strings.Builder)
+	w := NewJSONEventWriter(buf)
+	w.now = func() time.Time {
+		return time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	}
+
+	// WHEN
+	_, err := w.Write([]byte("\x1b[?25l⠋ Proposing infrastructure changes"))
+	require.NoError(t, err)
+	require.NoError(t, w.WriteEvent(ResultEvent(nil)))
+	require.NoError(t, w.WriteEvent(ResultEvent(errors.New("some error"))))
+
+	// THEN
+	require.Equal(t, `{"type":"result","timestamp":"2020-11-23T18:00:00Z","status":"succeeded"}
+{"type":"result","timestamp":"2020-11-23T18:00:00Z","status":"failed","error":"some error"}
+`, buf.String())
+}
+
+type bufferFileWriter struct {
+	strings.Builder
+}
+
+func (*bufferFileWriter) Fd() uintptr {
+	return 2
+}
+
+func TestStatusLineWriter_WriteEvent(t *testing.T) {
+	// GIVEN
+	buf := new(bufferFileWriter)
+	lines := NewStatusLines(buf)
+	api := lines.Writer("api")
+	worker := api.WithLabel("worker")
+
+	// WHEN
+	_, err := api.Write([]byte("\x1b[?25l⠋ Proposing infrastructure changes"))
+	require.NoError(t, err)
+	require.NoError(t, api.WriteEvent(Event{
+		Type:                 StackResourceEventType,
+		LogicalResourceID:    "Service",
+		ResourceType:         "AWS::ECS::Service",
+		ResourceStatus:       "UPDATE_IN_PROGRESS",
+		ResourceStatusReason: "Resource update initiated",
+	}))
+	require.NoError(t, worker.WriteEvent(Event{
+		Type:                 StackResourceEventType,
+		LogicalResourceID:    "Queue",
+		ResourceType:         "AWS::SQS::Queue",
+		ResourceStatus:       "CREATE_FAILED",
+		ResourceStatusReason: "Access denied",
+	}))
+	require.NoError(t, api.WriteEvent(Event{
+		Type:    ECSDeploymentEventType,
+		Service: "phonetool-test-api",
+		Deployments: []ECSDeploymentStatus{
+			{Status: "PRIMARY", DesiredCount: 2, RunningCount: 1, PendingCount: 1, RolloutState: "IN_PROGRESS"},
+		},
+		FailureEvents: []string{"task failed to start"},
+	}))
+	require.NoError(t, api.WriteEvent(ResultEvent(nil)))
+
+	// THEN
+	require.Equal(t, `api: Service (AWS::ECS::Service) [update in progress]
+worker: Queue (AWS::SQS::Queue) [create failed]: Access denied
+api: ECS service phonetool-test-api deployment primary [in progress]: 1/2 running, 1 pending, 0 failed
+api: ECS service phonetool-test-api: task failed to start
+`, buf.String())
+	require.Equal(t, uintptr(2), api.Fd())
+}
+
+func TestWriteStackEvents(t *testing.T) {
+	// GIVEN
+	startTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	serviceARN := "arn:aws:ecs:us-west-2:1111:service/cluster/service"
+	deployment := func(running int64, state string) *ecs.Service {
+		return &ecs.Service{
+			Deployments: []*awsecs.Deployment{
+				{
+					DesiredCount:   aws.Int64(2),
+					RunningCount:   aws.Int64(running),
+					RolloutState:   aws.String(state),
+					Status:         aws.String("PRIMARY"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/hello:10"),
+					UpdatedAt:      aws.Time(startTime),
+				},
+			},
+		}
+	}
+	subscriber := &fakeStackSubscriber{
+		events: []stream.StackEvent{
+			{
+				LogicalResourceID: "Service",
+				ResourceType:      "AWS::ECS::Service",
+				ResourceStatus:    "CREATE_IN_PROGRESS",
+				Timestamp:         startTime,
+			},
+			{
+				LogicalResourceID:  "Service",
+				PhysicalResourceID: serviceARN,
+				ResourceType:       "AWS::ECS::Service",
+				ResourceStatus:     "CREATE_IN_PROGRESS",
+				Timestamp:          startTime,
+			},
+			{
+				LogicalResourceID:    "Role",
+				ResourceType:         "AWS::IAM::Role",
+				ResourceStatus:       "CREATE_FAILED",
+				ResourceStatusReason: "Resource creation cancelled",
+				Timestamp:            startTime,
+			},
+		},
+	}
+	describer := &fakeECSServiceDescriber{
+		services: []*ecs.Service{
+			deployment(1, "IN_PROGRESS"),
+			deployment(2, "COMPLETED"),
+		},
+	}
+	buf := new(strings.Builder)
+	g, ctx := errgroup.WithContext(context.Background())
+
+	// WHEN
+	WriteStackEvents(NewJSONEventWriter(buf), subscriber, "phonetool-test-api", StackEventWriterOpts{
+		ECSDescriber: describer,
+		Group:        g,
+		Ctx:          ctx,
+	})
+	err := g.Wait()
+
+	// THEN
+	require.NoError(t, err)
+	out := buf.String()
+	require.Equal(t, 3, strings.Count(out, `"type":"stackResource"`), "every stack event should be written")
+	require.Contains(t, out, `"resourceStatusReason":"Resource creation cancelled"`)
+	require.Equal(t, 2, strings.Count(out, `"type":"ecsDeployment"`), "every change of the rollout should be written")
+	require.Contains(t, out, `"cluster":"cluster","service":"service"`)
+	require.Contains(t, out, `"runningCount":1`)
+	require.Contains(t, out, `"runningCount":2`)
+}
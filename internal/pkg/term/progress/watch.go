@@ -0,0 +1,107 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	maxTransitionsToDisplay = 5 // Total number of status transitions we want to display at most.
+)
+
+// StatusSubscriber is the interface to subscribe channels to status snapshots.
+type StatusSubscriber interface {
+	Subscribe() <-chan stream.StatusSnapshot
+}
+
+// ListeningStatusRenderer renders the latest status snapshot of a resource along with its most recent transitions.
+func ListeningStatusRenderer(streamer StatusSubscriber, opts RenderOptions) DynamicRenderer {
+	c := &statusWatchComponent{
+		padding:           opts.Padding,
+		maxLenTransitions: maxTransitionsToDisplay,
+		stream:            streamer.Subscribe(),
+		done:              make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type statusWatchComponent struct {
+	// Data to render.
+	status      string
+	transitions []string
+
+	// Style configuration for the component.
+	padding           int
+	maxLenTransitions int
+
+	stream <-chan stream.StatusSnapshot // Channel where status snapshots are received.
+	done   chan struct{}                // Channel that's closed when there are no more snapshots to listen on.
+	mu     sync.Mutex                   // Lock used to mutate data to render.
+}
+
+// Listen updates the status and transitions as snapshots are streamed.
+func (c *statusWatchComponent) Listen() {
+	for ev := range c.stream {
+		c.mu.Lock()
+		c.status = ev.Human
+		c.transitions = append(c.transitions, ev.Transitions...)
+		if len(c.transitions) > c.maxLenTransitions {
+			c.transitions = c.transitions[len(c.transitions)-c.maxLenTransitions:]
+		}
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the latest status followed by the most recent transitions as singleLineComponents.
+func (c *statusWatchComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var components []Renderer
+	if c.status != "" {
+		for _, line := range strings.Split(strings.TrimSuffix(c.status, "\n"), "\n") {
+			components = append(components, &singleLineComponent{
+				Text:    line,
+				Padding: c.padding,
+			})
+		}
+	}
+	if len(c.transitions) > 0 {
+		components = append(components, &singleLineComponent{}, &singleLineComponent{
+			Text:    color.Faint.Sprint("Recent changes"),
+			Padding: c.padding,
+		})
+		for _, transition := range reverseStrings(c.transitions) {
+			components = append(components, &singleLineComponent{
+				Text:    fmt.Sprintf("- %s", transition),
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render status component to writer: %w", err)
+	}
+	return nl, nil
+}
+
+// Done returns a channel that's closed when there are no more snapshots to listen.
+func (c *statusWatchComponent) Done() <-chan struct{} {
+	return c.done
+}
@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusWatchComponent_Listen(t *testing.T) {
+	t.Run("should update status to latest snapshot and respect max number of transitions", func(t *testing.T) {
+		// GIVEN
+		events := make(chan stream.StatusSnapshot)
+		done := make(chan struct{})
+		c := &statusWatchComponent{
+			maxLenTransitions: 2,
+			stream:            events,
+			done:              done,
+		}
+
+		// WHEN
+		go c.Listen()
+		go func() {
+			events <- stream.StatusSnapshot{
+				Human:       "first",
+				Transitions: []string{"transition1", "transition2"},
+			}
+			events <- stream.StatusSnapshot{
+				Human:       "second",
+				Transitions: []string{"transition3"},
+			}
+			close(events)
+		}()
+
+		// THEN
+		<-done // Listen should have closed the channel.
+		require.Equal(t, "second", c.status, "expected only the latest status to be stored")
+		require.Equal(t, []string{"transition2", "transition3"}, c.transitions, "expected max len transitions to be respected")
+	})
+}
+
+func TestStatusWatchComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inStatus      string
+		inTransitions []string
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render nothing if no snapshot was received": {
+			wantedNumLines: 0,
+			wantedOut:      "",
+		},
+		"should render only the status if there are no transitions": {
+			inStatus: `Pipeline Status
+
+  Build  InProgress
+`,
+			wantedNumLines: 3,
+			wantedOut: `Pipeline Status
+
+  Build  InProgress
+`,
+		},
+		"should render transitions in reverse order": {
+			inStatus:      "Task Summary\n",
+			inTransitions: []string{"Stage Build started", "Stage Build succeeded"},
+
+			wantedNumLines: 5,
+			wantedOut: `Task Summary
+
+Recent changes
+  - Stage Build succeeded
+  - Stage Build started
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &statusWatchComponent{
+				status:      tc.inStatus,
+				transitions: tc.inTransitions,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "expected number of lines to match")
+			require.Equal(t, tc.wantedOut, buf.String(), "expected rendered output to match")
+		})
+	}
+}
@@ -39,6 +39,7 @@ const (
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	addonModulesLockFileName  = "addons.lock.yml"
 
 	ymlFileExtension = ".yml"
 
@@ -297,6 +298,32 @@ func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name stri
 	return ws.write(data, svc, addonsDirName, fname)
 }
 
+// OverwriteAddon writes the content of an addon file under "{svc}/addons/{name}.yml", replacing the file if it exists.
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) OverwriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
+	data, err := content.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal binary addon content: %w", err)
+	}
+	fname := name + ymlFileExtension
+	return ws.overwrite(data, svc, addonsDirName, fname)
+}
+
+// ReadAddonModulesLock returns the contents of the lockfile that records the modules addons were created from.
+func (ws *Workspace) ReadAddonModulesLock() ([]byte, error) {
+	return ws.read(addonModulesLockFileName)
+}
+
+// WriteAddonModulesLock writes the lockfile that records the modules addons were created from, replacing the existing lockfile.
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WriteAddonModulesLock(marshaler encoding.BinaryMarshaler) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal addon modules lockfile to binary: %w", err)
+	}
+	return ws.overwrite(data, addonModulesLockFileName)
+}
+
 // FileStat wraps the os.Stat function.
 type FileStat interface {
 	Stat(name string) (os.FileInfo, error)
@@ -418,6 +445,25 @@ func (ws *Workspace) write(data []byte, elem ...string) (string, error) {
 	return filename, nil
 }
 
+// overwrite writes the data to a file under the copilot directory joined by path elements, replacing the file if it exists.
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) overwrite(data []byte, elem ...string) (string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	pathElems := append([]string{copilotPath}, elem...)
+	filename := filepath.Join(pathElems...)
+
+	if err := ws.fsUtils.MkdirAll(filepath.Dir(filename), 0755 /* -rwxr-xr-x */); err != nil {
+		return "", fmt.Errorf("create directories for file %s: %w", filename, err)
+	}
+	if err := ws.fsUtils.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write file %s: %w", filename, err)
+	}
+	return filename, nil
+}
+
 // read returns the contents of the file under the copilot directory joined by path elements.
 func (ws *Workspace) read(elem ...string) ([]byte, error) {
 	copilotPath, err := ws.copilotDirPath()
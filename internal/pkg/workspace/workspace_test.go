@@ -768,6 +768,99 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwriteAddon(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+		existing  []byte
+
+		wantedErr error
+	}{
+		"writes addons file when it does not exist": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("hello"),
+			},
+		},
+		"replaces existing addons file": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("hello"),
+			},
+			existing: []byte("bye"),
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+			wantedErr: errors.New("marshal binary addon content: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			utils := &afero.Afero{
+				Fs: fs,
+			}
+			utils.MkdirAll(filepath.Join("/", "copilot", "webhook", "addons"), 0755)
+			if tc.existing != nil {
+				utils.WriteFile("/copilot/webhook/addons/redis.yml", tc.existing, 0644)
+			}
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.OverwriteAddon(tc.marshaler, "webhook", "redis")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, "/copilot/webhook/addons/redis.yml", actualPath)
+			out, err := utils.ReadFile(actualPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.marshaler.content, out)
+		})
+	}
+}
+
+func TestWorkspace_AddonModulesLock(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	utils := &afero.Afero{
+		Fs: fs,
+	}
+	utils.MkdirAll("/copilot", 0755)
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	_, err := ws.ReadAddonModulesLock()
+
+	// THEN
+	var errNotExist *ErrFileNotExists
+	require.True(t, errors.As(err, &errNotExist))
+
+	// WHEN
+	for _, content := range []string{"first", "second"} {
+		path, err := ws.WriteAddonModulesLock(mockBinaryMarshaler{content: []byte(content)})
+		require.NoError(t, err)
+		require.Equal(t, "/copilot/addons.lock.yml", path)
+	}
+	out, err := ws.ReadAddonModulesLock()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "second", string(out))
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {